@@ -51,11 +51,22 @@ func Convert_v1beta1_Configuration_To_v1beta2_Configuration(in *Configuration, o
 	return nil
 }
 
+// Convert_v1beta2_Configuration_To_v1beta1_Configuration is a conversion function that drops the
+// TopologyAwareScheduling and CheckpointAwareEviction fields, which are not present in v1beta1.
+func Convert_v1beta2_Configuration_To_v1beta1_Configuration(in *v1beta2.Configuration, out *Configuration, s conversionapi.Scope) error {
+	return autoConvert_v1beta2_Configuration_To_v1beta1_Configuration(in, out, s)
+}
+
 // Convert_v1beta1_Integrations_To_v1beta2_Integrations is a conversion function that ignores deprecated PodOptions field.
 func Convert_v1beta1_Integrations_To_v1beta2_Integrations(in *Integrations, out *v1beta2.Integrations, s conversionapi.Scope) error {
 	return autoConvert_v1beta1_Integrations_To_v1beta2_Integrations(in, out, s)
 }
 
+// Convert_v1beta2_Integrations_To_v1beta1_Integrations is a conversion function that ignores the DeclarativeFrameworks field, which is not present in v1beta1.
+func Convert_v1beta2_Integrations_To_v1beta1_Integrations(in *v1beta2.Integrations, out *Integrations, s conversionapi.Scope) error {
+	return autoConvert_v1beta2_Integrations_To_v1beta1_Integrations(in, out, s)
+}
+
 func Convert_v1beta1_FairSharing_To_v1beta2_FairSharing(in *FairSharing, out *v1beta2.FairSharing, s conversionapi.Scope) error {
 	if in != nil && in.Enable && len(in.PreemptionStrategies) == 0 {
 		in.PreemptionStrategies = []PreemptionStrategy{LessThanOrEqualToFinalShare, LessThanInitialShare}
@@ -95,3 +106,7 @@ func Convert_v1beta2_WaitForPodsReady_To_v1beta1_WaitForPodsReady(in *v1beta2.Wa
 func Convert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in *v1beta2.MultiKueue, out *MultiKueue, s conversionapi.Scope) error {
 	return autoConvert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in, out, s)
 }
+
+func Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in *v1beta2.MultiKueueExternalFramework, out *MultiKueueExternalFramework, s conversionapi.Scope) error {
+	return autoConvert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in, out, s)
+}
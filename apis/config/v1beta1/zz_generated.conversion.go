@@ -60,11 +60,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Configuration_To_v1beta1_Configuration(a.(*v1beta2.Configuration), b.(*Configuration), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfigurationSpec)(nil), (*v1beta2.ControllerConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerConfigurationSpec_To_v1beta2_ControllerConfigurationSpec(a.(*ControllerConfigurationSpec), b.(*v1beta2.ControllerConfigurationSpec), scope)
 	}); err != nil {
@@ -125,11 +120,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Integrations)(nil), (*Integrations)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Integrations_To_v1beta1_Integrations(a.(*v1beta2.Integrations), b.(*Integrations), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*InternalCertManagement)(nil), (*v1beta2.InternalCertManagement)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_InternalCertManagement_To_v1beta2_InternalCertManagement(a.(*InternalCertManagement), b.(*v1beta2.InternalCertManagement), scope)
 	}); err != nil {
@@ -150,11 +140,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.MultiKueueExternalFramework)(nil), (*MultiKueueExternalFramework)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(a.(*v1beta2.MultiKueueExternalFramework), b.(*MultiKueueExternalFramework), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ObjectRetentionPolicies)(nil), (*v1beta2.ObjectRetentionPolicies)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ObjectRetentionPolicies_To_v1beta2_ObjectRetentionPolicies(a.(*ObjectRetentionPolicies), b.(*v1beta2.ObjectRetentionPolicies), scope)
 	}); err != nil {
@@ -235,11 +220,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Configuration_To_v1beta1_Configuration(a.(*v1beta2.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.FairSharing)(nil), (*FairSharing)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_FairSharing_To_v1beta1_FairSharing(a.(*v1beta2.FairSharing), b.(*FairSharing), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Integrations)(nil), (*Integrations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Integrations_To_v1beta1_Integrations(a.(*v1beta2.Integrations), b.(*Integrations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.MultiKueueExternalFramework)(nil), (*MultiKueueExternalFramework)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(a.(*v1beta2.MultiKueueExternalFramework), b.(*MultiKueueExternalFramework), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.MultiKueue)(nil), (*MultiKueue)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(a.(*v1beta2.MultiKueue), b.(*MultiKueue), scope)
 	}); err != nil {
@@ -401,14 +401,11 @@ func autoConvert_v1beta2_Configuration_To_v1beta1_Configuration(in *v1beta2.Conf
 	out.Resources = (*Resources)(unsafe.Pointer(in.Resources))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.ObjectRetentionPolicies = (*ObjectRetentionPolicies)(unsafe.Pointer(in.ObjectRetentionPolicies))
+	// WARNING: in.TopologyAwareScheduling requires manual conversion: does not exist in peer-type
+	// WARNING: in.CheckpointAwareEviction requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Configuration_To_v1beta1_Configuration is an autogenerated conversion function.
-func Convert_v1beta2_Configuration_To_v1beta1_Configuration(in *v1beta2.Configuration, out *Configuration, s conversion.Scope) error {
-	return autoConvert_v1beta2_Configuration_To_v1beta1_Configuration(in, out, s)
-}
-
 func autoConvert_v1beta1_ControllerConfigurationSpec_To_v1beta2_ControllerConfigurationSpec(in *ControllerConfigurationSpec, out *v1beta2.ControllerConfigurationSpec, s conversion.Scope) error {
 	out.GroupKindConcurrency = *(*map[string]int)(unsafe.Pointer(&in.GroupKindConcurrency))
 	out.CacheSyncTimeout = (*time.Duration)(unsafe.Pointer(in.CacheSyncTimeout))
@@ -589,15 +586,11 @@ func autoConvert_v1beta1_Integrations_To_v1beta2_Integrations(in *Integrations,
 func autoConvert_v1beta2_Integrations_To_v1beta1_Integrations(in *v1beta2.Integrations, out *Integrations, s conversion.Scope) error {
 	out.Frameworks = *(*[]string)(unsafe.Pointer(&in.Frameworks))
 	out.ExternalFrameworks = *(*[]string)(unsafe.Pointer(&in.ExternalFrameworks))
+	// WARNING: in.DeclarativeFrameworks requires manual conversion: does not exist in peer-type
 	out.LabelKeysToCopy = *(*[]string)(unsafe.Pointer(&in.LabelKeysToCopy))
 	return nil
 }
 
-// Convert_v1beta2_Integrations_To_v1beta1_Integrations is an autogenerated conversion function.
-func Convert_v1beta2_Integrations_To_v1beta1_Integrations(in *v1beta2.Integrations, out *Integrations, s conversion.Scope) error {
-	return autoConvert_v1beta2_Integrations_To_v1beta1_Integrations(in, out, s)
-}
-
 func autoConvert_v1beta1_InternalCertManagement_To_v1beta2_InternalCertManagement(in *InternalCertManagement, out *v1beta2.InternalCertManagement, s conversion.Scope) error {
 	out.Enable = (*bool)(unsafe.Pointer(in.Enable))
 	out.WebhookServiceName = (*string)(unsafe.Pointer(in.WebhookServiceName))
@@ -627,7 +620,17 @@ func autoConvert_v1beta1_MultiKueue_To_v1beta2_MultiKueue(in *MultiKueue, out *v
 	out.Origin = (*string)(unsafe.Pointer(in.Origin))
 	out.WorkerLostTimeout = (*metav1.Duration)(unsafe.Pointer(in.WorkerLostTimeout))
 	out.DispatcherName = (*string)(unsafe.Pointer(in.DispatcherName))
-	out.ExternalFrameworks = *(*[]v1beta2.MultiKueueExternalFramework)(unsafe.Pointer(&in.ExternalFrameworks))
+	if in.ExternalFrameworks != nil {
+		in, out := &in.ExternalFrameworks, &out.ExternalFrameworks
+		*out = make([]v1beta2.MultiKueueExternalFramework, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_MultiKueueExternalFramework_To_v1beta2_MultiKueueExternalFramework(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalFrameworks = nil
+	}
 	return nil
 }
 
@@ -641,8 +644,23 @@ func autoConvert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in *v1beta2.MultiKueue
 	out.Origin = (*string)(unsafe.Pointer(in.Origin))
 	out.WorkerLostTimeout = (*metav1.Duration)(unsafe.Pointer(in.WorkerLostTimeout))
 	out.DispatcherName = (*string)(unsafe.Pointer(in.DispatcherName))
-	out.ExternalFrameworks = *(*[]MultiKueueExternalFramework)(unsafe.Pointer(&in.ExternalFrameworks))
+	if in.ExternalFrameworks != nil {
+		in, out := &in.ExternalFrameworks, &out.ExternalFrameworks
+		*out = make([]MultiKueueExternalFramework, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalFrameworks = nil
+	}
 	// WARNING: in.ClusterProfile requires manual conversion: does not exist in peer-type
+	// WARNING: in.ScoringDispatcher requires manual conversion: does not exist in peer-type
+	// WARNING: in.StatusSyncInterval requires manual conversion: does not exist in peer-type
+	// WARNING: in.Migration requires manual conversion: does not exist in peer-type
+	// WARNING: in.QuotaFederation requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialsProviders requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -658,14 +676,13 @@ func Convert_v1beta1_MultiKueueExternalFramework_To_v1beta2_MultiKueueExternalFr
 
 func autoConvert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in *v1beta2.MultiKueueExternalFramework, out *MultiKueueExternalFramework, s conversion.Scope) error {
 	out.Name = in.Name
+	// WARNING: in.ManagedByPath requires manual conversion: does not exist in peer-type
+	// WARNING: in.SyncedSpecPaths requires manual conversion: does not exist in peer-type
+	// WARNING: in.StatusPaths requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemovedPathsOnCreate requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework is an autogenerated conversion function.
-func Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in *v1beta2.MultiKueueExternalFramework, out *MultiKueueExternalFramework, s conversion.Scope) error {
-	return autoConvert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in, out, s)
-}
-
 func autoConvert_v1beta1_ObjectRetentionPolicies_To_v1beta2_ObjectRetentionPolicies(in *ObjectRetentionPolicies, out *v1beta2.ObjectRetentionPolicies, s conversion.Scope) error {
 	out.Workloads = (*v1beta2.WorkloadRetentionPolicy)(unsafe.Pointer(in.Workloads))
 	return nil
@@ -105,6 +105,17 @@ type Configuration struct {
 	// of Kueue-managed objects. A nil value disables all automatic deletions.
 	// +optional
 	ObjectRetentionPolicies *ObjectRetentionPolicies `json:"objectRetentionPolicies,omitempty"`
+
+	// TopologyAwareScheduling provides configuration options for the
+	// Topology Aware Scheduling.
+	// +optional
+	TopologyAwareScheduling *TopologyAwareScheduling `json:"topologyAwareScheduling,omitempty"`
+
+	// CheckpointAwareEviction provides configuration options for the
+	// checkpoint-aware eviction of the jobs.
+	// It is only used when the CheckpointAwareEviction feature gate is enabled.
+	// +optional
+	CheckpointAwareEviction *CheckpointAwareEviction `json:"checkpointAwareEviction,omitempty"`
 }
 
 type ControllerManager struct {
@@ -283,14 +294,209 @@ type MultiKueue struct {
 	// ClusterProfile defines configuration for using the ClusterProfile API.
 	// +optional
 	ClusterProfile *ClusterProfile `json:"clusterProfile,omitempty"`
+
+	// ScoringDispatcher configures the "kueue.x-k8s.io/multikueue-dispatcher-scoring" dispatcher.
+	// It is only used when the DispatcherName is set to that dispatcher.
+	// +optional
+	ScoringDispatcher *MultiKueueScoringDispatcher `json:"scoringDispatcher,omitempty"`
+
+	// StatusSyncInterval defines the time interval between two consecutive reads
+	// of the quota, usage and stats of the worker clusters, published in the status
+	// of their MultiKueueClusters and in metrics.
+	// It is only used when the MultiKueueClusterStats feature gate is enabled.
+	// Defaults to 1min.
+	// +optional
+	StatusSyncInterval *metav1.Duration `json:"statusSyncInterval,omitempty"`
+
+	// Migration configures the automatic migration of the Workloads from their
+	// worker cluster to another one.
+	// It is only used when the MultiKueueWorkloadMigration feature gate is enabled.
+	// +optional
+	Migration *MultiKueueMigration `json:"migration,omitempty"`
+
+	// QuotaFederation configures the enforcement of the quota of the manager
+	// ClusterQueues over the worker clusters.
+	// It is only used when the MultiKueueQuotaFederation feature gate is enabled.
+	// +optional
+	QuotaFederation *MultiKueueQuotaFederation `json:"quotaFederation,omitempty"`
+
+	// CredentialsProviders defines the providers of the credentials used to connect
+	// to the worker clusters whose MultiKueueCluster has the
+	// kueue.x-k8s.io/multikueue-credentials-provider annotation set to their name.
+	// It is only used when the MultiKueueCredentialsProviders feature gate is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	CredentialsProviders []MultiKueueCredentialsProvider `json:"credentialsProviders,omitempty"`
+}
+
+// MultiKueueCredentialsProvider defines a provider of the credentials of the worker clusters.
+// Exactly one of Exec and ServiceAccountTokenExchange must be set.
+type MultiKueueCredentialsProvider struct {
+	// Name is the name of the provider, referenced by the
+	// kueue.x-k8s.io/multikueue-credentials-provider annotation.
+	Name string `json:"name"`
+
+	// Exec mints the credentials by running an executable implementing the
+	// client.authentication.k8s.io/v1 ExecCredential protocol.
+	// +optional
+	Exec *MultiKueueExecCredentialsProvider `json:"exec,omitempty"`
+
+	// ServiceAccountTokenExchange exchanges a projected service account token of
+	// the manager for an access token of the worker cluster, using the OAuth 2.0
+	// token exchange (RFC 8693).
+	// +optional
+	ServiceAccountTokenExchange *MultiKueueServiceAccountTokenExchange `json:"serviceAccountTokenExchange,omitempty"`
+}
+
+// MultiKueueExecCredentialsProvider defines the executable minting the credentials.
+// The MultiKueueCluster name is passed to it in the KUEUE_MULTIKUEUE_CLUSTER
+// environment variable.
+type MultiKueueExecCredentialsProvider struct {
+	// Command is the executable to run.
+	Command string `json:"command"`
+
+	// Args are the arguments passed to the command.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env defines additional environment variables set for the command.
+	// +optional
+	Env []clientcmdapi.ExecEnvVar `json:"env,omitempty"`
+}
+
+// MultiKueueServiceAccountTokenExchange defines the token exchange endpoint.
+// The API server URL of the worker cluster is sent as the audience of the
+// requested token.
+type MultiKueueServiceAccountTokenExchange struct {
+	// TokenPath is the path of the projected service account token of the manager.
+	TokenPath string `json:"tokenPath"`
+
+	// ExchangeURL is the URL of the token exchange endpoint.
+	ExchangeURL string `json:"exchangeURL"`
+}
+
+type MultiKueueQuotaFederationMode string
+
+const (
+	// MultiKueueQuotaFederationModeLease - the quota reserved in the worker clusters
+	// by the Workloads which were not dispatched by this manager, in the LocalQueues
+	// with the same namespace and name as the LocalQueues of a manager ClusterQueue,
+	// is leased from the quota of that ClusterQueue.
+	MultiKueueQuotaFederationModeLease MultiKueueQuotaFederationMode = "Lease"
+)
+
+// MultiKueueQuotaFederation defines how the quota of a manager ClusterQueue is
+// shared with the worker clusters. The quota reserved in the worker clusters is
+// read every 30s, or every CapacitySyncInterval of the scoring dispatcher if it
+// is used.
+type MultiKueueQuotaFederation struct {
+	// Mode defines how the quota of a manager ClusterQueue is shared with the worker clusters.
+	// The only supported mode is "Lease": the quota leased to the worker clusters is
+	// counted as usage of the manager ClusterQueue, so the Workloads are only admitted
+	// in the manager cluster within the quota which is not leased.
+	// Defaults to Lease.
+	// +optional
+	Mode MultiKueueQuotaFederationMode `json:"mode,omitempty"`
+}
+
+// MultiKueueMigration defines when a Workload running in a worker cluster is
+// automatically migrated to another worker cluster.
+type MultiKueueMigration struct {
+	// PodsReadyTimeout defines the time after which a Workload admitted in a
+	// worker cluster, whose pods are still not ready, is migrated to another
+	// worker cluster. The readiness is read from the PodsReady condition of
+	// the remote Workload, so it requires waitForPodsReady to be enabled in
+	// the worker clusters.
+	// If not set, the Workloads are not migrated automatically.
+	// +optional
+	PodsReadyTimeout *metav1.Duration `json:"podsReadyTimeout,omitempty"`
+
+	// FromDrainedClusters defines whether the Workloads admitted in a worker
+	// cluster whose MultiKueueCluster has the Drain cordon policy are migrated
+	// to the other worker clusters, instead of running to completion in it.
+	// Defaults to false.
+	// +optional
+	FromDrainedClusters *bool `json:"fromDrainedClusters,omitempty"`
+}
+
+// MultiKueueScoringDispatcher defines how the scoring dispatcher ranks the worker clusters.
+// The score of a worker cluster is the sum of:
+//   - FreeCapacityWeight multiplied by the fraction of the Workload's requests which fit
+//     in the free nominal quota of the worker's ClusterQueue,
+//   - PendingWorkloadsWeight divided by one plus the number of pending Workloads
+//     in the worker's ClusterQueue,
+//   - the weights of all the ClusterPreferences matching the MultiKueueCluster.
+type MultiKueueScoringDispatcher struct {
+	// CapacitySyncInterval defines the time interval between two consecutive
+	// refreshes of the cached quota, usage and pending Workloads of the worker clusters.
+	// Defaults to 30s.
+	// +optional
+	CapacitySyncInterval *metav1.Duration `json:"capacitySyncInterval,omitempty"`
+
+	// FreeCapacityWeight defines the weight of the free quota of the worker's ClusterQueue.
+	// Defaults to 100.
+	// +optional
+	FreeCapacityWeight *int32 `json:"freeCapacityWeight,omitempty"`
+
+	// PendingWorkloadsWeight defines the weight of the length of the queue
+	// of pending Workloads in the worker's ClusterQueue.
+	// Defaults to 10.
+	// +optional
+	PendingWorkloadsWeight *int32 `json:"pendingWorkloadsWeight,omitempty"`
+
+	// ClusterPreferences defines additional weights for the MultiKueueClusters
+	// matching the label selectors.
+	// +optional
+	// +listType=atomic
+	ClusterPreferences []MultiKueueClusterPreference `json:"clusterPreferences,omitempty"`
+}
+
+// MultiKueueClusterPreference defines the weight of the MultiKueueClusters matching the selector.
+type MultiKueueClusterPreference struct {
+	// Selector selects the MultiKueueClusters by their labels.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Weight is added to the score of the matching MultiKueueClusters.
+	Weight int32 `json:"weight"`
 }
 
 // MultiKueueExternalFramework defines a framework that is not built-in.
+//
+// The fields are referenced by paths in the JSONPath dot notation, for example
+// `.spec.managedBy`. The keys containing dots can be quoted with brackets,
+// for example `.metadata.annotations['example.com/key']`.
 type MultiKueueExternalFramework struct {
 	// Name is the GVK of the resource that are
 	// managed by external controllers
 	// the expected format is `kind.version.group`.
 	Name string `json:"name"`
+
+	// ManagedByPath is the path of the field set to "kueue.x-k8s.io/multikueue"
+	// in the objects managed by MultiKueue. The field is removed from the copies
+	// of the objects created in the worker clusters.
+	// Defaults to `.spec.managedBy`.
+	// +optional
+	ManagedByPath string `json:"managedByPath,omitempty"`
+
+	// SyncedSpecPaths are the paths of the spec fields which are updated in the
+	// copies of the objects in the worker clusters when they change in the manager
+	// cluster. They must be under `.spec`.
+	// If empty, the copies are not updated after their creation.
+	// +optional
+	SyncedSpecPaths []string `json:"syncedSpecPaths,omitempty"`
+
+	// StatusPaths are the paths of the status fields which are copied back from
+	// the objects in the worker clusters. They must be under `.status`.
+	// If empty, the whole status is copied.
+	// +optional
+	StatusPaths []string `json:"statusPaths,omitempty"`
+
+	// RemovedPathsOnCreate are the paths of the fields removed from the copies of
+	// the objects created in the worker clusters, in addition to ManagedByPath.
+	// They must be under `.spec`, `.metadata.labels` or `.metadata.annotations`.
+	// +optional
+	RemovedPathsOnCreate []string `json:"removedPathsOnCreate,omitempty"`
 }
 
 const (
@@ -301,6 +507,11 @@ const (
 	// MultiKueueDispatcherModeIncremental is the name of dispatcher mode where worker clusters are incrementally added to the pool of nominated clusters.
 	// The process begins with up to 3 initial clusters and expands the pool by up to 3 clusters at a time (if fewer remain, all are added).
 	MultiKueueDispatcherModeIncremental = "kueue.x-k8s.io/multikueue-dispatcher-incremental"
+
+	// MultiKueueDispatcherModeScoring is the name of dispatcher mode where worker clusters are ranked
+	// by their free capacity, the length of their queues and the configured preferences. The process
+	// begins with up to 3 best-ranked clusters and expands the pool by up to 3 clusters at a time.
+	MultiKueueDispatcherModeScoring = "kueue.x-k8s.io/multikueue-dispatcher-scoring"
 )
 
 type RequeuingStrategy struct {
@@ -435,15 +646,27 @@ type Integrations struct {
 	//  - "kubeflow.org/jaxjob"
 	//  - "trainer.kubeflow.org/trainjob"
 	//  - "workload.codeflare.dev/appwrapper"
+	//  - "sparkoperator.k8s.io/sparkapplication"
+	//  - "argoproj.io/workflow"
+	//  - "tekton.dev/pipelinerun"
 	//  - "pod"
 	//  - "deployment"
 	//  - "statefulset"
 	//  - "leaderworkerset.x-k8s.io/leaderworkerset"
+	//  - "serving.kserve.io/inferenceservice"
 	Frameworks []string `json:"frameworks,omitempty"`
 	// List of GroupVersionKinds that are managed for Kueue by external controllers;
 	// the expected format is `Kind.version.group.com`.
 	ExternalFrameworks []string `json:"externalFrameworks,omitempty"`
 
+	// DeclarativeFrameworks is a list of custom resources managed by Kueue
+	// through the paths of their fields, without a dedicated controller.
+	// Kueue suspends and unsuspends them, and creates their Workloads from
+	// their pod templates.
+	// Requires the DeclarativeIntegrations feature gate.
+	// +optional
+	DeclarativeFrameworks []DeclarativeFramework `json:"declarativeFrameworks,omitempty"`
+
 	// labelKeysToCopy is a list of label keys that should be copied from the job into the
 	// workload object. It is not required for the job to have all the labels from this
 	// list. If a job does not have some label with the given key from this list, the
@@ -456,6 +679,66 @@ type Integrations struct {
 	LabelKeysToCopy []string `json:"labelKeysToCopy,omitempty"`
 }
 
+// DeclarativeFramework describes how Kueue manages a custom resource.
+// The fields are referenced by paths in the JSONPath dot notation, for example
+// `.spec.suspend`. The keys containing dots can be quoted with brackets,
+// for example `.metadata.annotations['example.com/key']`.
+type DeclarativeFramework struct {
+	// Name is the GVK of the resource,
+	// the expected format is `kind.version.group`.
+	Name string `json:"name"`
+
+	// SuspendPath is the path of the boolean field suspending the resource.
+	// Defaults to `.spec.suspend`.
+	// +optional
+	SuspendPath string `json:"suspendPath,omitempty"`
+
+	// PodSets describes the pod templates of the resource.
+	// Up to 8 PodSets can be declared.
+	PodSets []DeclarativePodSet `json:"podSets"`
+
+	// ConditionsPath is the path of the list of conditions of the resource.
+	// Defaults to `.status.conditions`.
+	// +optional
+	ConditionsPath string `json:"conditionsPath,omitempty"`
+
+	// ActiveConditionType is the type of the condition which is true while the
+	// pods of the resource are running, including the pods terminating after
+	// the resource is suspended.
+	ActiveConditionType string `json:"activeConditionType"`
+
+	// SucceededConditionType is the type of the condition which is true when
+	// the resource completed successfully.
+	SucceededConditionType string `json:"succeededConditionType"`
+
+	// FailedConditionType is the type of the condition which is true when
+	// the resource failed.
+	// +optional
+	FailedConditionType string `json:"failedConditionType,omitempty"`
+}
+
+// DeclarativePodSet describes a pod template of a custom resource.
+type DeclarativePodSet struct {
+	// Name is the name of the PodSet in the Workload.
+	Name string `json:"name"`
+
+	// TemplatePath is the path of the pod template, for example
+	// `.spec.template`.
+	TemplatePath string `json:"templatePath"`
+
+	// ReplicasPath is the path of the integer field holding the number of pods
+	// created from the template.
+	// If empty, one pod is created from the template.
+	// +optional
+	ReplicasPath string `json:"replicasPath,omitempty"`
+
+	// NodeSelectorPath is the path of the node selector where Kueue injects
+	// the node labels of the assigned flavor.
+	// Defaults to the `.spec.nodeSelector` of the template.
+	// +optional
+	NodeSelectorPath string `json:"nodeSelectorPath,omitempty"`
+}
+
 type Resources struct {
 	// ExcludedResourcePrefixes defines which resources should be ignored by Kueue
 	ExcludeResourcePrefixes []string `json:"excludeResourcePrefixes,omitempty"`
@@ -588,3 +871,48 @@ type WorkloadRetentionPolicy struct {
 	// +optional
 	AfterDeactivatedByKueue *metav1.Duration `json:"afterDeactivatedByKueue,omitempty"`
 }
+
+// TopologyAwareScheduling defines configuration options for the Topology Aware Scheduling.
+type TopologyAwareScheduling struct {
+	// NodeDrain configures the proactive handling of planned Node drains.
+	// It is only used when the TASProactiveNodeDrain feature gate is enabled.
+	// +optional
+	NodeDrain *TASNodeDrain `json:"nodeDrain,omitempty"`
+}
+
+// TASNodeDrain defines how planned Node drains are detected and handled.
+// A Node is considered to be planned for a drain when it is cordoned and it
+// has either the maintenance label or the maintenance taint. The value of the
+// label or taint is the planned start of the maintenance, expressed in Unix
+// time (seconds).
+type TASNodeDrain struct {
+	// MaintenanceLabel is the key of the Node label announcing the start of
+	// the maintenance.
+	// Defaults to "kueue.x-k8s.io/maintenance-start".
+	// +optional
+	MaintenanceLabel *string `json:"maintenanceLabel,omitempty"`
+
+	// MaintenanceTaintKey is the key of the Node taint announcing the start of
+	// the maintenance.
+	// Defaults to "kueue.x-k8s.io/maintenance-start".
+	// +optional
+	MaintenanceTaintKey *string `json:"maintenanceTaintKey,omitempty"`
+
+	// MigrationLeadTime defines how long before the start of the maintenance
+	// the affected Workloads are moved away from the Node. Until then the
+	// Workloads are only notified, so that they can checkpoint.
+	// Defaults to 5 minutes.
+	// +optional
+	MigrationLeadTime *metav1.Duration `json:"migrationLeadTime,omitempty"`
+}
+
+// CheckpointAwareEviction defines configuration options for the checkpoint-aware
+// eviction of the jobs.
+type CheckpointAwareEviction struct {
+	// MaxGracePeriod is the maximum duration Kueue waits for the checkpoint of
+	// an evicted job. The grace period requested by the job, in the
+	// kueue.x-k8s.io/checkpoint-grace-period annotation, is capped to this value.
+	// Defaults to 10 minutes.
+	// +optional
+	MaxGracePeriod *metav1.Duration `json:"maxGracePeriod,omitempty"`
+}
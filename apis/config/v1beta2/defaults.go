@@ -50,6 +50,16 @@ const (
 	DefaultRequeuingBackoffBaseSeconds            = 60
 	DefaultRequeuingBackoffMaxSeconds             = 3600
 	DefaultResourceTransformationStrategy         = Retain
+	DefaultTASNodeMaintenanceKey                  = "kueue.x-k8s.io/maintenance-start"
+	DefaultTASNodeDrainMigrationLeadTime          = 5 * time.Minute
+	DefaultCheckpointMaxGracePeriod               = 10 * time.Minute
+)
+
+const (
+	DefaultMultiKueueCapacitySyncInterval   = 30 * time.Second
+	DefaultMultiKueueStatusSyncInterval     = time.Minute
+	DefaultMultiKueueFreeCapacityWeight     = 100
+	DefaultMultiKueuePendingWorkloadsWeight = 10
 )
 
 func getOperatorNamespace() string {
@@ -120,11 +130,32 @@ func SetDefaults_Configuration(cfg *Configuration) {
 	cfg.MultiKueue.Origin = ptr.To(cmp.Or(ptr.Deref(cfg.MultiKueue.Origin, ""), DefaultMultiKueueOrigin))
 	cfg.MultiKueue.WorkerLostTimeout = cmp.Or(cfg.MultiKueue.WorkerLostTimeout, &metav1.Duration{Duration: DefaultMultiKueueWorkerLostTimeout})
 	cfg.MultiKueue.DispatcherName = cmp.Or(cfg.MultiKueue.DispatcherName, ptr.To(MultiKueueDispatcherModeAllAtOnce))
+	cfg.MultiKueue.StatusSyncInterval = cmp.Or(cfg.MultiKueue.StatusSyncInterval, &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval})
+	if *cfg.MultiKueue.DispatcherName == MultiKueueDispatcherModeScoring {
+		sd := cmp.Or(cfg.MultiKueue.ScoringDispatcher, &MultiKueueScoringDispatcher{})
+		sd.CapacitySyncInterval = cmp.Or(sd.CapacitySyncInterval, &metav1.Duration{Duration: DefaultMultiKueueCapacitySyncInterval})
+		sd.FreeCapacityWeight = cmp.Or(sd.FreeCapacityWeight, ptr.To[int32](DefaultMultiKueueFreeCapacityWeight))
+		sd.PendingWorkloadsWeight = cmp.Or(sd.PendingWorkloadsWeight, ptr.To[int32](DefaultMultiKueuePendingWorkloadsWeight))
+		cfg.MultiKueue.ScoringDispatcher = sd
+	}
+	if qf := cfg.MultiKueue.QuotaFederation; qf != nil {
+		qf.Mode = cmp.Or(qf.Mode, MultiKueueQuotaFederationModeLease)
+	}
 
 	if afs := cfg.AdmissionFairSharing; afs != nil {
 		afs.UsageSamplingInterval.Duration = cmp.Or(afs.UsageSamplingInterval.Duration, 5*time.Minute)
 	}
 
+	if tas := cfg.TopologyAwareScheduling; tas != nil && tas.NodeDrain != nil {
+		tas.NodeDrain.MaintenanceLabel = cmp.Or(tas.NodeDrain.MaintenanceLabel, ptr.To(DefaultTASNodeMaintenanceKey))
+		tas.NodeDrain.MaintenanceTaintKey = cmp.Or(tas.NodeDrain.MaintenanceTaintKey, ptr.To(DefaultTASNodeMaintenanceKey))
+		tas.NodeDrain.MigrationLeadTime = cmp.Or(tas.NodeDrain.MigrationLeadTime, &metav1.Duration{Duration: DefaultTASNodeDrainMigrationLeadTime})
+	}
+
+	if cae := cfg.CheckpointAwareEviction; cae != nil {
+		cae.MaxGracePeriod = cmp.Or(cae.MaxGracePeriod, &metav1.Duration{Duration: DefaultCheckpointMaxGracePeriod})
+	}
+
 	if cfg.Resources != nil {
 		for idx := range cfg.Resources.Transformations {
 			cfg.Resources.Transformations[idx].Strategy = ptr.To(cmp.Or(ptr.Deref(cfg.Resources.Transformations[idx].Strategy, ""), DefaultResourceTransformationStrategy))
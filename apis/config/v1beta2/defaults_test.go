@@ -89,10 +89,11 @@ func TestSetDefaults_Configuration(t *testing.T) {
 	}
 
 	defaultMultiKueue := &MultiKueue{
-		GCInterval:        &metav1.Duration{Duration: DefaultMultiKueueGCInterval},
-		Origin:            ptr.To(DefaultMultiKueueOrigin),
-		WorkerLostTimeout: &metav1.Duration{Duration: DefaultMultiKueueWorkerLostTimeout},
-		DispatcherName:    ptr.To(MultiKueueDispatcherModeAllAtOnce),
+		GCInterval:         &metav1.Duration{Duration: DefaultMultiKueueGCInterval},
+		Origin:             ptr.To(DefaultMultiKueueOrigin),
+		WorkerLostTimeout:  &metav1.Duration{Duration: DefaultMultiKueueWorkerLostTimeout},
+		DispatcherName:     ptr.To(MultiKueueDispatcherModeAllAtOnce),
+		StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
 	}
 
 	podsReadyTimeoutOverwrite := metav1.Duration{Duration: time.Minute}
@@ -502,10 +503,11 @@ func TestSetDefaults_Configuration(t *testing.T) {
 					Enable: ptr.To(false),
 				},
 				MultiKueue: &MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: time.Second},
-					Origin:            ptr.To("multikueue-manager1"),
-					WorkerLostTimeout: &metav1.Duration{Duration: time.Minute},
-					DispatcherName:    ptr.To(MultiKueueDispatcherModeIncremental),
+					GCInterval:         &metav1.Duration{Duration: time.Second},
+					Origin:             ptr.To("multikueue-manager1"),
+					WorkerLostTimeout:  &metav1.Duration{Duration: time.Minute},
+					DispatcherName:     ptr.To(MultiKueueDispatcherModeIncremental),
+					StatusSyncInterval: &metav1.Duration{Duration: 2 * time.Minute},
 				},
 			},
 			want: &Configuration{
@@ -517,10 +519,11 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				ClientConnection: defaultClientConnection,
 				Integrations:     defaultIntegrations,
 				MultiKueue: &MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: time.Second},
-					Origin:            ptr.To("multikueue-manager1"),
-					WorkerLostTimeout: &metav1.Duration{Duration: time.Minute},
-					DispatcherName:    ptr.To(MultiKueueDispatcherModeIncremental),
+					GCInterval:         &metav1.Duration{Duration: time.Second},
+					Origin:             ptr.To("multikueue-manager1"),
+					WorkerLostTimeout:  &metav1.Duration{Duration: time.Minute},
+					DispatcherName:     ptr.To(MultiKueueDispatcherModeIncremental),
+					StatusSyncInterval: &metav1.Duration{Duration: 2 * time.Minute},
 				},
 				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
 			},
@@ -531,10 +534,11 @@ func TestSetDefaults_Configuration(t *testing.T) {
 					Enable: ptr.To(false),
 				},
 				MultiKueue: &MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: time.Second},
-					Origin:            ptr.To(""),
-					WorkerLostTimeout: &metav1.Duration{Duration: time.Minute},
-					DispatcherName:    defaultMultiKueue.DispatcherName,
+					GCInterval:         &metav1.Duration{Duration: time.Second},
+					Origin:             ptr.To(""),
+					WorkerLostTimeout:  &metav1.Duration{Duration: time.Minute},
+					DispatcherName:     defaultMultiKueue.DispatcherName,
+					StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
 				},
 			},
 			want: &Configuration{
@@ -546,10 +550,11 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				ClientConnection: defaultClientConnection,
 				Integrations:     defaultIntegrations,
 				MultiKueue: &MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: time.Second},
-					Origin:            ptr.To(DefaultMultiKueueOrigin),
-					WorkerLostTimeout: &metav1.Duration{Duration: time.Minute},
-					DispatcherName:    defaultMultiKueue.DispatcherName,
+					GCInterval:         &metav1.Duration{Duration: time.Second},
+					Origin:             ptr.To(DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: time.Minute},
+					DispatcherName:     defaultMultiKueue.DispatcherName,
+					StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
 				},
 				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
 			},
@@ -573,10 +578,11 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				ClientConnection: defaultClientConnection,
 				Integrations:     defaultIntegrations,
 				MultiKueue: &MultiKueue{
-					GCInterval:        &metav1.Duration{},
-					Origin:            ptr.To("multikueue-manager1"),
-					WorkerLostTimeout: &metav1.Duration{Duration: 15 * time.Minute},
-					DispatcherName:    defaultMultiKueue.DispatcherName,
+					GCInterval:         &metav1.Duration{},
+					Origin:             ptr.To("multikueue-manager1"),
+					WorkerLostTimeout:  &metav1.Duration{Duration: 15 * time.Minute},
+					DispatcherName:     defaultMultiKueue.DispatcherName,
+					StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
 				},
 				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
 			},
@@ -643,6 +649,124 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				},
 			},
 		},
+		"multiKueue.scoringDispatcher": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				MultiKueue: &MultiKueue{
+					DispatcherName:     ptr.To(MultiKueueDispatcherModeScoring),
+					StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
+					ScoringDispatcher: &MultiKueueScoringDispatcher{
+						PendingWorkloadsWeight: ptr.To[int32](0),
+					},
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection: defaultClientConnection,
+				Integrations:     defaultIntegrations,
+				MultiKueue: &MultiKueue{
+					GCInterval:         &metav1.Duration{Duration: DefaultMultiKueueGCInterval},
+					Origin:             ptr.To(DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:     ptr.To(MultiKueueDispatcherModeScoring),
+					StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
+					ScoringDispatcher: &MultiKueueScoringDispatcher{
+						CapacitySyncInterval:   &metav1.Duration{Duration: DefaultMultiKueueCapacitySyncInterval},
+						FreeCapacityWeight:     ptr.To[int32](DefaultMultiKueueFreeCapacityWeight),
+						PendingWorkloadsWeight: ptr.To[int32](0),
+					},
+				},
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+			},
+		},
+		"multiKueue.quotaFederation": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				MultiKueue: &MultiKueue{
+					QuotaFederation: &MultiKueueQuotaFederation{},
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection: defaultClientConnection,
+				Integrations:     defaultIntegrations,
+				MultiKueue: &MultiKueue{
+					GCInterval:         &metav1.Duration{Duration: DefaultMultiKueueGCInterval},
+					Origin:             ptr.To(DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:     ptr.To(MultiKueueDispatcherModeAllAtOnce),
+					StatusSyncInterval: &metav1.Duration{Duration: DefaultMultiKueueStatusSyncInterval},
+					QuotaFederation: &MultiKueueQuotaFederation{
+						Mode: MultiKueueQuotaFederationModeLease,
+					},
+				},
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+			},
+		},
+		"topologyAwareScheduling.nodeDrain": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				TopologyAwareScheduling: &TopologyAwareScheduling{
+					NodeDrain: &TASNodeDrain{
+						MaintenanceTaintKey: ptr.To("example.com/maintenance"),
+					},
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				TopologyAwareScheduling: &TopologyAwareScheduling{
+					NodeDrain: &TASNodeDrain{
+						MaintenanceLabel:    ptr.To(DefaultTASNodeMaintenanceKey),
+						MaintenanceTaintKey: ptr.To("example.com/maintenance"),
+						MigrationLeadTime:   &metav1.Duration{Duration: DefaultTASNodeDrainMigrationLeadTime},
+					},
+				},
+			},
+		},
+		"checkpointAwareEviction": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				CheckpointAwareEviction: &CheckpointAwareEviction{},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				CheckpointAwareEviction: &CheckpointAwareEviction{
+					MaxGracePeriod: &metav1.Duration{Duration: DefaultCheckpointMaxGracePeriod},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
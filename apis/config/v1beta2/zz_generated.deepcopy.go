@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/component-base/config/v1alpha1"
 	timex "time"
 )
@@ -52,6 +53,26 @@ func (in *AdmissionFairSharing) DeepCopy() *AdmissionFairSharing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CheckpointAwareEviction) DeepCopyInto(out *CheckpointAwareEviction) {
+	*out = *in
+	if in.MaxGracePeriod != nil {
+		in, out := &in.MaxGracePeriod, &out.MaxGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckpointAwareEviction.
+func (in *CheckpointAwareEviction) DeepCopy() *CheckpointAwareEviction {
+	if in == nil {
+		return nil
+	}
+	out := new(CheckpointAwareEviction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -182,6 +203,16 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(ObjectRetentionPolicies)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TopologyAwareScheduling != nil {
+		in, out := &in.TopologyAwareScheduling, &out.TopologyAwareScheduling
+		*out = new(TopologyAwareScheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CheckpointAwareEviction != nil {
+		in, out := &in.CheckpointAwareEviction, &out.CheckpointAwareEviction
+		*out = new(CheckpointAwareEviction)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -312,6 +343,41 @@ func (in *ControllerWebhook) DeepCopy() *ControllerWebhook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeFramework) DeepCopyInto(out *DeclarativeFramework) {
+	*out = *in
+	if in.PodSets != nil {
+		in, out := &in.PodSets, &out.PodSets
+		*out = make([]DeclarativePodSet, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeFramework.
+func (in *DeclarativeFramework) DeepCopy() *DeclarativeFramework {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeFramework)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativePodSet) DeepCopyInto(out *DeclarativePodSet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativePodSet.
+func (in *DeclarativePodSet) DeepCopy() *DeclarativePodSet {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativePodSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceClassMapping) DeepCopyInto(out *DeviceClassMapping) {
 	*out = *in
@@ -365,6 +431,13 @@ func (in *Integrations) DeepCopyInto(out *Integrations) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeclarativeFrameworks != nil {
+		in, out := &in.DeclarativeFrameworks, &out.DeclarativeFrameworks
+		*out = make([]DeclarativeFramework, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LabelKeysToCopy != nil {
 		in, out := &in.LabelKeysToCopy, &out.LabelKeysToCopy
 		*out = make([]string, len(*in))
@@ -438,13 +511,42 @@ func (in *MultiKueue) DeepCopyInto(out *MultiKueue) {
 	if in.ExternalFrameworks != nil {
 		in, out := &in.ExternalFrameworks, &out.ExternalFrameworks
 		*out = make([]MultiKueueExternalFramework, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ClusterProfile != nil {
 		in, out := &in.ClusterProfile, &out.ClusterProfile
 		*out = new(ClusterProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScoringDispatcher != nil {
+		in, out := &in.ScoringDispatcher, &out.ScoringDispatcher
+		*out = new(MultiKueueScoringDispatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatusSyncInterval != nil {
+		in, out := &in.StatusSyncInterval, &out.StatusSyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MultiKueueMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaFederation != nil {
+		in, out := &in.QuotaFederation, &out.QuotaFederation
+		*out = new(MultiKueueQuotaFederation)
+		**out = **in
+	}
+	if in.CredentialsProviders != nil {
+		in, out := &in.CredentialsProviders, &out.CredentialsProviders
+		*out = make([]MultiKueueCredentialsProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueue.
@@ -457,9 +559,90 @@ func (in *MultiKueue) DeepCopy() *MultiKueue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueClusterPreference) DeepCopyInto(out *MultiKueueClusterPreference) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterPreference.
+func (in *MultiKueueClusterPreference) DeepCopy() *MultiKueueClusterPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueClusterPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueCredentialsProvider) DeepCopyInto(out *MultiKueueCredentialsProvider) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(MultiKueueExecCredentialsProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountTokenExchange != nil {
+		in, out := &in.ServiceAccountTokenExchange, &out.ServiceAccountTokenExchange
+		*out = new(MultiKueueServiceAccountTokenExchange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueCredentialsProvider.
+func (in *MultiKueueCredentialsProvider) DeepCopy() *MultiKueueCredentialsProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueCredentialsProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueExecCredentialsProvider) DeepCopyInto(out *MultiKueueExecCredentialsProvider) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]api.ExecEnvVar, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueExecCredentialsProvider.
+func (in *MultiKueueExecCredentialsProvider) DeepCopy() *MultiKueueExecCredentialsProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueExecCredentialsProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueExternalFramework) DeepCopyInto(out *MultiKueueExternalFramework) {
 	*out = *in
+	if in.SyncedSpecPaths != nil {
+		in, out := &in.SyncedSpecPaths, &out.SyncedSpecPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusPaths != nil {
+		in, out := &in.StatusPaths, &out.StatusPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedPathsOnCreate != nil {
+		in, out := &in.RemovedPathsOnCreate, &out.RemovedPathsOnCreate
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueExternalFramework.
@@ -472,6 +655,98 @@ func (in *MultiKueueExternalFramework) DeepCopy() *MultiKueueExternalFramework {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueMigration) DeepCopyInto(out *MultiKueueMigration) {
+	*out = *in
+	if in.PodsReadyTimeout != nil {
+		in, out := &in.PodsReadyTimeout, &out.PodsReadyTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FromDrainedClusters != nil {
+		in, out := &in.FromDrainedClusters, &out.FromDrainedClusters
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueMigration.
+func (in *MultiKueueMigration) DeepCopy() *MultiKueueMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueQuotaFederation) DeepCopyInto(out *MultiKueueQuotaFederation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueQuotaFederation.
+func (in *MultiKueueQuotaFederation) DeepCopy() *MultiKueueQuotaFederation {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueQuotaFederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueScoringDispatcher) DeepCopyInto(out *MultiKueueScoringDispatcher) {
+	*out = *in
+	if in.CapacitySyncInterval != nil {
+		in, out := &in.CapacitySyncInterval, &out.CapacitySyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FreeCapacityWeight != nil {
+		in, out := &in.FreeCapacityWeight, &out.FreeCapacityWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PendingWorkloadsWeight != nil {
+		in, out := &in.PendingWorkloadsWeight, &out.PendingWorkloadsWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ClusterPreferences != nil {
+		in, out := &in.ClusterPreferences, &out.ClusterPreferences
+		*out = make([]MultiKueueClusterPreference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueScoringDispatcher.
+func (in *MultiKueueScoringDispatcher) DeepCopy() *MultiKueueScoringDispatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueScoringDispatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueServiceAccountTokenExchange) DeepCopyInto(out *MultiKueueServiceAccountTokenExchange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueServiceAccountTokenExchange.
+func (in *MultiKueueServiceAccountTokenExchange) DeepCopy() *MultiKueueServiceAccountTokenExchange {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueServiceAccountTokenExchange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectRetentionPolicies) DeepCopyInto(out *ObjectRetentionPolicies) {
 	*out = *in
@@ -588,6 +863,36 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TASNodeDrain) DeepCopyInto(out *TASNodeDrain) {
+	*out = *in
+	if in.MaintenanceLabel != nil {
+		in, out := &in.MaintenanceLabel, &out.MaintenanceLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaintenanceTaintKey != nil {
+		in, out := &in.MaintenanceTaintKey, &out.MaintenanceTaintKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.MigrationLeadTime != nil {
+		in, out := &in.MigrationLeadTime, &out.MigrationLeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TASNodeDrain.
+func (in *TASNodeDrain) DeepCopy() *TASNodeDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(TASNodeDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSOptions) DeepCopyInto(out *TLSOptions) {
 	*out = *in
@@ -608,6 +913,26 @@ func (in *TLSOptions) DeepCopy() *TLSOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyAwareScheduling) DeepCopyInto(out *TopologyAwareScheduling) {
+	*out = *in
+	if in.NodeDrain != nil {
+		in, out := &in.NodeDrain, &out.NodeDrain
+		*out = new(TASNodeDrain)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyAwareScheduling.
+func (in *TopologyAwareScheduling) DeepCopy() *TopologyAwareScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyAwareScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WaitForPodsReady) DeepCopyInto(out *WaitForPodsReady) {
 	*out = *in
@@ -30,6 +30,7 @@ const (
 
 // WorkloadSpec defines the desired state of Workload
 // +kubebuilder:validation:XValidation:rule="has(self.priorityClassName) ? has(self.priority) : true", message="priority should not be nil when priorityClassName is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.tasks) || size(self.podSets) == 1", message="tasks requires exactly one podSet"
 type WorkloadSpec struct {
 	// podSets is a list of sets of homogeneous pods, each described by a Pod spec
 	// and a count.
@@ -90,6 +91,78 @@ type WorkloadSpec struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=1
 	MaximumExecutionTimeSeconds *int32 `json:"maximumExecutionTimeSeconds,omitempty"`
+
+	// dependencies is a list of Workloads in the same namespace that must
+	// finish before this Workload can be admitted. While any of the
+	// dependencies is not satisfied, the Workload is kept inadmissible in its
+	// ClusterQueue. When a dependency finishes without satisfying its
+	// condition, the Workload is finished as failed.
+	// This field requires the WorkloadDependencies feature gate.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	Dependencies []WorkloadDependency `json:"dependencies,omitempty"`
+
+	// tasks is the number of independent tasks tracked by the Workload. When
+	// set, the Workload is a bulk Workload: each pod of its only podSet runs one
+	// task, and the podSet count is the maximum number of tasks running at once.
+	// The tasks are admitted incrementally, starting from a single task, as the
+	// quota of the ClusterQueue allows.
+	// tasks cannot be changed.
+	// This field requires the BulkWorkloads feature gate.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Tasks *int32 `json:"tasks,omitempty"`
+}
+
+// WorkloadDependencyCondition is the condition of a dependency that needs to
+// be met before the dependent Workload can be admitted.
+//
+// +enum
+// +kubebuilder:validation:Enum=Succeeded;Finished
+type WorkloadDependencyCondition string
+
+const (
+	// WorkloadDependencySucceeded requires the dependency to finish
+	// successfully.
+	WorkloadDependencySucceeded WorkloadDependencyCondition = "Succeeded"
+
+	// WorkloadDependencyFinished requires the dependency to finish,
+	// regardless of its result.
+	WorkloadDependencyFinished WorkloadDependencyCondition = "Finished"
+)
+
+// WorkloadDependency references the Workloads that need to finish before the
+// dependent Workload can be admitted.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.name) != has(self.selector)", message="exactly one of name or selector must be set"
+type WorkloadDependency struct {
+	// name is the name of the Workload in the same namespace.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	Name *string `json:"name,omitempty"`
+
+	// selector selects the Workloads in the same namespace by their labels.
+	// The dependency is satisfied when at least one Workload matches the
+	// selector and all the matching Workloads meet the condition.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// condition is the condition that the referenced Workloads need to meet.
+	// Possible values are:
+	//
+	//   - Succeeded: the Workloads need to finish successfully.
+	//   - Finished: the Workloads need to finish, regardless of the result.
+	//
+	// Defaults to Succeeded.
+	// +optional
+	// +kubebuilder:default=Succeeded
+	Condition WorkloadDependencyCondition `json:"condition,omitempty"`
 }
 
 // PodSetTopologyRequest defines the topology request for a PodSet.
@@ -149,8 +222,88 @@ type PodSetTopologyRequest struct {
 	//
 	// +optional
 	PodSetSliceSize *int32 `json:"podSetSliceSize,omitempty"`
+
+	// spreadTopology indicates the topology level across which the pods of the
+	// PodSet should be spread, as indicated by the
+	// `kueue.x-k8s.io/podset-spread-topology` annotation.
+	// This is limited to 63 characters.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	SpreadTopology *string `json:"spreadTopology,omitempty"`
+
+	// spreadMinDomains indicates the minimum number of distinct topology domains
+	// at the spreadTopology level the PodSet should be spread across, as indicated
+	// by the `kueue.x-k8s.io/podset-spread-min-domains` annotation.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	SpreadMinDomains *int32 `json:"spreadMinDomains,omitempty"`
+
+	// spreadMaxPodsPerDomain indicates the maximum number of pods of the PodSet
+	// which can be placed in a single topology domain at the spreadTopology level,
+	// as indicated by the `kueue.x-k8s.io/podset-spread-max-pods-per-domain` annotation.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	SpreadMaxPodsPerDomain *int32 `json:"spreadMaxPodsPerDomain,omitempty"`
+
+	// podSetConstraints describes the topology relations between this PodSet
+	// and other PodSets of the workload, as indicated by the
+	// `kueue.x-k8s.io/podset-same-topology-domain-as` and
+	// `kueue.x-k8s.io/podset-different-topology-domain-from` annotations.
+	// The constraints are only evaluated between PodSets assigned to the same
+	// ResourceFlavor.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	PodSetConstraints []PodSetTopologyConstraint `json:"podSetConstraints,omitempty"`
+}
+
+// PodSetTopologyConstraint describes the topology relation between a PodSet
+// and another PodSet of the same workload.
+type PodSetTopologyConstraint struct {
+	// podSetName is the name of the other PodSet of the workload.
+	//
+	// +required
+	PodSetName PodSetReference `json:"podSetName"`
+
+	// level is the topology level at which the relation is evaluated.
+	// This is limited to 63 characters.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Level string `json:"level"`
+
+	// relation indicates whether the pods of both PodSets need to be placed
+	// within the same topology domain at the level, or in disjoint domains.
+	// The possible values are:
+	// - SameDomain
+	// - DifferentDomain
+	//
+	// +required
+	Relation PodSetTopologyRelation `json:"relation"`
 }
 
+// PodSetTopologyRelation describes the relation between the topology domains
+// of two PodSets.
+//
+// +enum
+// +kubebuilder:validation:Enum=SameDomain;DifferentDomain
+type PodSetTopologyRelation string
+
+const (
+	// SameDomainPodSetTopologyRelation indicates that the pods of both PodSets
+	// need to be placed within a single topology domain at the level.
+	SameDomainPodSetTopologyRelation PodSetTopologyRelation = "SameDomain"
+
+	// DifferentDomainPodSetTopologyRelation indicates that no topology domain
+	// at the level can contain pods of both PodSets.
+	DifferentDomainPodSetTopologyRelation PodSetTopologyRelation = "DifferentDomain"
+)
+
 type Admission struct {
 	// clusterQueue is the name of the ClusterQueue that admitted this workload.
 	ClusterQueue ClusterQueueReference `json:"clusterQueue"`
@@ -448,6 +601,40 @@ type WorkloadStatus struct {
 	//
 	// +optional
 	UnhealthyNodes []UnhealthyNode `json:"unhealthyNodes,omitempty"`
+
+	// tasks reports the aggregated status of the tasks of a bulk Workload.
+	//
+	// +optional
+	Tasks *WorkloadTasksStatus `json:"tasks,omitempty"`
+}
+
+// WorkloadTasksStatus is the aggregated status of the tasks of a bulk Workload.
+type WorkloadTasksStatus struct {
+	// admitted is the number of tasks which can run at once within the quota
+	// reserved for the Workload.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Admitted int32 `json:"admitted,omitempty"`
+
+	// active is the number of running tasks.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Active int32 `json:"active,omitempty"`
+
+	// succeeded is the number of tasks which finished successfully.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// failed is the number of tasks which finished with a failure and are not
+	// retried.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Failed int32 `json:"failed,omitempty"`
 }
 
 type SchedulingStats struct {
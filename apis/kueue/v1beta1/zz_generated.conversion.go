@@ -344,6 +344,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MultiKueueClusterFlavorStatus)(nil), (*v1beta2.MultiKueueClusterFlavorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MultiKueueClusterFlavorStatus_To_v1beta2_MultiKueueClusterFlavorStatus(a.(*MultiKueueClusterFlavorStatus), b.(*v1beta2.MultiKueueClusterFlavorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.MultiKueueClusterFlavorStatus)(nil), (*MultiKueueClusterFlavorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MultiKueueClusterFlavorStatus_To_v1beta1_MultiKueueClusterFlavorStatus(a.(*v1beta2.MultiKueueClusterFlavorStatus), b.(*MultiKueueClusterFlavorStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MultiKueueClusterList)(nil), (*v1beta2.MultiKueueClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MultiKueueClusterList_To_v1beta2_MultiKueueClusterList(a.(*MultiKueueClusterList), b.(*v1beta2.MultiKueueClusterList), scope)
 	}); err != nil {
@@ -354,6 +364,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MultiKueueClusterResourceStatus)(nil), (*v1beta2.MultiKueueClusterResourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MultiKueueClusterResourceStatus_To_v1beta2_MultiKueueClusterResourceStatus(a.(*MultiKueueClusterResourceStatus), b.(*v1beta2.MultiKueueClusterResourceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.MultiKueueClusterResourceStatus)(nil), (*MultiKueueClusterResourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MultiKueueClusterResourceStatus_To_v1beta1_MultiKueueClusterResourceStatus(a.(*v1beta2.MultiKueueClusterResourceStatus), b.(*MultiKueueClusterResourceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MultiKueueClusterStatus)(nil), (*v1beta2.MultiKueueClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MultiKueueClusterStatus_To_v1beta2_MultiKueueClusterStatus(a.(*MultiKueueClusterStatus), b.(*v1beta2.MultiKueueClusterStatus), scope)
 	}); err != nil {
@@ -424,6 +444,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSetTopologyConstraint)(nil), (*v1beta2.PodSetTopologyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PodSetTopologyConstraint_To_v1beta2_PodSetTopologyConstraint(a.(*PodSetTopologyConstraint), b.(*v1beta2.PodSetTopologyConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.PodSetTopologyConstraint)(nil), (*PodSetTopologyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodSetTopologyConstraint_To_v1beta1_PodSetTopologyConstraint(a.(*v1beta2.PodSetTopologyConstraint), b.(*PodSetTopologyConstraint), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodSetTopologyRequest)(nil), (*v1beta2.PodSetTopologyRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PodSetTopologyRequest_To_v1beta2_PodSetTopologyRequest(a.(*PodSetTopologyRequest), b.(*v1beta2.PodSetTopologyRequest), scope)
 	}); err != nil {
@@ -664,6 +694,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadDependency)(nil), (*v1beta2.WorkloadDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkloadDependency_To_v1beta2_WorkloadDependency(a.(*WorkloadDependency), b.(*v1beta2.WorkloadDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.WorkloadDependency)(nil), (*WorkloadDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_WorkloadDependency_To_v1beta1_WorkloadDependency(a.(*v1beta2.WorkloadDependency), b.(*WorkloadDependency), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkloadList)(nil), (*v1beta2.WorkloadList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkloadList_To_v1beta2_WorkloadList(a.(*WorkloadList), b.(*v1beta2.WorkloadList), scope)
 	}); err != nil {
@@ -704,6 +744,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadTasksStatus)(nil), (*v1beta2.WorkloadTasksStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkloadTasksStatus_To_v1beta2_WorkloadTasksStatus(a.(*WorkloadTasksStatus), b.(*v1beta2.WorkloadTasksStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta2.WorkloadTasksStatus)(nil), (*WorkloadTasksStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_WorkloadTasksStatus_To_v1beta1_WorkloadTasksStatus(a.(*v1beta2.WorkloadTasksStatus), b.(*WorkloadTasksStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*AdmissionCheckSpec)(nil), (*v1beta2.AdmissionCheckSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AdmissionCheckSpec_To_v1beta2_AdmissionCheckSpec(a.(*AdmissionCheckSpec), b.(*v1beta2.AdmissionCheckSpec), scope)
 	}); err != nil {
@@ -1724,6 +1774,28 @@ func Convert_v1beta2_MultiKueueCluster_To_v1beta1_MultiKueueCluster(in *v1beta2.
 	return autoConvert_v1beta2_MultiKueueCluster_To_v1beta1_MultiKueueCluster(in, out, s)
 }
 
+func autoConvert_v1beta1_MultiKueueClusterFlavorStatus_To_v1beta2_MultiKueueClusterFlavorStatus(in *MultiKueueClusterFlavorStatus, out *v1beta2.MultiKueueClusterFlavorStatus, s conversion.Scope) error {
+	out.Name = v1beta2.ResourceFlavorReference(in.Name)
+	out.Resources = *(*[]v1beta2.MultiKueueClusterResourceStatus)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1beta1_MultiKueueClusterFlavorStatus_To_v1beta2_MultiKueueClusterFlavorStatus is an autogenerated conversion function.
+func Convert_v1beta1_MultiKueueClusterFlavorStatus_To_v1beta2_MultiKueueClusterFlavorStatus(in *MultiKueueClusterFlavorStatus, out *v1beta2.MultiKueueClusterFlavorStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MultiKueueClusterFlavorStatus_To_v1beta2_MultiKueueClusterFlavorStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_MultiKueueClusterFlavorStatus_To_v1beta1_MultiKueueClusterFlavorStatus(in *v1beta2.MultiKueueClusterFlavorStatus, out *MultiKueueClusterFlavorStatus, s conversion.Scope) error {
+	out.Name = ResourceFlavorReference(in.Name)
+	out.Resources = *(*[]MultiKueueClusterResourceStatus)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1beta2_MultiKueueClusterFlavorStatus_To_v1beta1_MultiKueueClusterFlavorStatus is an autogenerated conversion function.
+func Convert_v1beta2_MultiKueueClusterFlavorStatus_To_v1beta1_MultiKueueClusterFlavorStatus(in *v1beta2.MultiKueueClusterFlavorStatus, out *MultiKueueClusterFlavorStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_MultiKueueClusterFlavorStatus_To_v1beta1_MultiKueueClusterFlavorStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_MultiKueueClusterList_To_v1beta2_MultiKueueClusterList(in *MultiKueueClusterList, out *v1beta2.MultiKueueClusterList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -1766,19 +1838,51 @@ func Convert_v1beta2_MultiKueueClusterList_To_v1beta1_MultiKueueClusterList(in *
 	return autoConvert_v1beta2_MultiKueueClusterList_To_v1beta1_MultiKueueClusterList(in, out, s)
 }
 
+func autoConvert_v1beta1_MultiKueueClusterResourceStatus_To_v1beta2_MultiKueueClusterResourceStatus(in *MultiKueueClusterResourceStatus, out *v1beta2.MultiKueueClusterResourceStatus, s conversion.Scope) error {
+	out.Name = corev1.ResourceName(in.Name)
+	out.NominalQuota = in.NominalQuota
+	out.Usage = in.Usage
+	return nil
+}
+
+// Convert_v1beta1_MultiKueueClusterResourceStatus_To_v1beta2_MultiKueueClusterResourceStatus is an autogenerated conversion function.
+func Convert_v1beta1_MultiKueueClusterResourceStatus_To_v1beta2_MultiKueueClusterResourceStatus(in *MultiKueueClusterResourceStatus, out *v1beta2.MultiKueueClusterResourceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MultiKueueClusterResourceStatus_To_v1beta2_MultiKueueClusterResourceStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_MultiKueueClusterResourceStatus_To_v1beta1_MultiKueueClusterResourceStatus(in *v1beta2.MultiKueueClusterResourceStatus, out *MultiKueueClusterResourceStatus, s conversion.Scope) error {
+	out.Name = corev1.ResourceName(in.Name)
+	out.NominalQuota = in.NominalQuota
+	out.Usage = in.Usage
+	return nil
+}
+
+// Convert_v1beta2_MultiKueueClusterResourceStatus_To_v1beta1_MultiKueueClusterResourceStatus is an autogenerated conversion function.
+func Convert_v1beta2_MultiKueueClusterResourceStatus_To_v1beta1_MultiKueueClusterResourceStatus(in *v1beta2.MultiKueueClusterResourceStatus, out *MultiKueueClusterResourceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_MultiKueueClusterResourceStatus_To_v1beta1_MultiKueueClusterResourceStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_MultiKueueClusterSpec_To_v1beta2_MultiKueueClusterSpec(in *MultiKueueClusterSpec, out *v1beta2.MultiKueueClusterSpec, s conversion.Scope) error {
 	// WARNING: in.KubeConfig requires manual conversion: does not exist in peer-type
 	// WARNING: in.ClusterProfileRef requires manual conversion: does not exist in peer-type
+	out.CordonPolicy = (*v1beta2.MultiKueueClusterCordonPolicy)(unsafe.Pointer(in.CordonPolicy))
 	return nil
 }
 
 func autoConvert_v1beta2_MultiKueueClusterSpec_To_v1beta1_MultiKueueClusterSpec(in *v1beta2.MultiKueueClusterSpec, out *MultiKueueClusterSpec, s conversion.Scope) error {
 	// WARNING: in.ClusterSource requires manual conversion: does not exist in peer-type
+	out.CordonPolicy = (*MultiKueueClusterCordonPolicy)(unsafe.Pointer(in.CordonPolicy))
 	return nil
 }
 
 func autoConvert_v1beta1_MultiKueueClusterStatus_To_v1beta2_MultiKueueClusterStatus(in *MultiKueueClusterStatus, out *v1beta2.MultiKueueClusterStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.KueueVersion = in.KueueVersion
+	out.ClusterQueues = *(*[]v1beta2.ClusterQueueReference)(unsafe.Pointer(&in.ClusterQueues))
+	out.Flavors = *(*[]v1beta2.MultiKueueClusterFlavorStatus)(unsafe.Pointer(&in.Flavors))
+	out.MirroredWorkloads = (*int32)(unsafe.Pointer(in.MirroredWorkloads))
+	out.LastSyncTime = (*v1.Time)(unsafe.Pointer(in.LastSyncTime))
+	out.LastSyncLatencyMilliseconds = (*int32)(unsafe.Pointer(in.LastSyncLatencyMilliseconds))
 	return nil
 }
 
@@ -1789,6 +1893,12 @@ func Convert_v1beta1_MultiKueueClusterStatus_To_v1beta2_MultiKueueClusterStatus(
 
 func autoConvert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(in *v1beta2.MultiKueueClusterStatus, out *MultiKueueClusterStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.KueueVersion = in.KueueVersion
+	out.ClusterQueues = *(*[]ClusterQueueReference)(unsafe.Pointer(&in.ClusterQueues))
+	out.Flavors = *(*[]MultiKueueClusterFlavorStatus)(unsafe.Pointer(&in.Flavors))
+	out.MirroredWorkloads = (*int32)(unsafe.Pointer(in.MirroredWorkloads))
+	out.LastSyncTime = (*v1.Time)(unsafe.Pointer(in.LastSyncTime))
+	out.LastSyncLatencyMilliseconds = (*int32)(unsafe.Pointer(in.LastSyncLatencyMilliseconds))
 	return nil
 }
 
@@ -1961,6 +2071,30 @@ func Convert_v1beta2_PodSetRequest_To_v1beta1_PodSetRequest(in *v1beta2.PodSetRe
 	return autoConvert_v1beta2_PodSetRequest_To_v1beta1_PodSetRequest(in, out, s)
 }
 
+func autoConvert_v1beta1_PodSetTopologyConstraint_To_v1beta2_PodSetTopologyConstraint(in *PodSetTopologyConstraint, out *v1beta2.PodSetTopologyConstraint, s conversion.Scope) error {
+	out.PodSetName = v1beta2.PodSetReference(in.PodSetName)
+	out.Level = in.Level
+	out.Relation = v1beta2.PodSetTopologyRelation(in.Relation)
+	return nil
+}
+
+// Convert_v1beta1_PodSetTopologyConstraint_To_v1beta2_PodSetTopologyConstraint is an autogenerated conversion function.
+func Convert_v1beta1_PodSetTopologyConstraint_To_v1beta2_PodSetTopologyConstraint(in *PodSetTopologyConstraint, out *v1beta2.PodSetTopologyConstraint, s conversion.Scope) error {
+	return autoConvert_v1beta1_PodSetTopologyConstraint_To_v1beta2_PodSetTopologyConstraint(in, out, s)
+}
+
+func autoConvert_v1beta2_PodSetTopologyConstraint_To_v1beta1_PodSetTopologyConstraint(in *v1beta2.PodSetTopologyConstraint, out *PodSetTopologyConstraint, s conversion.Scope) error {
+	out.PodSetName = PodSetReference(in.PodSetName)
+	out.Level = in.Level
+	out.Relation = PodSetTopologyRelation(in.Relation)
+	return nil
+}
+
+// Convert_v1beta2_PodSetTopologyConstraint_To_v1beta1_PodSetTopologyConstraint is an autogenerated conversion function.
+func Convert_v1beta2_PodSetTopologyConstraint_To_v1beta1_PodSetTopologyConstraint(in *v1beta2.PodSetTopologyConstraint, out *PodSetTopologyConstraint, s conversion.Scope) error {
+	return autoConvert_v1beta2_PodSetTopologyConstraint_To_v1beta1_PodSetTopologyConstraint(in, out, s)
+}
+
 func autoConvert_v1beta1_PodSetTopologyRequest_To_v1beta2_PodSetTopologyRequest(in *PodSetTopologyRequest, out *v1beta2.PodSetTopologyRequest, s conversion.Scope) error {
 	out.Required = (*string)(unsafe.Pointer(in.Required))
 	out.Preferred = (*string)(unsafe.Pointer(in.Preferred))
@@ -1971,6 +2105,10 @@ func autoConvert_v1beta1_PodSetTopologyRequest_To_v1beta2_PodSetTopologyRequest(
 	out.PodSetGroupName = (*string)(unsafe.Pointer(in.PodSetGroupName))
 	out.PodSetSliceRequiredTopology = (*string)(unsafe.Pointer(in.PodSetSliceRequiredTopology))
 	out.PodSetSliceSize = (*int32)(unsafe.Pointer(in.PodSetSliceSize))
+	out.SpreadTopology = (*string)(unsafe.Pointer(in.SpreadTopology))
+	out.SpreadMinDomains = (*int32)(unsafe.Pointer(in.SpreadMinDomains))
+	out.SpreadMaxPodsPerDomain = (*int32)(unsafe.Pointer(in.SpreadMaxPodsPerDomain))
+	out.PodSetConstraints = *(*[]v1beta2.PodSetTopologyConstraint)(unsafe.Pointer(&in.PodSetConstraints))
 	return nil
 }
 
@@ -1989,6 +2127,10 @@ func autoConvert_v1beta2_PodSetTopologyRequest_To_v1beta1_PodSetTopologyRequest(
 	out.PodSetGroupName = (*string)(unsafe.Pointer(in.PodSetGroupName))
 	out.PodSetSliceRequiredTopology = (*string)(unsafe.Pointer(in.PodSetSliceRequiredTopology))
 	out.PodSetSliceSize = (*int32)(unsafe.Pointer(in.PodSetSliceSize))
+	out.SpreadTopology = (*string)(unsafe.Pointer(in.SpreadTopology))
+	out.SpreadMinDomains = (*int32)(unsafe.Pointer(in.SpreadMinDomains))
+	out.SpreadMaxPodsPerDomain = (*int32)(unsafe.Pointer(in.SpreadMaxPodsPerDomain))
+	out.PodSetConstraints = *(*[]PodSetTopologyConstraint)(unsafe.Pointer(&in.PodSetConstraints))
 	return nil
 }
 
@@ -2553,6 +2695,30 @@ func Convert_v1beta2_Workload_To_v1beta1_Workload(in *v1beta2.Workload, out *Wor
 	return autoConvert_v1beta2_Workload_To_v1beta1_Workload(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkloadDependency_To_v1beta2_WorkloadDependency(in *WorkloadDependency, out *v1beta2.WorkloadDependency, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Condition = v1beta2.WorkloadDependencyCondition(in.Condition)
+	return nil
+}
+
+// Convert_v1beta1_WorkloadDependency_To_v1beta2_WorkloadDependency is an autogenerated conversion function.
+func Convert_v1beta1_WorkloadDependency_To_v1beta2_WorkloadDependency(in *WorkloadDependency, out *v1beta2.WorkloadDependency, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkloadDependency_To_v1beta2_WorkloadDependency(in, out, s)
+}
+
+func autoConvert_v1beta2_WorkloadDependency_To_v1beta1_WorkloadDependency(in *v1beta2.WorkloadDependency, out *WorkloadDependency, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Condition = WorkloadDependencyCondition(in.Condition)
+	return nil
+}
+
+// Convert_v1beta2_WorkloadDependency_To_v1beta1_WorkloadDependency is an autogenerated conversion function.
+func Convert_v1beta2_WorkloadDependency_To_v1beta1_WorkloadDependency(in *v1beta2.WorkloadDependency, out *WorkloadDependency, s conversion.Scope) error {
+	return autoConvert_v1beta2_WorkloadDependency_To_v1beta1_WorkloadDependency(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkloadList_To_v1beta2_WorkloadList(in *WorkloadList, out *v1beta2.WorkloadList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -2673,6 +2839,8 @@ func autoConvert_v1beta1_WorkloadSpec_To_v1beta2_WorkloadSpec(in *WorkloadSpec,
 	// WARNING: in.PriorityClassSource requires manual conversion: does not exist in peer-type
 	out.Active = (*bool)(unsafe.Pointer(in.Active))
 	out.MaximumExecutionTimeSeconds = (*int32)(unsafe.Pointer(in.MaximumExecutionTimeSeconds))
+	out.Dependencies = *(*[]v1beta2.WorkloadDependency)(unsafe.Pointer(&in.Dependencies))
+	out.Tasks = (*int32)(unsafe.Pointer(in.Tasks))
 	return nil
 }
 
@@ -2683,6 +2851,8 @@ func autoConvert_v1beta2_WorkloadSpec_To_v1beta1_WorkloadSpec(in *v1beta2.Worklo
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.Active = (*bool)(unsafe.Pointer(in.Active))
 	out.MaximumExecutionTimeSeconds = (*int32)(unsafe.Pointer(in.MaximumExecutionTimeSeconds))
+	out.Dependencies = *(*[]WorkloadDependency)(unsafe.Pointer(&in.Dependencies))
+	out.Tasks = (*int32)(unsafe.Pointer(in.Tasks))
 	return nil
 }
 
@@ -2706,6 +2876,7 @@ func autoConvert_v1beta1_WorkloadStatus_To_v1beta2_WorkloadStatus(in *WorkloadSt
 	out.NominatedClusterNames = *(*[]string)(unsafe.Pointer(&in.NominatedClusterNames))
 	out.ClusterName = (*string)(unsafe.Pointer(in.ClusterName))
 	out.UnhealthyNodes = *(*[]v1beta2.UnhealthyNode)(unsafe.Pointer(&in.UnhealthyNodes))
+	out.Tasks = (*v1beta2.WorkloadTasksStatus)(unsafe.Pointer(in.Tasks))
 	return nil
 }
 
@@ -2729,5 +2900,32 @@ func autoConvert_v1beta2_WorkloadStatus_To_v1beta1_WorkloadStatus(in *v1beta2.Wo
 	out.NominatedClusterNames = *(*[]string)(unsafe.Pointer(&in.NominatedClusterNames))
 	out.ClusterName = (*string)(unsafe.Pointer(in.ClusterName))
 	out.UnhealthyNodes = *(*[]UnhealthyNode)(unsafe.Pointer(&in.UnhealthyNodes))
+	out.Tasks = (*WorkloadTasksStatus)(unsafe.Pointer(in.Tasks))
 	return nil
 }
+
+func autoConvert_v1beta1_WorkloadTasksStatus_To_v1beta2_WorkloadTasksStatus(in *WorkloadTasksStatus, out *v1beta2.WorkloadTasksStatus, s conversion.Scope) error {
+	out.Admitted = in.Admitted
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	return nil
+}
+
+// Convert_v1beta1_WorkloadTasksStatus_To_v1beta2_WorkloadTasksStatus is an autogenerated conversion function.
+func Convert_v1beta1_WorkloadTasksStatus_To_v1beta2_WorkloadTasksStatus(in *WorkloadTasksStatus, out *v1beta2.WorkloadTasksStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkloadTasksStatus_To_v1beta2_WorkloadTasksStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_WorkloadTasksStatus_To_v1beta1_WorkloadTasksStatus(in *v1beta2.WorkloadTasksStatus, out *WorkloadTasksStatus, s conversion.Scope) error {
+	out.Admitted = in.Admitted
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	return nil
+}
+
+// Convert_v1beta2_WorkloadTasksStatus_To_v1beta1_WorkloadTasksStatus is an autogenerated conversion function.
+func Convert_v1beta2_WorkloadTasksStatus_To_v1beta1_WorkloadTasksStatus(in *v1beta2.WorkloadTasksStatus, out *WorkloadTasksStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_WorkloadTasksStatus_To_v1beta1_WorkloadTasksStatus(in, out, s)
+}
@@ -1001,6 +1001,28 @@ func (in *MultiKueueCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueClusterFlavorStatus) DeepCopyInto(out *MultiKueueClusterFlavorStatus) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]MultiKueueClusterResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterFlavorStatus.
+func (in *MultiKueueClusterFlavorStatus) DeepCopy() *MultiKueueClusterFlavorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueClusterFlavorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueClusterList) DeepCopyInto(out *MultiKueueClusterList) {
 	*out = *in
@@ -1033,6 +1055,23 @@ func (in *MultiKueueClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueClusterResourceStatus) DeepCopyInto(out *MultiKueueClusterResourceStatus) {
+	*out = *in
+	out.NominalQuota = in.NominalQuota.DeepCopy()
+	out.Usage = in.Usage.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterResourceStatus.
+func (in *MultiKueueClusterResourceStatus) DeepCopy() *MultiKueueClusterResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueClusterResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueClusterSpec) DeepCopyInto(out *MultiKueueClusterSpec) {
 	*out = *in
@@ -1042,6 +1081,11 @@ func (in *MultiKueueClusterSpec) DeepCopyInto(out *MultiKueueClusterSpec) {
 		*out = new(ClusterProfileReference)
 		**out = **in
 	}
+	if in.CordonPolicy != nil {
+		in, out := &in.CordonPolicy, &out.CordonPolicy
+		*out = new(MultiKueueClusterCordonPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterSpec.
@@ -1064,6 +1108,32 @@ func (in *MultiKueueClusterStatus) DeepCopyInto(out *MultiKueueClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterQueues != nil {
+		in, out := &in.ClusterQueues, &out.ClusterQueues
+		*out = make([]ClusterQueueReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]MultiKueueClusterFlavorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MirroredWorkloads != nil {
+		in, out := &in.MirroredWorkloads, &out.MirroredWorkloads
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncLatencyMilliseconds != nil {
+		in, out := &in.LastSyncLatencyMilliseconds, &out.LastSyncLatencyMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterStatus.
@@ -1246,6 +1316,21 @@ func (in *PodSetRequest) DeepCopy() *PodSetRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetTopologyConstraint) DeepCopyInto(out *PodSetTopologyConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetTopologyConstraint.
+func (in *PodSetTopologyConstraint) DeepCopy() *PodSetTopologyConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetTopologyConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSetTopologyRequest) DeepCopyInto(out *PodSetTopologyRequest) {
 	*out = *in
@@ -1294,6 +1379,26 @@ func (in *PodSetTopologyRequest) DeepCopyInto(out *PodSetTopologyRequest) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.SpreadTopology != nil {
+		in, out := &in.SpreadTopology, &out.SpreadTopology
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpreadMinDomains != nil {
+		in, out := &in.SpreadMinDomains, &out.SpreadMinDomains
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpreadMaxPodsPerDomain != nil {
+		in, out := &in.SpreadMaxPodsPerDomain, &out.SpreadMaxPodsPerDomain
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodSetConstraints != nil {
+		in, out := &in.PodSetConstraints, &out.PodSetConstraints
+		*out = make([]PodSetTopologyConstraint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetTopologyRequest.
@@ -1944,6 +2049,31 @@ func (in *Workload) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDependency) DeepCopyInto(out *WorkloadDependency) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDependency.
+func (in *WorkloadDependency) DeepCopy() *WorkloadDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadList) DeepCopyInto(out *WorkloadList) {
 	*out = *in
@@ -2073,6 +2203,18 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]WorkloadDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSpec.
@@ -2149,6 +2291,11 @@ func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 		*out = make([]UnhealthyNode, len(*in))
 		copy(*out, *in)
 	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = new(WorkloadTasksStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
@@ -2160,3 +2307,18 @@ func (in *WorkloadStatus) DeepCopy() *WorkloadStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadTasksStatus) DeepCopyInto(out *WorkloadTasksStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadTasksStatus.
+func (in *WorkloadTasksStatus) DeepCopy() *WorkloadTasksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadTasksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
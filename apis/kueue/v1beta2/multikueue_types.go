@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,6 +26,10 @@ const (
 	MultiKueueConfigSecretKey = "kubeconfig"
 	MultiKueueClusterActive   = "Active"
 
+	// MultiKueueClusterCordoned is the condition type reporting whether
+	// the MultiKueueCluster is cordoned or drained, and the progress of the drain.
+	MultiKueueClusterCordoned = "Cordoned"
+
 	// MultiKueueOriginLabel is a label used to track the creator
 	// of multikueue remote objects.
 	MultiKueueOriginLabel = "kueue.x-k8s.io/multikueue-origin"
@@ -32,6 +38,32 @@ const (
 	// the management cluster on remote objects.
 	MultiKueueOriginUIDAnnotation = "kueue.x-k8s.io/multikueue-origin-uid"
 
+	// MultiKueueMigrateAnnotation is set on a Workload running in a worker
+	// cluster to request its migration to another worker cluster.
+	MultiKueueMigrateAnnotation = "kueue.x-k8s.io/multikueue-migrate"
+
+	// MultiKueueMigratedFromAnnotation records the worker cluster a Workload
+	// was migrated from, until the Workload is dispatched again. The cluster is
+	// skipped when the Workload is dispatched again, unless it is the only one
+	// available.
+	MultiKueueMigratedFromAnnotation = "kueue.x-k8s.io/multikueue-migrated-from"
+
+	// MultiKueueCredentialsProviderAnnotation is set on a MultiKueueCluster to
+	// name the credentials provider, registered in the MultiKueue controller,
+	// used to authenticate to the worker cluster.
+	MultiKueueCredentialsProviderAnnotation = "kueue.x-k8s.io/multikueue-credentials-provider"
+
+	// MultiKueueRequiredClusterSelectorAnnotation is set on a job, or its Workload,
+	// to a label selector, for example "topology.kubernetes.io/region in (us-east1,us-east4)".
+	// The Workload is only dispatched to the worker clusters whose MultiKueueCluster
+	// labels match the selector.
+	MultiKueueRequiredClusterSelectorAnnotation = "kueue.x-k8s.io/multikueue-required-cluster-selector"
+
+	// MultiKueuePreferredClusterSelectorAnnotation is set on a job, or its Workload,
+	// to a label selector. The worker clusters whose MultiKueueCluster labels match
+	// the selector are nominated before the other ones.
+	MultiKueuePreferredClusterSelectorAnnotation = "kueue.x-k8s.io/multikueue-preferred-cluster-selector"
+
 	// MultiKueueControllerName is the name used by the MultiKueue
 	// admission check controller.
 	MultiKueueControllerName = "kueue.x-k8s.io/multikueue"
@@ -48,6 +80,20 @@ const (
 	SecretLocationType LocationType = "Secret"
 )
 
+type MultiKueueClusterCordonPolicy string
+
+const (
+	// MultiKueueClusterCordonPolicyNone - Workloads are dispatched to the cluster.
+	MultiKueueClusterCordonPolicyNone MultiKueueClusterCordonPolicy = "None"
+
+	// MultiKueueClusterCordonPolicyCordon - no new Workloads are dispatched to the cluster.
+	MultiKueueClusterCordonPolicyCordon MultiKueueClusterCordonPolicy = "Cordon"
+
+	// MultiKueueClusterCordonPolicyDrain - no new Workloads are dispatched to the cluster,
+	// and the Workloads not yet admitted in the cluster are removed from it.
+	MultiKueueClusterCordonPolicyDrain MultiKueueClusterCordonPolicy = "Drain"
+)
+
 type KubeConfig struct {
 	// location of the KubeConfig.
 	//
@@ -70,6 +116,21 @@ type MultiKueueClusterSpec struct {
 	// clusterSource is the source to connect to the cluster.
 	// +required
 	ClusterSource ClusterSource `json:"clusterSource,omitempty"`
+
+	// cordonPolicy - if set to a value different from None, no new Workloads are
+	// dispatched to the cluster.
+	//
+	// Depending on its value, the Workloads dispatched to the cluster will:
+	//
+	// - None - Workloads are dispatched to the cluster.
+	// - Cordon - Admitted Workloads run to completion, the Workloads not yet admitted remain dispatched.
+	// - Drain - Admitted Workloads run to completion, the Workloads not yet admitted are removed
+	//   from the cluster and dispatched to other clusters.
+	//
+	// +kubebuilder:validation:Enum=None;Cordon;Drain
+	// +kubebuilder:default="None"
+	// +optional
+	CordonPolicy *MultiKueueClusterCordonPolicy `json:"cordonPolicy,omitempty"`
 }
 
 // +kubebuilder:validation:ExactlyOneOf=kubeConfig;clusterProfileRef
@@ -102,6 +163,73 @@ type MultiKueueClusterStatus struct {
 	// +patchMergeKey=type
 	// +kubebuilder:validation:MaxItems=16
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// kueueVersion is the version of Kueue running in the worker cluster, as
+	// reported by the app.kubernetes.io/version label of its Workload
+	// CustomResourceDefinition. It is empty if the version cannot be determined.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	KueueVersion string `json:"kueueVersion,omitempty"`
+
+	// clusterQueues are the names of the ClusterQueues reachable in the worker cluster.
+	// clusterQueues are limited to 1000 items.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=1000
+	ClusterQueues []ClusterQueueReference `json:"clusterQueues,omitempty"`
+
+	// flavors are the nominal quota and usage of the ClusterQueues in the
+	// worker cluster, aggregated per flavor.
+	// flavors are limited to 64 items.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Flavors []MultiKueueClusterFlavorStatus `json:"flavors,omitempty"`
+
+	// mirroredWorkloads is the number of Workloads created in the worker
+	// cluster by this manager.
+	// +optional
+	MirroredWorkloads *int32 `json:"mirroredWorkloads,omitempty"`
+
+	// lastSyncTime is the last time the status of the worker cluster was
+	// successfully read.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// lastSyncLatencyMilliseconds is the time, in milliseconds, it took to
+	// read the status of the worker cluster during the last successful sync.
+	// +optional
+	LastSyncLatencyMilliseconds *int32 `json:"lastSyncLatencyMilliseconds,omitempty"`
+}
+
+// MultiKueueClusterFlavorStatus is the nominal quota and usage of a flavor,
+// summed over the ClusterQueues of the worker cluster.
+type MultiKueueClusterFlavorStatus struct {
+	// name of the flavor.
+	// +required
+	Name ResourceFlavorReference `json:"name"`
+
+	// resources lists the nominal quota and usage for the resources in this flavor.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	// +required
+	Resources []MultiKueueClusterResourceStatus `json:"resources,omitempty"`
+}
+
+type MultiKueueClusterResourceStatus struct {
+	// name of the resource.
+	// +required
+	Name corev1.ResourceName `json:"name,omitempty"`
+
+	// nominalQuota is the sum of the nominal quotas of the resource.
+	// +optional
+	NominalQuota resource.Quantity `json:"nominalQuota,omitempty"`
+
+	// usage is the sum of the quota used by the admitted Workloads.
+	// +optional
+	Usage resource.Quantity `json:"usage,omitempty"`
 }
 
 // +genclient
@@ -59,6 +59,37 @@ const (
 	// is defined.
 	PodSetSliceSizeAnnotation = "kueue.x-k8s.io/podset-slice-size"
 
+	// PodSetSpreadTopologyAnnotation indicates that a PodSet requires
+	// Topology Aware Scheduling, and requires spreading its pods across
+	// the topology domains at the level indicated by the annotation value
+	// (e.g. across zones or racks) to reduce the blast radius of a failure
+	// of a single domain.
+	PodSetSpreadTopologyAnnotation = "kueue.x-k8s.io/podset-spread-topology"
+
+	// PodSetSpreadMinDomainsAnnotation describes the minimum number of distinct
+	// topology domains the PodSet needs to be spread across.
+	//
+	// This annotation requires `kueue.x-k8s.io/podset-spread-topology`.
+	PodSetSpreadMinDomainsAnnotation = "kueue.x-k8s.io/podset-spread-min-domains"
+
+	// PodSetSpreadMaxPodsPerDomainAnnotation describes the maximum number of pods
+	// of the PodSet which can be placed within a single topology domain.
+	//
+	// This annotation requires `kueue.x-k8s.io/podset-spread-topology`.
+	PodSetSpreadMaxPodsPerDomainAnnotation = "kueue.x-k8s.io/podset-spread-max-pods-per-domain"
+
+	// PodSetSameTopologyDomainAsAnnotation indicates that the PodSet needs to be
+	// placed within the same topology domain as other PodSets of the workload.
+	// The value is a comma-separated list of `<podset-name>=<topology-level>`
+	// pairs, e.g. `worker=cloud.com/topology-block`.
+	PodSetSameTopologyDomainAsAnnotation = "kueue.x-k8s.io/podset-same-topology-domain-as"
+
+	// PodSetDifferentTopologyDomainFromAnnotation indicates that the PodSet needs
+	// to be placed in topology domains not used by other PodSets of the workload.
+	// The value is a comma-separated list of `<podset-name>=<topology-level>`
+	// pairs, e.g. `worker=cloud.com/topology-rack`.
+	PodSetDifferentTopologyDomainFromAnnotation = "kueue.x-k8s.io/podset-different-topology-domain-from"
+
 	// TopologySchedulingGate is used to delay scheduling of a Pod until the
 	// nodeSelectors corresponding to the assigned topology domain are injected
 	// into the Pod. For the Pod-based integrations the gate is added in webhook
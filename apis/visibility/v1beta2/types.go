@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -27,6 +28,7 @@ import (
 // +k8s:openapi-gen=true
 // +genclient:nonNamespaced
 // +genclient:method=GetPendingWorkloadsSummary,verb=get,subresource=pendingworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.PendingWorkloadsSummary
+// +genclient:method=GetRemotePendingWorkloadsSummary,verb=get,subresource=remotependingworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.RemotePendingWorkloadsSummary
 type ClusterQueue struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -46,6 +48,7 @@ type ClusterQueueList struct {
 // +kubebuilder:object:root=true
 // +k8s:openapi-gen=true
 // +genclient:method=GetPendingWorkloadsSummary,verb=get,subresource=pendingworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.PendingWorkloadsSummary
+// +genclient:method=GetRemotePendingWorkloadsSummary,verb=get,subresource=remotependingworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.RemotePendingWorkloadsSummary
 type LocalQueue struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -91,6 +94,62 @@ type PendingWorkloadsSummary struct {
 	Items []PendingWorkload `json:"items"`
 }
 
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// RemotePendingWorkloadsSummary contains the pending workloads dispatched by
+// the manager cluster to the queue with the same name in the MultiKueue
+// worker clusters.
+type RemotePendingWorkloadsSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Clusters contains the status of the queue in each worker cluster
+	Clusters []RemoteClusterPendingWorkloads `json:"clusters"`
+
+	// Items contains the pending workloads of all the worker clusters, ordered
+	// by their positions in the queues of the worker clusters
+	Items []RemotePendingWorkload `json:"items"`
+}
+
+// RemoteClusterPendingWorkloads is a user-facing representation of the status
+// of the queue in a MultiKueue worker cluster.
+type RemoteClusterPendingWorkloads struct {
+	// Name indicates the name of the MultiKueueCluster
+	Name string `json:"name"`
+
+	// Error indicates why the pending workloads could not be read from the worker cluster
+	Error string `json:"error,omitempty"`
+
+	// ClusterQueue indicates the status of the ClusterQueue in the worker cluster
+	ClusterQueue *RemoteClusterQueueStatus `json:"clusterQueue,omitempty"`
+}
+
+// RemotePendingWorkload is a user-facing representation of a workload pending
+// in a MultiKueue worker cluster.
+type RemotePendingWorkload struct {
+	// ClusterName indicates the name of the MultiKueueCluster the workload is pending in
+	ClusterName string `json:"clusterName"`
+
+	PendingWorkload `json:",inline"`
+}
+
+// RemoteClusterQueueStatus summarizes the status of a ClusterQueue in a
+// MultiKueue worker cluster.
+type RemoteClusterQueueStatus struct {
+	// Name indicates the name of the ClusterQueue
+	Name v1beta2.ClusterQueueReference `json:"name"`
+
+	// PendingWorkloads indicates the number of workloads pending in the ClusterQueue
+	PendingWorkloads int32 `json:"pendingWorkloads"`
+
+	// ReservingWorkloads indicates the number of workloads reserving quota in the ClusterQueue
+	ReservingWorkloads int32 `json:"reservingWorkloads"`
+
+	// AdmittedWorkloads indicates the number of workloads admitted in the ClusterQueue
+	AdmittedWorkloads int32 `json:"admittedWorkloads"`
+}
+
 // +kubebuilder:object:root=true
 // +k8s:openapi-gen=true
 // +k8s:conversion-gen:explicit-from=net/url.Values
@@ -107,9 +166,91 @@ type PendingWorkloadOptions struct {
 	Limit int64 `json:"limit,omitempty"`
 }
 
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:openapi-gen=true
+// +genclient:nonNamespaced
+// +genclient:method=GetTopologyCapacity,verb=get,subresource=capacity,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.TopologyCapacity
+type Topology struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Capacity TopologyCapacity `json:"capacity"`
+}
+
+// +kubebuilder:object:root=true
+type TopologyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Topology `json:"items"`
+}
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// TopologyCapacity contains the tree of topology domains, with their capacity
+// and usage, for each ResourceFlavor referencing the Topology.
+type TopologyCapacity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Levels indicates the topology levels, ordered from the highest to the lowest
+	Levels []string `json:"levels"`
+
+	// Flavors contains the tree of topology domains for each ResourceFlavor referencing the Topology
+	Flavors []FlavorTopologyCapacity `json:"flavors"`
+}
+
+// FlavorTopologyCapacity is the tree of topology domains for the nodes
+// matching a ResourceFlavor.
+type FlavorTopologyCapacity struct {
+	// Name indicates the name of the ResourceFlavor
+	Name v1beta2.ResourceFlavorReference `json:"name"`
+
+	// Domains contains the domains at the highest topology level
+	Domains []TopologyDomainCapacity `json:"domains"`
+}
+
+// TopologyDomainCapacity is a user-facing representation of a topology domain
+// that summarizes its capacity and usage.
+type TopologyDomainCapacity struct {
+	// Level indicates the topology level of the domain
+	Level string `json:"level"`
+
+	// Value indicates the value of the topology level label for the domain
+	Value string `json:"value"`
+
+	// Capacity indicates the total allocatable capacity of the ready and schedulable nodes in the domain
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// TASUsage indicates the usage coming from workloads admitted by TAS
+	TASUsage corev1.ResourceList `json:"tasUsage,omitempty"`
+
+	// NonTASUsage indicates the usage coming from Pods which are not managed by workloads admitted by TAS
+	NonTASUsage corev1.ResourceList `json:"nonTASUsage,omitempty"`
+
+	// Workloads lists the workloads with Pods assigned to the domain
+	Workloads []TopologyDomainWorkload `json:"workloads,omitempty"`
+
+	// Domains contains the domains at the next topology level
+	Domains []TopologyDomainCapacity `json:"domains,omitempty"`
+}
+
+// TopologyDomainWorkload identifies a workload with Pods assigned to a topology domain.
+type TopologyDomainWorkload struct {
+	// Namespace indicates the namespace of the workload
+	Namespace string `json:"namespace"`
+
+	// Name indicates the name of the workload
+	Name string `json:"name"`
+}
+
 func init() {
 	SchemeBuilder.Register(
 		&PendingWorkloadsSummary{},
 		&PendingWorkloadOptions{},
+		&RemotePendingWorkloadsSummary{},
+		&TopologyCapacity{},
 	)
 }
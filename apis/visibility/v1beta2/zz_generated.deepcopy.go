@@ -21,6 +21,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -82,6 +83,28 @@ func (in *ClusterQueueList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorTopologyCapacity) DeepCopyInto(out *FlavorTopologyCapacity) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]TopologyDomainCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorTopologyCapacity.
+func (in *FlavorTopologyCapacity) DeepCopy() *FlavorTopologyCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorTopologyCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalQueue) DeepCopyInto(out *LocalQueue) {
 	*out = *in
@@ -211,3 +234,251 @@ func (in *PendingWorkloadsSummary) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterPendingWorkloads) DeepCopyInto(out *RemoteClusterPendingWorkloads) {
+	*out = *in
+	if in.ClusterQueue != nil {
+		in, out := &in.ClusterQueue, &out.ClusterQueue
+		*out = new(RemoteClusterQueueStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterPendingWorkloads.
+func (in *RemoteClusterPendingWorkloads) DeepCopy() *RemoteClusterPendingWorkloads {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterPendingWorkloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterQueueStatus) DeepCopyInto(out *RemoteClusterQueueStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterQueueStatus.
+func (in *RemoteClusterQueueStatus) DeepCopy() *RemoteClusterQueueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterQueueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemotePendingWorkload) DeepCopyInto(out *RemotePendingWorkload) {
+	*out = *in
+	in.PendingWorkload.DeepCopyInto(&out.PendingWorkload)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePendingWorkload.
+func (in *RemotePendingWorkload) DeepCopy() *RemotePendingWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(RemotePendingWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemotePendingWorkloadsSummary) DeepCopyInto(out *RemotePendingWorkloadsSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]RemoteClusterPendingWorkloads, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemotePendingWorkload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePendingWorkloadsSummary.
+func (in *RemotePendingWorkloadsSummary) DeepCopy() *RemotePendingWorkloadsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RemotePendingWorkloadsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemotePendingWorkloadsSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Topology) DeepCopyInto(out *Topology) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Capacity.DeepCopyInto(&out.Capacity)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topology.
+func (in *Topology) DeepCopy() *Topology {
+	if in == nil {
+		return nil
+	}
+	out := new(Topology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Topology) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyCapacity) DeepCopyInto(out *TopologyCapacity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]FlavorTopologyCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyCapacity.
+func (in *TopologyCapacity) DeepCopy() *TopologyCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopologyCapacity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainCapacity) DeepCopyInto(out *TopologyDomainCapacity) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.TASUsage != nil {
+		in, out := &in.TASUsage, &out.TASUsage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.NonTASUsage != nil {
+		in, out := &in.NonTASUsage, &out.NonTASUsage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]TopologyDomainWorkload, len(*in))
+		copy(*out, *in)
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]TopologyDomainCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainCapacity.
+func (in *TopologyDomainCapacity) DeepCopy() *TopologyDomainCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainWorkload) DeepCopyInto(out *TopologyDomainWorkload) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainWorkload.
+func (in *TopologyDomainWorkload) DeepCopy() *TopologyDomainWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyList) DeepCopyInto(out *TopologyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Topology, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyList.
+func (in *TopologyList) DeepCopy() *TopologyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopologyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -31,6 +31,11 @@ func (in ClusterQueueList) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.ClusterQueueList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in FlavorTopologyCapacity) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.FlavorTopologyCapacity"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in LocalQueue) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.LocalQueue"
@@ -55,3 +60,48 @@ func (in PendingWorkloadOptions) OpenAPIModelName() string {
 func (in PendingWorkloadsSummary) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.PendingWorkloadsSummary"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RemoteClusterPendingWorkloads) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.RemoteClusterPendingWorkloads"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RemoteClusterQueueStatus) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.RemoteClusterQueueStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RemotePendingWorkload) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.RemotePendingWorkload"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RemotePendingWorkloadsSummary) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.RemotePendingWorkloadsSummary"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Topology) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.Topology"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TopologyCapacity) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.TopologyCapacity"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TopologyDomainCapacity) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.TopologyDomainCapacity"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TopologyDomainWorkload) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.TopologyDomainWorkload"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TopologyList) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.TopologyList"
+}
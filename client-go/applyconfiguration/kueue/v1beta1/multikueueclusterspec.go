@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
 // MultiKueueClusterSpecApplyConfiguration represents a declarative configuration of the MultiKueueClusterSpec type for use
 // with apply.
 type MultiKueueClusterSpecApplyConfiguration struct {
@@ -28,6 +32,17 @@ type MultiKueueClusterSpecApplyConfiguration struct {
 	// This is only used to prevent data loss when converting between v1beta2 and v1beta1.
 	// It has no effect in v1beta1.
 	ClusterProfileRef *ClusterProfileReferenceApplyConfiguration `json:"clusterProfileRef,omitempty"`
+	// cordonPolicy - if set to a value different from None, no new Workloads are
+	// dispatched to the cluster.
+	//
+	// Depending on its value, the Workloads dispatched to the cluster will:
+	//
+	// - None - Workloads are dispatched to the cluster.
+	// - Cordon - Admitted Workloads run to completion, the Workloads not yet admitted remain dispatched.
+	// - Drain - Admitted Workloads run to completion, the Workloads not yet admitted are removed
+	// from the cluster and dispatched to other clusters.
+	//
+	CordonPolicy *kueuev1beta1.MultiKueueClusterCordonPolicy `json:"cordonPolicy,omitempty"`
 }
 
 // MultiKueueClusterSpecApplyConfiguration constructs a declarative configuration of the MultiKueueClusterSpec type for use with
@@ -51,3 +66,11 @@ func (b *MultiKueueClusterSpecApplyConfiguration) WithClusterProfileRef(value *C
 	b.ClusterProfileRef = value
 	return b
 }
+
+// WithCordonPolicy sets the CordonPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CordonPolicy field is set to the value of the last call.
+func (b *MultiKueueClusterSpecApplyConfiguration) WithCordonPolicy(value kueuev1beta1.MultiKueueClusterCordonPolicy) *MultiKueueClusterSpecApplyConfiguration {
+	b.CordonPolicy = &value
+	return b
+}
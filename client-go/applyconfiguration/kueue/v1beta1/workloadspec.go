@@ -67,6 +67,21 @@ type WorkloadSpecApplyConfiguration struct {
 	//
 	// If unspecified, no execution time limit is enforced on the Workload.
 	MaximumExecutionTimeSeconds *int32 `json:"maximumExecutionTimeSeconds,omitempty"`
+	// dependencies is a list of Workloads in the same namespace that must
+	// finish before this Workload can be admitted. While any of the
+	// dependencies is not satisfied, the Workload is kept inadmissible in its
+	// ClusterQueue. When a dependency finishes without satisfying its
+	// condition, the Workload is finished as failed.
+	// This field requires the WorkloadDependencies feature gate.
+	Dependencies []WorkloadDependencyApplyConfiguration `json:"dependencies,omitempty"`
+	// tasks is the number of independent tasks tracked by the Workload. When
+	// set, the Workload is a bulk Workload: each pod of its only podSet runs one
+	// task, and the podSet count is the maximum number of tasks running at once.
+	// The tasks are admitted incrementally, starting from a single task, as the
+	// quota of the ClusterQueue allows.
+	// tasks cannot be changed.
+	// This field requires the BulkWorkloads feature gate.
+	Tasks *int32 `json:"tasks,omitempty"`
 }
 
 // WorkloadSpecApplyConfiguration constructs a declarative configuration of the WorkloadSpec type for use with
@@ -135,3 +150,24 @@ func (b *WorkloadSpecApplyConfiguration) WithMaximumExecutionTimeSeconds(value i
 	b.MaximumExecutionTimeSeconds = &value
 	return b
 }
+
+// WithDependencies adds the given value to the Dependencies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Dependencies field.
+func (b *WorkloadSpecApplyConfiguration) WithDependencies(values ...*WorkloadDependencyApplyConfiguration) *WorkloadSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependencies")
+		}
+		b.Dependencies = append(b.Dependencies, *values[i])
+	}
+	return b
+}
+
+// WithTasks sets the Tasks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tasks field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithTasks(value int32) *WorkloadSpecApplyConfiguration {
+	b.Tasks = &value
+	return b
+}
@@ -18,11 +18,26 @@ limitations under the License.
 
 package v1beta2
 
+import (
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
 // MultiKueueClusterSpecApplyConfiguration represents a declarative configuration of the MultiKueueClusterSpec type for use
 // with apply.
 type MultiKueueClusterSpecApplyConfiguration struct {
 	// clusterSource is the source to connect to the cluster.
 	ClusterSource *ClusterSourceApplyConfiguration `json:"clusterSource,omitempty"`
+	// cordonPolicy - if set to a value different from None, no new Workloads are
+	// dispatched to the cluster.
+	//
+	// Depending on its value, the Workloads dispatched to the cluster will:
+	//
+	// - None - Workloads are dispatched to the cluster.
+	// - Cordon - Admitted Workloads run to completion, the Workloads not yet admitted remain dispatched.
+	// - Drain - Admitted Workloads run to completion, the Workloads not yet admitted are removed
+	// from the cluster and dispatched to other clusters.
+	//
+	CordonPolicy *kueuev1beta2.MultiKueueClusterCordonPolicy `json:"cordonPolicy,omitempty"`
 }
 
 // MultiKueueClusterSpecApplyConfiguration constructs a declarative configuration of the MultiKueueClusterSpec type for use with
@@ -38,3 +53,11 @@ func (b *MultiKueueClusterSpecApplyConfiguration) WithClusterSource(value *Clust
 	b.ClusterSource = value
 	return b
 }
+
+// WithCordonPolicy sets the CordonPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CordonPolicy field is set to the value of the last call.
+func (b *MultiKueueClusterSpecApplyConfiguration) WithCordonPolicy(value kueuev1beta2.MultiKueueClusterCordonPolicy) *MultiKueueClusterSpecApplyConfiguration {
+	b.CordonPolicy = &value
+	return b
+}
@@ -19,7 +19,9 @@ limitations under the License.
 package v1beta2
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
 
 // MultiKueueClusterStatusApplyConfiguration represents a declarative configuration of the MultiKueueClusterStatus type for use
@@ -29,6 +31,26 @@ type MultiKueueClusterStatusApplyConfiguration struct {
 	// current state.
 	// conditions are limited to 16 elements.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// kueueVersion is the version of Kueue running in the worker cluster, as
+	// reported by the app.kubernetes.io/version label of its Workload
+	// CustomResourceDefinition. It is empty if the version cannot be determined.
+	KueueVersion *string `json:"kueueVersion,omitempty"`
+	// clusterQueues are the names of the ClusterQueues reachable in the worker cluster.
+	// clusterQueues are limited to 1000 items.
+	ClusterQueues []kueuev1beta2.ClusterQueueReference `json:"clusterQueues,omitempty"`
+	// flavors are the nominal quota and usage of the ClusterQueues in the
+	// worker cluster, aggregated per flavor.
+	// flavors are limited to 64 items.
+	Flavors []MultiKueueClusterFlavorStatusApplyConfiguration `json:"flavors,omitempty"`
+	// mirroredWorkloads is the number of Workloads created in the worker
+	// cluster by this manager.
+	MirroredWorkloads *int32 `json:"mirroredWorkloads,omitempty"`
+	// lastSyncTime is the last time the status of the worker cluster was
+	// successfully read.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// lastSyncLatencyMilliseconds is the time, in milliseconds, it took to
+	// read the status of the worker cluster during the last successful sync.
+	LastSyncLatencyMilliseconds *int32 `json:"lastSyncLatencyMilliseconds,omitempty"`
 }
 
 // MultiKueueClusterStatusApplyConfiguration constructs a declarative configuration of the MultiKueueClusterStatus type for use with
@@ -49,3 +71,58 @@ func (b *MultiKueueClusterStatusApplyConfiguration) WithConditions(values ...*v1
 	}
 	return b
 }
+
+// WithKueueVersion sets the KueueVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KueueVersion field is set to the value of the last call.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithKueueVersion(value string) *MultiKueueClusterStatusApplyConfiguration {
+	b.KueueVersion = &value
+	return b
+}
+
+// WithClusterQueues adds the given value to the ClusterQueues field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ClusterQueues field.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithClusterQueues(values ...kueuev1beta2.ClusterQueueReference) *MultiKueueClusterStatusApplyConfiguration {
+	for i := range values {
+		b.ClusterQueues = append(b.ClusterQueues, values[i])
+	}
+	return b
+}
+
+// WithFlavors adds the given value to the Flavors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Flavors field.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithFlavors(values ...*MultiKueueClusterFlavorStatusApplyConfiguration) *MultiKueueClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFlavors")
+		}
+		b.Flavors = append(b.Flavors, *values[i])
+	}
+	return b
+}
+
+// WithMirroredWorkloads sets the MirroredWorkloads field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MirroredWorkloads field is set to the value of the last call.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithMirroredWorkloads(value int32) *MultiKueueClusterStatusApplyConfiguration {
+	b.MirroredWorkloads = &value
+	return b
+}
+
+// WithLastSyncTime sets the LastSyncTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSyncTime field is set to the value of the last call.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithLastSyncTime(value metav1.Time) *MultiKueueClusterStatusApplyConfiguration {
+	b.LastSyncTime = &value
+	return b
+}
+
+// WithLastSyncLatencyMilliseconds sets the LastSyncLatencyMilliseconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSyncLatencyMilliseconds field is set to the value of the last call.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithLastSyncLatencyMilliseconds(value int32) *MultiKueueClusterStatusApplyConfiguration {
+	b.LastSyncLatencyMilliseconds = &value
+	return b
+}
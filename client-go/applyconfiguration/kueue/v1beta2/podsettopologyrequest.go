@@ -63,6 +63,26 @@ type PodSetTopologyRequestApplyConfiguration struct {
 	// Kueue finds a requested topology domain on a level defined
 	// in `kueue.x-k8s.io/podset-slice-required-topology` annotation.
 	PodSetSliceSize *int32 `json:"podSetSliceSize,omitempty"`
+	// spreadTopology indicates the topology level across which the pods of the
+	// PodSet should be spread, as indicated by the
+	// `kueue.x-k8s.io/podset-spread-topology` annotation.
+	// This is limited to 63 characters.
+	SpreadTopology *string `json:"spreadTopology,omitempty"`
+	// spreadMinDomains indicates the minimum number of distinct topology domains
+	// at the spreadTopology level the PodSet should be spread across, as indicated
+	// by the `kueue.x-k8s.io/podset-spread-min-domains` annotation.
+	SpreadMinDomains *int32 `json:"spreadMinDomains,omitempty"`
+	// spreadMaxPodsPerDomain indicates the maximum number of pods of the PodSet
+	// which can be placed in a single topology domain at the spreadTopology level,
+	// as indicated by the `kueue.x-k8s.io/podset-spread-max-pods-per-domain` annotation.
+	SpreadMaxPodsPerDomain *int32 `json:"spreadMaxPodsPerDomain,omitempty"`
+	// podSetConstraints describes the topology relations between this PodSet
+	// and other PodSets of the workload, as indicated by the
+	// `kueue.x-k8s.io/podset-same-topology-domain-as` and
+	// `kueue.x-k8s.io/podset-different-topology-domain-from` annotations.
+	// The constraints are only evaluated between PodSets assigned to the same
+	// ResourceFlavor.
+	PodSetConstraints []PodSetTopologyConstraintApplyConfiguration `json:"podSetConstraints,omitempty"`
 }
 
 // PodSetTopologyRequestApplyConfiguration constructs a declarative configuration of the PodSetTopologyRequest type for use with
@@ -142,3 +162,40 @@ func (b *PodSetTopologyRequestApplyConfiguration) WithPodSetSliceSize(value int3
 	b.PodSetSliceSize = &value
 	return b
 }
+
+// WithSpreadTopology sets the SpreadTopology field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpreadTopology field is set to the value of the last call.
+func (b *PodSetTopologyRequestApplyConfiguration) WithSpreadTopology(value string) *PodSetTopologyRequestApplyConfiguration {
+	b.SpreadTopology = &value
+	return b
+}
+
+// WithSpreadMinDomains sets the SpreadMinDomains field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpreadMinDomains field is set to the value of the last call.
+func (b *PodSetTopologyRequestApplyConfiguration) WithSpreadMinDomains(value int32) *PodSetTopologyRequestApplyConfiguration {
+	b.SpreadMinDomains = &value
+	return b
+}
+
+// WithSpreadMaxPodsPerDomain sets the SpreadMaxPodsPerDomain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SpreadMaxPodsPerDomain field is set to the value of the last call.
+func (b *PodSetTopologyRequestApplyConfiguration) WithSpreadMaxPodsPerDomain(value int32) *PodSetTopologyRequestApplyConfiguration {
+	b.SpreadMaxPodsPerDomain = &value
+	return b
+}
+
+// WithPodSetConstraints adds the given value to the PodSetConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodSetConstraints field.
+func (b *PodSetTopologyRequestApplyConfiguration) WithPodSetConstraints(values ...*PodSetTopologyConstraintApplyConfiguration) *PodSetTopologyRequestApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodSetConstraints")
+		}
+		b.PodSetConstraints = append(b.PodSetConstraints, *values[i])
+	}
+	return b
+}
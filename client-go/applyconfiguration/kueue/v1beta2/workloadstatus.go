@@ -78,6 +78,8 @@ type WorkloadStatusApplyConfiguration struct {
 	// It indicates Kueue's scheduler is searching for replacements of the failed nodes.
 	// Requires enabling the TASFailedNodeReplacement feature gate.
 	UnhealthyNodes []UnhealthyNodeApplyConfiguration `json:"unhealthyNodes,omitempty"`
+	// tasks reports the aggregated status of the tasks of a bulk Workload.
+	Tasks *WorkloadTasksStatusApplyConfiguration `json:"tasks,omitempty"`
 }
 
 // WorkloadStatusApplyConfiguration constructs a declarative configuration of the WorkloadStatus type for use with
@@ -200,3 +202,11 @@ func (b *WorkloadStatusApplyConfiguration) WithUnhealthyNodes(values ...*Unhealt
 	}
 	return b
 }
+
+// WithTasks sets the Tasks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tasks field is set to the value of the last call.
+func (b *WorkloadStatusApplyConfiguration) WithTasks(value *WorkloadTasksStatusApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	b.Tasks = value
+	return b
+}
@@ -106,6 +106,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.LocalQueueStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MultiKueueCluster"):
 		return &kueuev1beta1.MultiKueueClusterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MultiKueueClusterFlavorStatus"):
+		return &kueuev1beta1.MultiKueueClusterFlavorStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MultiKueueClusterResourceStatus"):
+		return &kueuev1beta1.MultiKueueClusterResourceStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MultiKueueClusterSpec"):
 		return &kueuev1beta1.MultiKueueClusterSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MultiKueueClusterStatus"):
@@ -120,6 +124,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.PodSetAssignmentApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetRequest"):
 		return &kueuev1beta1.PodSetRequestApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodSetTopologyConstraint"):
+		return &kueuev1beta1.PodSetTopologyConstraintApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetTopologyRequest"):
 		return &kueuev1beta1.PodSetTopologyRequestApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetUpdate"):
@@ -166,6 +172,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.UnhealthyNodeApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Workload"):
 		return &kueuev1beta1.WorkloadApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkloadDependency"):
+		return &kueuev1beta1.WorkloadDependencyApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WorkloadPriorityClass"):
 		return &kueuev1beta1.WorkloadPriorityClassApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WorkloadSchedulingStatsEviction"):
@@ -174,6 +182,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.WorkloadSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WorkloadStatus"):
 		return &kueuev1beta1.WorkloadStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkloadTasksStatus"):
+		return &kueuev1beta1.WorkloadTasksStatusApplyConfiguration{}
 
 		// Group=kueue.x-k8s.io, Version=v1beta2
 	case v1beta2.SchemeGroupVersion.WithKind("Admission"):
@@ -242,6 +252,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.LocalQueueStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueCluster"):
 		return &kueuev1beta2.MultiKueueClusterApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterFlavorStatus"):
+		return &kueuev1beta2.MultiKueueClusterFlavorStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterResourceStatus"):
+		return &kueuev1beta2.MultiKueueClusterResourceStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterSpec"):
 		return &kueuev1beta2.MultiKueueClusterSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterStatus"):
@@ -256,6 +270,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.PodSetAssignmentApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetRequest"):
 		return &kueuev1beta2.PodSetRequestApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PodSetTopologyConstraint"):
+		return &kueuev1beta2.PodSetTopologyConstraintApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetTopologyRequest"):
 		return &kueuev1beta2.PodSetTopologyRequestApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetUpdate"):
@@ -308,6 +324,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.UnhealthyNodeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Workload"):
 		return &kueuev1beta2.WorkloadApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("WorkloadDependency"):
+		return &kueuev1beta2.WorkloadDependencyApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadPriorityClass"):
 		return &kueuev1beta2.WorkloadPriorityClassApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadSchedulingStatsEviction"):
@@ -316,6 +334,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.WorkloadSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadStatus"):
 		return &kueuev1beta2.WorkloadStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("WorkloadTasksStatus"):
+		return &kueuev1beta2.WorkloadTasksStatusApplyConfiguration{}
 
 		// Group=visibility.kueue.x-k8s.io, Version=v1beta1
 	case visibilityv1beta1.SchemeGroupVersion.WithKind("ClusterQueue"):
@@ -330,12 +350,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=visibility.kueue.x-k8s.io, Version=v1beta2
 	case visibilityv1beta2.SchemeGroupVersion.WithKind("ClusterQueue"):
 		return &applyconfigurationvisibilityv1beta2.ClusterQueueApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("FlavorTopologyCapacity"):
+		return &applyconfigurationvisibilityv1beta2.FlavorTopologyCapacityApplyConfiguration{}
 	case visibilityv1beta2.SchemeGroupVersion.WithKind("LocalQueue"):
 		return &applyconfigurationvisibilityv1beta2.LocalQueueApplyConfiguration{}
 	case visibilityv1beta2.SchemeGroupVersion.WithKind("PendingWorkload"):
 		return &applyconfigurationvisibilityv1beta2.PendingWorkloadApplyConfiguration{}
 	case visibilityv1beta2.SchemeGroupVersion.WithKind("PendingWorkloadsSummary"):
 		return &applyconfigurationvisibilityv1beta2.PendingWorkloadsSummaryApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("Topology"):
+		return &applyconfigurationvisibilityv1beta2.TopologyApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("TopologyCapacity"):
+		return &applyconfigurationvisibilityv1beta2.TopologyCapacityApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("TopologyDomainCapacity"):
+		return &applyconfigurationvisibilityv1beta2.TopologyDomainCapacityApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("TopologyDomainWorkload"):
+		return &applyconfigurationvisibilityv1beta2.TopologyDomainWorkloadApplyConfiguration{}
 
 	}
 	return nil
@@ -48,6 +48,7 @@ type ClusterQueueInterface interface {
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *visibilityv1beta2.ClusterQueue, err error)
 	Apply(ctx context.Context, clusterQueue *applyconfigurationvisibilityv1beta2.ClusterQueueApplyConfiguration, opts v1.ApplyOptions) (result *visibilityv1beta2.ClusterQueue, err error)
 	GetPendingWorkloadsSummary(ctx context.Context, clusterQueueName string, options v1.GetOptions) (*visibilityv1beta2.PendingWorkloadsSummary, error)
+	GetRemotePendingWorkloadsSummary(ctx context.Context, clusterQueueName string, options v1.GetOptions) (*visibilityv1beta2.RemotePendingWorkloadsSummary, error)
 
 	ClusterQueueExpansion
 }
@@ -83,3 +84,16 @@ func (c *clusterQueues) GetPendingWorkloadsSummary(ctx context.Context, clusterQ
 		Into(result)
 	return
 }
+
+// GetRemotePendingWorkloadsSummary takes name of the clusterQueue, and returns the corresponding visibilityv1beta2.RemotePendingWorkloadsSummary object, and an error if there is any.
+func (c *clusterQueues) GetRemotePendingWorkloadsSummary(ctx context.Context, clusterQueueName string, options v1.GetOptions) (result *visibilityv1beta2.RemotePendingWorkloadsSummary, err error) {
+	result = &visibilityv1beta2.RemotePendingWorkloadsSummary{}
+	err = c.GetClient().Get().
+		Resource("clusterqueues").
+		Name(clusterQueueName).
+		SubResource("remotependingworkloads").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -66,3 +66,14 @@ func (c *fakeClusterQueues) GetPendingWorkloadsSummary(ctx context.Context, clus
 	}
 	return obj.(*v1beta2.PendingWorkloadsSummary), err
 }
+
+// GetRemotePendingWorkloadsSummary takes name of the clusterQueue, and returns the corresponding remotePendingWorkloadsSummary object, and an error if there is any.
+func (c *fakeClusterQueues) GetRemotePendingWorkloadsSummary(ctx context.Context, clusterQueueName string, options v1.GetOptions) (result *v1beta2.RemotePendingWorkloadsSummary, err error) {
+	emptyResult := &v1beta2.RemotePendingWorkloadsSummary{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetSubresourceActionWithOptions(c.Resource(), "remotependingworkloads", clusterQueueName, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta2.RemotePendingWorkloadsSummary), err
+}
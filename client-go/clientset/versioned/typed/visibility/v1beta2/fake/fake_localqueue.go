@@ -65,3 +65,15 @@ func (c *fakeLocalQueues) GetPendingWorkloadsSummary(ctx context.Context, localQ
 	}
 	return obj.(*v1beta2.PendingWorkloadsSummary), err
 }
+
+// GetRemotePendingWorkloadsSummary takes name of the localQueue, and returns the corresponding remotePendingWorkloadsSummary object, and an error if there is any.
+func (c *fakeLocalQueues) GetRemotePendingWorkloadsSummary(ctx context.Context, localQueueName string, options v1.GetOptions) (result *v1beta2.RemotePendingWorkloadsSummary, err error) {
+	emptyResult := &v1beta2.RemotePendingWorkloadsSummary{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(c.Resource(), c.Namespace(), "remotependingworkloads", localQueueName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta2.RemotePendingWorkloadsSummary), err
+}
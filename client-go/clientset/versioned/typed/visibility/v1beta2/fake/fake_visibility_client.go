@@ -36,6 +36,10 @@ func (c *FakeVisibilityV1beta2) LocalQueues(namespace string) v1beta2.LocalQueue
 	return newFakeLocalQueues(c, namespace)
 }
 
+func (c *FakeVisibilityV1beta2) Topologies() v1beta2.TopologyInterface {
+	return newFakeTopologies(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeVisibilityV1beta2) RESTClient() rest.Interface {
@@ -21,3 +21,5 @@ package v1beta2
 type ClusterQueueExpansion interface{}
 
 type LocalQueueExpansion interface{}
+
+type TopologyExpansion interface{}
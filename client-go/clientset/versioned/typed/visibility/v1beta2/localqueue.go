@@ -48,6 +48,7 @@ type LocalQueueInterface interface {
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *visibilityv1beta2.LocalQueue, err error)
 	Apply(ctx context.Context, localQueue *applyconfigurationvisibilityv1beta2.LocalQueueApplyConfiguration, opts v1.ApplyOptions) (result *visibilityv1beta2.LocalQueue, err error)
 	GetPendingWorkloadsSummary(ctx context.Context, localQueueName string, options v1.GetOptions) (*visibilityv1beta2.PendingWorkloadsSummary, error)
+	GetRemotePendingWorkloadsSummary(ctx context.Context, localQueueName string, options v1.GetOptions) (*visibilityv1beta2.RemotePendingWorkloadsSummary, error)
 
 	LocalQueueExpansion
 }
@@ -84,3 +85,17 @@ func (c *localQueues) GetPendingWorkloadsSummary(ctx context.Context, localQueue
 		Into(result)
 	return
 }
+
+// GetRemotePendingWorkloadsSummary takes name of the localQueue, and returns the corresponding visibilityv1beta2.RemotePendingWorkloadsSummary object, and an error if there is any.
+func (c *localQueues) GetRemotePendingWorkloadsSummary(ctx context.Context, localQueueName string, options v1.GetOptions) (result *visibilityv1beta2.RemotePendingWorkloadsSummary, err error) {
+	result = &visibilityv1beta2.RemotePendingWorkloadsSummary{}
+	err = c.GetClient().Get().
+		Namespace(c.GetNamespace()).
+		Resource("localqueues").
+		Name(localQueueName).
+		SubResource("remotependingworkloads").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -114,6 +114,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta2().ClusterQueues().Informer()}, nil
 	case visibilityv1beta2.SchemeGroupVersion.WithResource("localqueues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta2().LocalQueues().Informer()}, nil
+	case visibilityv1beta2.SchemeGroupVersion.WithResource("topologies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta2().Topologies().Informer()}, nil
 
 	}
 
@@ -28,6 +28,8 @@ type Interface interface {
 	ClusterQueues() ClusterQueueInformer
 	// LocalQueues returns a LocalQueueInformer.
 	LocalQueues() LocalQueueInformer
+	// Topologies returns a TopologyInformer.
+	Topologies() TopologyInformer
 }
 
 type version struct {
@@ -50,3 +52,8 @@ func (v *version) ClusterQueues() ClusterQueueInformer {
 func (v *version) LocalQueues() LocalQueueInformer {
 	return &localQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// Topologies returns a TopologyInformer.
+func (v *version) Topologies() TopologyInformer {
+	return &topologyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
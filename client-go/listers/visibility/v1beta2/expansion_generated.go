@@ -29,3 +29,7 @@ type LocalQueueListerExpansion interface{}
 // LocalQueueNamespaceListerExpansion allows custom methods to be added to
 // LocalQueueNamespaceLister.
 type LocalQueueNamespaceListerExpansion interface{}
+
+// TopologyListerExpansion allows custom methods to be added to
+// TopologyLister.
+type TopologyListerExpansion interface{}
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	zaplog "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -53,12 +54,15 @@ import (
 	kueuealpha "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibilityv1beta2 "sigs.k8s.io/kueue/apis/visibility/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/config"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
 	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/externalframeworks"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
 	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/provisioning"
 	"sigs.k8s.io/kueue/pkg/controller/core"
 	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
@@ -103,6 +107,8 @@ func init() {
 	utilruntime.Must(kueue.AddToScheme(scheme))
 	utilruntime.Must(kueuev1beta1.AddToScheme(scheme))
 	utilruntime.Must(kueuealpha.AddToScheme(scheme))
+	// The visibility types are read from the MultiKueue worker clusters.
+	utilruntime.Must(visibilityv1beta2.AddToScheme(scheme))
 	utilruntime.Must(configapiv1beta1.AddToScheme(scheme))
 	utilruntime.Must(configapi.AddToScheme(scheme))
 	utilruntime.Must(autoscaling.AddToScheme(scheme))
@@ -332,7 +338,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := setupControllers(ctx, mgr, cCache, queues, &cfg, serverVersionFetcher, roleTracker); err != nil {
+	var workerClients *workers.Clients
+	if features.Enabled(features.MultiKueue) {
+		workerClients = workers.NewClients()
+	}
+
+	if err := setupControllers(ctx, mgr, cCache, queues, &cfg, serverVersionFetcher, roleTracker, workerClients); err != nil {
 		setupLog.Error(err, "Unable to setup controllers")
 		os.Exit(1)
 	}
@@ -347,7 +358,7 @@ func main() {
 
 	if features.Enabled(features.VisibilityOnDemand) {
 		go func() {
-			if err := visibility.CreateAndStartVisibilityServer(ctx, queues, *cfg.InternalCertManagement.Enable, kubeConfig, parsedTLSConfig); err != nil {
+			if err := visibility.CreateAndStartVisibilityServer(ctx, queues, cCache, workerClients, *cfg.InternalCertManagement.Enable, kubeConfig, parsedTLSConfig); err != nil {
 				setupLog.Error(err, "Unable to create and start visibility server")
 				os.Exit(1)
 			}
@@ -394,10 +405,13 @@ func setupIndexes(ctx context.Context, mgr ctrl.Manager, cfg *configapi.Configur
 	opts := []jobframework.Option{
 		jobframework.WithEnabledFrameworks(cfg.Integrations.Frameworks),
 	}
+	if features.Enabled(features.DeclarativeIntegrations) {
+		opts = append(opts, jobframework.WithDeclarativeFrameworks(cfg.Integrations.DeclarativeFrameworks))
+	}
 	return jobframework.SetupIndexes(ctx, mgr.GetFieldIndexer(), opts...)
 }
 
-func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.Cache, queues *qcache.Manager, cfg *configapi.Configuration, serverVersionFetcher *kubeversion.ServerVersionFetcher, roleTracker *roletracker.RoleTracker) error {
+func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.Cache, queues *qcache.Manager, cfg *configapi.Configuration, serverVersionFetcher *kubeversion.ServerVersionFetcher, roleTracker *roletracker.RoleTracker, workerClients *workers.Clients) error {
 	if failedCtrl, err := core.SetupControllers(mgr, queues, cCache, cfg, roleTracker); err != nil {
 		return fmt.Errorf("unable to create controller %s: %w", failedCtrl, err)
 	}
@@ -441,6 +455,40 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 			}
 		}
 
+		var capacityCache *capacity.Cache
+		capacitySyncInterval := configapi.DefaultMultiKueueCapacitySyncInterval
+		if sd := cfg.MultiKueue.ScoringDispatcher; sd != nil && ptr.Deref(cfg.MultiKueue.DispatcherName, "") == configapi.MultiKueueDispatcherModeScoring {
+			capacityCache = capacity.NewCache()
+			capacitySyncInterval = sd.CapacitySyncInterval.Duration
+		}
+
+		var quotaFederationCache *schdcache.Cache
+		if cfg.MultiKueue.QuotaFederation != nil && features.Enabled(features.MultiKueueQuotaFederation) {
+			quotaFederationCache = cCache
+			if capacityCache == nil {
+				capacityCache = capacity.NewCache()
+			}
+		}
+
+		var statusSyncInterval time.Duration
+		if features.Enabled(features.MultiKueueClusterStats) {
+			statusSyncInterval = cfg.MultiKueue.StatusSyncInterval.Duration
+		}
+
+		var migrationPodsReadyTimeout time.Duration
+		var migrateFromDrainedClusters bool
+		if m := cfg.MultiKueue.Migration; m != nil && features.Enabled(features.MultiKueueWorkloadMigration) {
+			if m.PodsReadyTimeout != nil {
+				migrationPodsReadyTimeout = m.PodsReadyTimeout.Duration
+			}
+			migrateFromDrainedClusters = ptr.Deref(m.FromDrainedClusters, false)
+		}
+
+		var credentialsProviders []multikueue.CredentialsProvider
+		if features.Enabled(features.MultiKueueCredentialsProviders) {
+			credentialsProviders = multikueue.NewCredentialsProviders(cfg.MultiKueue.CredentialsProviders)
+		}
+
 		if err := multikueue.SetupControllers(mgr, *cfg.Namespace,
 			multikueue.WithGCInterval(cfg.MultiKueue.GCInterval.Duration),
 			multikueue.WithOrigin(ptr.Deref(cfg.MultiKueue.Origin, configapi.DefaultMultiKueueOrigin)),
@@ -448,12 +496,19 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 			multikueue.WithAdapters(adapters),
 			multikueue.WithDispatcherName(ptr.Deref(cfg.MultiKueue.DispatcherName, configapi.MultiKueueDispatcherModeAllAtOnce)),
 			multikueue.WithClusterProfiles(cfg.MultiKueue.ClusterProfile),
+			multikueue.WithCapacityCache(capacityCache, capacitySyncInterval),
+			multikueue.WithWorkerClients(workerClients),
+			multikueue.WithStatusSyncInterval(statusSyncInterval),
+			multikueue.WithMigrationPodsReadyTimeout(migrationPodsReadyTimeout),
+			multikueue.WithMigrationFromDrainedClusters(migrateFromDrainedClusters),
+			multikueue.WithQuotaFederation(quotaFederationCache, queues),
+			multikueue.WithCredentialsProviders(credentialsProviders...),
 			multikueue.WithRoleTracker(roleTracker),
 		); err != nil {
 			return fmt.Errorf("could not setup MultiKueue controller: %w", err)
 		}
 
-		if failedDispatcher, err := dispatcher.SetupControllers(mgr, cfg, roleTracker); err != nil {
+		if failedDispatcher, err := dispatcher.SetupControllers(mgr, cfg, roleTracker, capacityCache); err != nil {
 			return fmt.Errorf("could not setup Dispatcher controller %q for MultiKueue: %w", failedDispatcher, err)
 		}
 	}
@@ -475,6 +530,7 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 		jobframework.WithCache(cCache),
 		jobframework.WithQueues(queues),
 		jobframework.WithObjectRetentionPolicies(cfg.ObjectRetentionPolicies),
+		jobframework.WithCheckpointAwareEviction(cfg.CheckpointAwareEviction),
 		jobframework.WithRoleTracker(roleTracker),
 	}
 	nsSelector, err := metav1.LabelSelectorAsSelector(cfg.ManagedJobsNamespaceSelector)
@@ -482,6 +538,9 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 		return fmt.Errorf("failed to parse managedJobsNamespaceSelector: %w", err)
 	}
 	opts = append(opts, jobframework.WithManagedJobsNamespaceSelector(nsSelector))
+	if features.Enabled(features.DeclarativeIntegrations) {
+		opts = append(opts, jobframework.WithDeclarativeFrameworks(cfg.Integrations.DeclarativeFrameworks))
+	}
 
 	if err := jobframework.SetupControllers(ctx, mgr, setupLog, opts...); err != nil {
 		return fmt.Errorf("unable to create controller or webhook for kubernetesVersion %v: %w", serverVersionFetcher.GetServerVersion(), err)
@@ -26,12 +26,16 @@ import (
 
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/cordon"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/create"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/list"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/migrate"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/passthrough"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/resume"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/stop"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/uncordon"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/version"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/view"
 )
 
 type KueuectlOptions struct {
@@ -78,9 +82,13 @@ func NewKueuectlCmd(o KueuectlOptions) *cobra.Command {
 	cmd.AddCommand(create.NewCreateCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(resume.NewResumeCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(stop.NewStopCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(cordon.NewCordonCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(uncordon.NewUncordonCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(migrate.NewMigrateCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(list.NewListCmd(clientGetter, o.IOStreams, o.Clock))
 	cmd.AddCommand(passthrough.NewCommands(clientGetter, o.IOStreams)...)
 	cmd.AddCommand(version.NewVersionCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(view.NewViewCmd(clientGetter, o.IOStreams))
 
 	return cmd
 }
@@ -0,0 +1,150 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cordon
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+	kueuev1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta2"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+)
+
+var (
+	mkcLong = templates.LongDesc(`
+		Stops dispatching new workloads to the given MultiKueueCluster.
+
+		The workloads admitted in the cluster run to completion. With --drain,
+		the workloads not yet admitted in the cluster are removed from it
+		and dispatched to other clusters.
+	`)
+	mkcExample = templates.Examples(`
+		# Cordon the multikueuecluster
+		kueuectl cordon multikueuecluster my-multikueuecluster
+
+		# Drain the multikueuecluster
+		kueuectl cordon multikueuecluster my-multikueuecluster --drain
+	`)
+)
+
+type MultiKueueClusterOptions struct {
+	MultiKueueClusterName string
+	Drain                 bool
+	Client                kueuev1beta2.KueueV1beta2Interface
+	PrintFlags            *genericclioptions.PrintFlags
+	PrintObj              printers.ResourcePrinterFunc
+	genericiooptions.IOStreams
+}
+
+func NewMultiKueueClusterOptions(streams genericiooptions.IOStreams) *MultiKueueClusterOptions {
+	return &MultiKueueClusterOptions{
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(scheme.Scheme),
+		IOStreams:  streams,
+	}
+}
+
+func NewMultiKueueClusterCmd(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewMultiKueueClusterOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "multikueuecluster NAME [--drain]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"mkc"},
+		Short:                 "Cordon the MultiKueueCluster",
+		Long:                  mkcLong,
+		Example:               mkcExample,
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgsFunction:     completion.MultiKueueClusterNameFunc(clientGetter),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	o.PrintFlags.AddFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.Drain, "drain", false,
+		"Indicates whether to remove the workloads not yet admitted from the cluster.")
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *MultiKueueClusterOptions) Complete(clientGetter clientgetter.ClientGetter, args []string) error {
+	o.MultiKueueClusterName = args[0]
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta2()
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	o.PrintObj = printer.PrintObj
+
+	return nil
+}
+
+// Run executes the command
+func (o *MultiKueueClusterOptions) Run(ctx context.Context) error {
+	mkc, err := o.Client.MultiKueueClusters().Get(ctx, o.MultiKueueClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	mkcOriginal := mkc.DeepCopy()
+	if o.Drain {
+		mkc.Spec.CordonPolicy = ptr.To(kueue.MultiKueueClusterCordonPolicyDrain)
+	} else {
+		mkc.Spec.CordonPolicy = ptr.To(kueue.MultiKueueClusterCordonPolicyCordon)
+	}
+
+	opts := metav1.PatchOptions{}
+	patch := client.MergeFrom(mkcOriginal)
+	data, err := patch.Data(mkc)
+	if err != nil {
+		return err
+	}
+	mkc, err = o.Client.MultiKueueClusters().
+		Patch(ctx, o.MultiKueueClusterName, types.MergePatchType, data, opts)
+	if err != nil {
+		return err
+	}
+
+	return o.PrintObj(mkc, o.Out)
+}
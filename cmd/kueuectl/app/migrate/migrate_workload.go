@@ -0,0 +1,169 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+	kueuev1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta2"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/dryrun"
+)
+
+var (
+	wlLong = templates.LongDesc(`
+		Requests the migration of the given Workload, running in a MultiKueue
+		worker cluster, to another worker cluster.
+
+		The remote objects are deleted from the worker cluster and the Workload
+		is put back in the queue, to be dispatched to another worker cluster.
+		It requires the MultiKueueWorkloadMigration feature gate to be enabled
+		in the manager cluster.
+	`)
+	wlExample = templates.Examples(`
+		# Migrate the workload
+		kueuectl migrate workload my-workload
+	`)
+)
+
+type WorkloadOptions struct {
+	PrintFlags *genericclioptions.PrintFlags
+
+	DryRunStrategy dryrun.Strategy
+	Name           string
+	Namespace      string
+
+	Client kueuev1beta2.KueueV1beta2Interface
+
+	PrintObj printers.ResourcePrinterFunc
+
+	genericiooptions.IOStreams
+}
+
+func NewWorkloadOptions(streams genericiooptions.IOStreams) *WorkloadOptions {
+	return &WorkloadOptions{
+		PrintFlags: genericclioptions.NewPrintFlags("migrated").WithTypeSetter(scheme.Scheme),
+		IOStreams:  streams,
+	}
+}
+
+func NewWorkloadCmd(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewWorkloadOptions(streams)
+
+	cmd := &cobra.Command{
+		Use: "workload NAME [--namespace NAMESPACE] [--dry-run STRATEGY]",
+		// To do not add "[flags]" suffix on the end of usage line
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"kueueworkload", "kueueworkloads", "kwl"},
+		Short:                 "Migrate the Workload to another MultiKueue worker cluster",
+		Long:                  wlLong,
+		Example:               wlExample,
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgsFunction:     completion.WorkloadNameFunc(clientGetter, ptr.To(true)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, cmd, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	o.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *WorkloadOptions) Complete(clientGetter clientgetter.ClientGetter, cmd *cobra.Command, args []string) error {
+	o.Name = args[0]
+
+	var err error
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta2()
+
+	o.DryRunStrategy, err = dryrun.GetStrategy(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = dryrun.PrintFlagsWithStrategy(o.PrintFlags, o.DryRunStrategy)
+	if err != nil {
+		return err
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	o.PrintObj = printer.PrintObj
+
+	return nil
+}
+
+// Run sets the migration annotation on the Workload.
+func (o *WorkloadOptions) Run(ctx context.Context) error {
+	wl, err := o.Client.Workloads(o.Namespace).Get(ctx, o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	wlOriginal := wl.DeepCopy()
+	metav1.SetMetaDataAnnotation(&wl.ObjectMeta, kueue.MultiKueueMigrateAnnotation, "")
+
+	if o.DryRunStrategy != dryrun.Client {
+		opts := metav1.PatchOptions{}
+		if o.DryRunStrategy == dryrun.Server {
+			opts.DryRun = []string{metav1.DryRunAll}
+		}
+		patch := client.MergeFrom(wlOriginal)
+		data, err := patch.Data(wl)
+		if err != nil {
+			return err
+		}
+		wl, err = o.Client.Workloads(o.Namespace).Patch(ctx, wl.Name, types.MergePatchType, data, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return o.PrintObj(wl, o.Out)
+}
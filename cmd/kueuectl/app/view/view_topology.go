@@ -0,0 +1,196 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+	visibilityv1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/visibility/v1beta2"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+)
+
+const (
+	flavorFlagName = "flavor"
+	indentation    = "  "
+	noneValue      = "<none>"
+)
+
+var (
+	topologyLong = templates.LongDesc(`
+		Displays the tree of topology domains of the given Topology, for each
+		ResourceFlavor referencing it. For each domain, the capacity, the usage
+		of workloads admitted by TAS, the usage of other Pods and the workloads
+		assigned to the domain are shown.
+	`)
+	topologyExample = templates.Examples(`
+		# View the topology domains of the topology
+		kueuectl view topology my-topology
+
+		# View the topology domains for the given resource flavor only
+		kueuectl view topology my-topology --flavor my-flavor
+
+		# View the topology domains in YAML format
+		kueuectl view topology my-topology -o yaml
+	`)
+)
+
+type TopologyOptions struct {
+	TopologyName string
+	Flavor       string
+
+	Client     visibilityv1beta2.VisibilityV1beta2Interface
+	PrintFlags *genericclioptions.PrintFlags
+	PrintObj   printers.ResourcePrinterFunc
+
+	genericiooptions.IOStreams
+}
+
+func NewTopologyOptions(streams genericiooptions.IOStreams) *TopologyOptions {
+	return &TopologyOptions{
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(scheme.Scheme),
+		IOStreams:  streams,
+	}
+}
+
+func NewTopologyCmd(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewTopologyOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "topology NAME [--flavor FLAVOR]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"topo"},
+		Short:                 "View the capacity and usage of topology domains",
+		Long:                  topologyLong,
+		Example:               topologyExample,
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgsFunction:     completion.TopologyNameFunc(clientGetter),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	o.PrintFlags.AddFlags(cmd)
+
+	cmd.Flags().StringVar(&o.Flavor, flavorFlagName, "",
+		"Show the topology domains for the given ResourceFlavor only.")
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *TopologyOptions) Complete(clientGetter clientgetter.ClientGetter, args []string) error {
+	o.TopologyName = args[0]
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.VisibilityV1beta2()
+
+	if o.PrintFlags.OutputFormat != nil && *o.PrintFlags.OutputFormat != "" {
+		printer, err := o.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		o.PrintObj = printer.PrintObj
+	}
+
+	return nil
+}
+
+// Run executes the command
+func (o *TopologyOptions) Run(ctx context.Context) error {
+	capacity, err := o.Client.Topologies().GetTopologyCapacity(ctx, o.TopologyName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if o.Flavor != "" {
+		capacity.Flavors = slices.DeleteFunc(capacity.Flavors, func(f visibility.FlavorTopologyCapacity) bool {
+			return string(f.Name) != o.Flavor
+		})
+		if len(capacity.Flavors) == 0 {
+			return fmt.Errorf("resource flavor %q does not reference topology %q", o.Flavor, o.TopologyName)
+		}
+	}
+
+	if o.PrintObj != nil {
+		return o.PrintObj(capacity, o.Out)
+	}
+
+	return printTopologyCapacity(o.Out, capacity)
+}
+
+func printTopologyCapacity(out io.Writer, capacity *visibility.TopologyCapacity) error {
+	w := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "FLAVOR\tDOMAIN\tLEVEL\tCAPACITY\tTAS USAGE\tNON-TAS USAGE\tWORKLOADS")
+	for _, flavor := range capacity.Flavors {
+		printTopologyDomains(w, flavor.Name, flavor.Domains, 0)
+	}
+	return w.Flush()
+}
+
+func printTopologyDomains(w io.Writer, flavor kueue.ResourceFlavorReference, domains []visibility.TopologyDomainCapacity, depth int) {
+	for _, domain := range domains {
+		workloads := noneValue
+		if len(domain.Workloads) > 0 {
+			names := make([]string, 0, len(domain.Workloads))
+			for _, wl := range domain.Workloads {
+				names = append(names, wl.Namespace+"/"+wl.Name)
+			}
+			workloads = strings.Join(names, ",")
+		}
+		fmt.Fprintf(w, "%s\t%s%s\t%s\t%s\t%s\t%s\t%s\n",
+			flavor, strings.Repeat(indentation, depth), domain.Value, domain.Level,
+			resourceListString(domain.Capacity), resourceListString(domain.TASUsage),
+			resourceListString(domain.NonTASUsage), workloads)
+		printTopologyDomains(w, flavor, domain.Domains, depth+1)
+	}
+}
+
+func resourceListString(rl corev1.ResourceList) string {
+	values := make([]string, 0, len(rl))
+	for _, name := range slices.Sorted(maps.Keys(rl)) {
+		quantity := rl[name]
+		values = append(values, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	return strings.Join(values, ",")
+}
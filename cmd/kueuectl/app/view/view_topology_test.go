@@ -0,0 +1,182 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubetesting "k8s.io/client-go/testing"
+
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+)
+
+func TestTopologyCmd(t *testing.T) {
+	topologyCapacity := &visibility.TopologyCapacity{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Levels:     []string{"cloud.com/topology-block", corev1.LabelHostname},
+		Flavors: []visibility.FlavorTopologyCapacity{
+			{
+				Name: "tas-a",
+				Domains: []visibility.TopologyDomainCapacity{{
+					Level: "cloud.com/topology-block",
+					Value: "b1",
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:  resource.MustParse("4"),
+						corev1.ResourcePods: resource.MustParse("20"),
+					},
+					TASUsage: corev1.ResourceList{
+						corev1.ResourceCPU:  resource.MustParse("1"),
+						corev1.ResourcePods: resource.MustParse("1"),
+					},
+					Workloads: []visibility.TopologyDomainWorkload{{Namespace: "default", Name: "wl-a"}},
+					Domains: []visibility.TopologyDomainCapacity{
+						{
+							Level: corev1.LabelHostname,
+							Value: "x1",
+							Capacity: corev1.ResourceList{
+								corev1.ResourceCPU:  resource.MustParse("2"),
+								corev1.ResourcePods: resource.MustParse("10"),
+							},
+							TASUsage: corev1.ResourceList{
+								corev1.ResourceCPU:  resource.MustParse("1"),
+								corev1.ResourcePods: resource.MustParse("1"),
+							},
+							Workloads: []visibility.TopologyDomainWorkload{{Namespace: "default", Name: "wl-a"}},
+						},
+						{
+							Level: corev1.LabelHostname,
+							Value: "x2",
+							Capacity: corev1.ResourceList{
+								corev1.ResourceCPU:  resource.MustParse("2"),
+								corev1.ResourcePods: resource.MustParse("10"),
+							},
+							NonTASUsage: corev1.ResourceList{
+								corev1.ResourceCPU:  resource.MustParse("500m"),
+								corev1.ResourcePods: resource.MustParse("1"),
+							},
+						},
+					},
+				}},
+			},
+			{
+				Name: "tas-b",
+				Domains: []visibility.TopologyDomainCapacity{{
+					Level: "cloud.com/topology-block",
+					Value: "b2",
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("8"),
+					},
+				}},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		args       []string
+		getErr     error
+		wantOut    string
+		wantOutErr string
+		wantErr    error
+	}{
+		"should print the tree of topology domains": {
+			args: []string{"default"},
+			wantOut: `FLAVOR   DOMAIN   LEVEL                      CAPACITY        TAS USAGE      NON-TAS USAGE     WORKLOADS
+tas-a    b1       cloud.com/topology-block   cpu=4,pods=20   cpu=1,pods=1                     default/wl-a
+tas-a      x1     kubernetes.io/hostname     cpu=2,pods=10   cpu=1,pods=1                     default/wl-a
+tas-a      x2     kubernetes.io/hostname     cpu=2,pods=10                  cpu=500m,pods=1   <none>
+tas-b    b2       cloud.com/topology-block   cpu=8                                            <none>
+`,
+		},
+		"should print the tree of topology domains for the flavor": {
+			args: []string{"default", "--flavor", "tas-b"},
+			wantOut: `FLAVOR   DOMAIN   LEVEL                      CAPACITY   TAS USAGE   NON-TAS USAGE   WORKLOADS
+tas-b    b2       cloud.com/topology-block   cpu=8                                  <none>
+`,
+		},
+		"should fail for a flavor not referencing the topology": {
+			args:    []string{"default", "--flavor", "missing"},
+			wantErr: errors.New(`resource flavor "missing" does not reference topology "default"`),
+			wantOutErr: `Error: resource flavor "missing" does not reference topology "default"
+`,
+		},
+		"should print the topology capacity in yaml": {
+			args: []string{"default", "--flavor", "tas-b", "-o", "yaml"},
+			wantOut: `apiVersion: visibility.kueue.x-k8s.io/v1beta2
+flavors:
+- domains:
+  - capacity:
+      cpu: "8"
+    level: cloud.com/topology-block
+    value: b2
+  name: tas-b
+kind: TopologyCapacity
+levels:
+- cloud.com/topology-block
+- kubernetes.io/hostname
+metadata:
+  name: default
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			clientset := fake.NewSimpleClientset()
+			clientset.PrependReactor("get", "topologies", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+				return true, topologyCapacity.DeepCopy(), nil
+			})
+			tcg := cmdtesting.NewTestClientGetter().WithKueueClientset(clientset)
+
+			cmd := NewTopologyCmd(tcg, streams)
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrMsg, wantErrMsg string
+			if gotErr != nil {
+				gotErrMsg = gotErr.Error()
+			}
+			if tc.wantErr != nil {
+				wantErrMsg = tc.wantErr.Error()
+			}
+			if diff := cmp.Diff(wantErrMsg, gotErrMsg); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			gotOut := out.String()
+			if diff := cmp.Diff(tc.wantOut, gotOut); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			gotOutErr := outErr.String()
+			if diff := cmp.Diff(tc.wantOutErr, gotOutErr); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -235,6 +235,10 @@ func (c *ClusterQueue) AddFromLocalQueue(q *LocalQueue, roleTracker *roletracker
 	defer c.rwm.Unlock()
 	added := false
 	for _, info := range q.items {
+		if workload.HasPendingDependencies(info.Obj) {
+			c.inadmissibleWorkloads.insert(workload.Key(info.Obj), info)
+			continue
+		}
 		if c.heap.PushIfNotPresent(info) {
 			added = true
 		}
@@ -256,20 +260,23 @@ func (c *ClusterQueue) PushOrUpdate(wInfo *workload.Info) {
 	if oldInfo := c.inadmissibleWorkloads.get(key); oldInfo != nil {
 		// update in place if the workload was inadmissible and didn't change
 		// to potentially become admissible, unless the Eviction status changed
-		// which can affect the workloads order in the queue.
+		// which can affect the workloads order in the queue, or its dependencies
+		// got satisfied.
 		if equality.Semantic.DeepEqual(oldInfo.Obj.Spec, wInfo.Obj.Spec) &&
 			equality.Semantic.DeepEqual(oldInfo.Obj.Status.ReclaimablePods, wInfo.Obj.Status.ReclaimablePods) &&
 			equality.Semantic.DeepEqual(apimeta.FindStatusCondition(oldInfo.Obj.Status.Conditions, kueue.WorkloadEvicted),
 				apimeta.FindStatusCondition(wInfo.Obj.Status.Conditions, kueue.WorkloadEvicted)) &&
 			equality.Semantic.DeepEqual(apimeta.FindStatusCondition(oldInfo.Obj.Status.Conditions, kueue.WorkloadRequeued),
-				apimeta.FindStatusCondition(wInfo.Obj.Status.Conditions, kueue.WorkloadRequeued)) {
+				apimeta.FindStatusCondition(wInfo.Obj.Status.Conditions, kueue.WorkloadRequeued)) &&
+			equality.Semantic.DeepEqual(apimeta.FindStatusCondition(oldInfo.Obj.Status.Conditions, kueue.WorkloadDependenciesSatisfied),
+				apimeta.FindStatusCondition(wInfo.Obj.Status.Conditions, kueue.WorkloadDependenciesSatisfied)) {
 			c.inadmissibleWorkloads.insert(key, wInfo)
 			return
 		}
 		// otherwise move or update in place in the queue.
 		c.inadmissibleWorkloads.delete(key)
 	}
-	if c.heap.GetByKey(key) == nil && !c.backoffWaitingTimeExpired(wInfo) {
+	if c.heap.GetByKey(key) == nil && !c.readyToQueue(wInfo) {
 		c.inadmissibleWorkloads.insert(key, wInfo)
 		return
 	}
@@ -286,6 +293,12 @@ func (c *ClusterQueue) RebuildLocalQueue(lqName string) {
 	}
 }
 
+// readyToQueue returns true if the workload can be moved to the heap, that is
+// its backoff waiting time expired and it has no pending dependencies.
+func (c *ClusterQueue) readyToQueue(wInfo *workload.Info) bool {
+	return c.backoffWaitingTimeExpired(wInfo) && !workload.HasPendingDependencies(wInfo.Obj)
+}
+
 // backoffWaitingTimeExpired returns true if the current time is after the requeueAt
 // and Requeued condition not present or equal True.
 func (c *ClusterQueue) backoffWaitingTimeExpired(wInfo *workload.Info) bool {
@@ -349,7 +362,7 @@ func (c *ClusterQueue) requeueIfNotPresent(log logr.Logger, wInfo *workload.Info
 
 	inadmissibleWl := c.inadmissibleWorkloads.get(key)
 
-	if c.backoffWaitingTimeExpired(wInfo) &&
+	if c.readyToQueue(wInfo) &&
 		(immediate || c.queueInadmissibleCycle >= c.popCycle || wInfo.LastAssignment.PendingFlavors()) {
 		// If the workload was inadmissible, move it back into the queue.
 		if inadmissibleWl != nil {
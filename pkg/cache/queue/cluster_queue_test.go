@@ -70,10 +70,12 @@ func Test_PushOrUpdate(t *testing.T) {
 	}
 	wlBase := utiltestingapi.MakeWorkload("workload-1", defaultNamespace).Clone()
 
+	dependency := kueue.WorkloadDependency{Name: ptr.To("workload-0")}
 	cases := map[string]struct {
-		workload                  *utiltestingapi.WorkloadWrapper
-		wantWorkload              *workload.Info
-		wantInAdmissibleWorkloads inadmissibleWorkloads
+		workload                   *utiltestingapi.WorkloadWrapper
+		enableWorkloadDependencies bool
+		wantWorkload               *workload.Info
+		wantInAdmissibleWorkloads  inadmissibleWorkloads
 	}{
 		"workload doesn't have re-queue state": {
 			workload:     wlBase.Clone(),
@@ -157,9 +159,46 @@ func Test_PushOrUpdate(t *testing.T) {
 				}).
 				Obj()),
 		},
+		"workload with pending dependencies": {
+			workload:                   wlBase.Clone().Dependency(dependency),
+			enableWorkloadDependencies: true,
+			wantInAdmissibleWorkloads: inadmissibleWorkloads{
+				"default/workload-1": workload.NewInfo(wlBase.Clone().
+					ResourceVersion("1").
+					Dependency(dependency).
+					Obj()),
+			},
+		},
+		"workload with satisfied dependencies": {
+			workload: wlBase.Clone().
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadDependenciesSatisfied,
+					Reason: kueue.WorkloadDependenciesFinished,
+					Status: metav1.ConditionTrue,
+				}),
+			enableWorkloadDependencies: true,
+			wantWorkload: workload.NewInfo(wlBase.Clone().
+				ResourceVersion("1").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadDependenciesSatisfied,
+					Reason: kueue.WorkloadDependenciesFinished,
+					Status: metav1.ConditionTrue,
+				}).
+				Obj()),
+		},
+		"workload with dependencies when the feature is disabled": {
+			workload: wlBase.Clone().Dependency(dependency),
+			wantWorkload: workload.NewInfo(wlBase.Clone().
+				ResourceVersion("1").
+				Dependency(dependency).
+				Obj()),
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, tc.enableWorkloadDependencies)
 			ctx, _ := utiltesting.ContextWithLog(t)
 			cq := newClusterQueueImpl(ctx, nil, defaultOrdering, fakeClock)
 
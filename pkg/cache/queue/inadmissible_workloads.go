@@ -158,7 +158,7 @@ func queueInadmissibleWorkloads(ctx context.Context, c *ClusterQueue, client cli
 	for key, wInfo := range c.inadmissibleWorkloads {
 		ns := corev1.Namespace{}
 		err := client.Get(ctx, types.NamespacedName{Name: wInfo.Obj.Namespace}, &ns)
-		if err != nil || !c.namespaceSelector.Matches(labels.Set(ns.Labels)) || !c.backoffWaitingTimeExpired(wInfo) {
+		if err != nil || !c.namespaceSelector.Matches(labels.Set(ns.Labels)) || !c.readyToQueue(wInfo) {
 			newInadmissibleWorkloads.insert(key, wInfo)
 		} else if c.heap.PushIfNotPresent(wInfo) {
 			moved++
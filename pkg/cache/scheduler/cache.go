@@ -612,6 +612,21 @@ func (c *Cache) addOrUpdateWorkloadWithoutLock(log logr.Logger, wl *kueue.Worklo
 	return true, nil
 }
 
+// SetForeignUsage sets the quota reserved outside of this cluster which counts
+// against the ClusterQueue, such as the quota reserved in the MultiKueue worker
+// clusters by the workloads not dispatched by this manager. It is counted as
+// usage of the ClusterQueue when admitting workloads. It returns whether the
+// foreign usage of the ClusterQueue changed.
+func (c *Cache) SetForeignUsage(cqName kueue.ClusterQueueReference, usage resources.FlavorResourceQuantities) bool {
+	c.Lock()
+	defer c.Unlock()
+	cq := c.hm.ClusterQueue(cqName)
+	if cq == nil {
+		return false
+	}
+	return cq.setForeignUsage(usage)
+}
+
 func (c *Cache) GetWorkloadFromCache(wlKey workload.Reference) *kueue.Workload {
 	c.RLock()
 	defer c.RUnlock()
@@ -1925,6 +1925,109 @@ func TestClusterQueueUsage(t *testing.T) {
 	}
 }
 
+func TestSetForeignUsage(t *testing.T) {
+	cq := utiltestingapi.MakeClusterQueue("foo").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "10").
+				Obj(),
+		).
+		Obj()
+	wl := utiltestingapi.MakeWorkload("one", "").
+		Request(corev1.ResourceCPU, "2").
+		ReserveQuotaAt(utiltestingapi.MakeAdmission("foo").
+			PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+				Assignment(corev1.ResourceCPU, "default", "2").
+				Obj()).
+			Obj(), time.Now()).
+		Obj()
+	reserved := func(cpu string) []kueue.FlavorUsage {
+		return []kueue.FlavorUsage{{
+			Name: "default",
+			Resources: []kueue.ResourceUsage{{
+				Name:  corev1.ResourceCPU,
+				Total: resource.MustParse(cpu),
+			}},
+		}}
+	}
+	defaultCPU := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+
+	cases := []struct {
+		name                      string
+		cqName                    kueue.ClusterQueueReference
+		usage                     resources.FlavorResourceQuantities
+		wantChanged               bool
+		wantReservedResources     []kueue.FlavorUsage
+		wantAllocatableGeneration int64
+	}{
+		{
+			name:   "the usage of the flavors and resources without quota is ignored",
+			cqName: "foo",
+			usage: resources.FlavorResourceQuantities{
+				defaultCPU: 3000,
+				{Flavor: "other", Resource: corev1.ResourceCPU}:      5000,
+				{Flavor: "default", Resource: corev1.ResourceMemory}: 1,
+			},
+			wantChanged:               true,
+			wantReservedResources:     reserved("5"),
+			wantAllocatableGeneration: 1,
+		},
+		{
+			name:                      "unchanged usage",
+			cqName:                    "foo",
+			usage:                     resources.FlavorResourceQuantities{defaultCPU: 3000},
+			wantReservedResources:     reserved("5"),
+			wantAllocatableGeneration: 1,
+		},
+		{
+			name:                      "increased usage",
+			cqName:                    "foo",
+			usage:                     resources.FlavorResourceQuantities{defaultCPU: 4000},
+			wantChanged:               true,
+			wantReservedResources:     reserved("6"),
+			wantAllocatableGeneration: 1,
+		},
+		{
+			name:                      "removed usage makes more workloads fit",
+			cqName:                    "foo",
+			wantChanged:               true,
+			wantReservedResources:     reserved("2"),
+			wantAllocatableGeneration: 2,
+		},
+		{
+			name:                      "nonexistent ClusterQueue",
+			cqName:                    "bar",
+			usage:                     resources.FlavorResourceQuantities{defaultCPU: 4000},
+			wantReservedResources:     reserved("2"),
+			wantAllocatableGeneration: 2,
+		},
+	}
+
+	cache := New(utiltesting.NewFakeClient())
+	ctx, log := utiltesting.ContextWithLog(t)
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding ClusterQueue: %v", err)
+	}
+	if added := cache.AddOrUpdateWorkload(log, wl); !added {
+		t.Fatalf("Workload %s was not added", workload.Key(wl))
+	}
+	for _, tc := range cases {
+		if changed := cache.SetForeignUsage(tc.cqName, tc.usage); changed != tc.wantChanged {
+			t.Errorf("%s: got changed %t, want %t", tc.name, changed, tc.wantChanged)
+		}
+		stats, err := cache.Usage(cq)
+		if err != nil {
+			t.Fatalf("%s: couldn't get usage: %v", tc.name, err)
+		}
+		if diff := cmp.Diff(tc.wantReservedResources, stats.ReservedResources); diff != "" {
+			t.Errorf("%s: unexpected reserved resources (-want,+got):\n%s", tc.name, diff)
+		}
+		if got := cache.hm.ClusterQueue("foo").AllocatableResourceGeneration; got != tc.wantAllocatableGeneration {
+			t.Errorf("%s: got allocatable resource generation %d, want %d", tc.name, got, tc.wantAllocatableGeneration)
+		}
+	}
+}
+
 func TestLocalQueueUsage(t *testing.T) {
 	now := time.Now().Truncate(time.Second)
 	cq := *utiltestingapi.MakeClusterQueue("foo").
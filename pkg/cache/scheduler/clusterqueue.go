@@ -68,6 +68,9 @@ type clusterQueue struct {
 	AllocatableResourceGeneration int64
 
 	AdmittedUsage resources.FlavorResourceQuantities
+	// foreignUsage is the quota reserved outside of this cluster which counts
+	// against the ClusterQueue, included in the usage of its resourceNode.
+	foreignUsage resources.FlavorResourceQuantities
 	// localQueues by (namespace/name).
 	localQueues                        map[queue.LocalQueueReference]*LocalQueue
 	podsReadyTracking                  bool
@@ -548,6 +551,33 @@ func (c *clusterQueue) updateWorkloadTASUsage(log logr.Logger, wi *workload.Info
 	}
 }
 
+// setForeignUsage replaces the foreign usage of the ClusterQueue, restricted to
+// the flavors and resources with a quota in the ClusterQueue. It returns whether
+// the foreign usage changed.
+func (c *clusterQueue) setForeignUsage(usage resources.FlavorResourceQuantities) bool {
+	newUsage := make(resources.FlavorResourceQuantities, len(usage))
+	for fr, q := range usage {
+		if _, found := c.resourceNode.Quotas[fr]; found && q > 0 {
+			newUsage[fr] = q
+		}
+	}
+	if maps.Equal(c.foreignUsage, newUsage) {
+		return false
+	}
+	for fr, q := range c.foreignUsage {
+		removeUsage(c, fr, q)
+		if newUsage[fr] < q {
+			// Less usage can make more workloads fit in the ClusterQueue.
+			c.AllocatableResourceGeneration++
+		}
+	}
+	for fr, q := range newUsage {
+		addUsage(c, fr, q)
+	}
+	c.foreignUsage = newUsage
+	return true
+}
+
 func updateFlavorUsage(newUsage resources.FlavorResourceQuantities, oldUsage resources.FlavorResourceQuantities, op usageOp) {
 	for fr, q := range newUsage {
 		oldUsage[fr] += q * int64(op.asSignedOne())
@@ -198,13 +198,23 @@ func (c *ClusterQueueSnapshot) FindTopologyAssignmentsForWorkload(
 	}
 
 	result := make(TASAssignmentsResult)
-	for tasFlavor, flavorTASRequests := range tasRequestsByFlavor {
-		// We assume the `tasFlavor` is already in the snapshot as this was
-		// already checked earlier during flavor assignment, and the set of
-		// flavors is immutable in snapshot.
-		tasFlavorCache := c.TASFlavors[tasFlavor]
-		flvResult := tasFlavorCache.FindTopologyAssignmentsForFlavor(flavorTASRequests, options...)
-		maps.Copy(result, flvResult)
+	// We assume the flavors are already in the snapshot as this was already
+	// checked earlier during flavor assignment, and the set of flavors is
+	// immutable in snapshot.
+	for _, tasFlavors := range c.tasFlavorsByTopology(tasRequestsByFlavor) {
+		// The PodSets assigned to different flavors sharing the Topology are
+		// placed jointly within a common domain at the required level.
+		if len(tasFlavors) > 1 && features.Enabled(features.TASMultiFlavorPlacement) && !workload.HasUnhealthyNodes(opts.workload) {
+			if level, found := c.commonRequiredLevel(tasRequestsByFlavor, tasFlavors); found {
+				maps.Copy(result, c.findTopologyAssignmentsInCommonDomain(tasRequestsByFlavor, tasFlavors, level, options...))
+				continue
+			}
+		}
+		for _, tasFlavor := range tasFlavors {
+			tasFlavorCache := c.TASFlavors[tasFlavor]
+			flvResult := tasFlavorCache.FindTopologyAssignmentsForFlavor(tasRequestsByFlavor[tasFlavor], options...)
+			maps.Copy(result, flvResult)
+		}
 	}
 	return result
 }
@@ -59,6 +59,15 @@ func (t *tasCache) Get(name kueue.ResourceFlavorReference) *TASFlavorCache {
 	return t.flavorCache[name]
 }
 
+// TopologyLevels returns the levels of the given Topology, and whether the
+// Topology is known to the cache.
+func (t *tasCache) TopologyLevels(name kueue.TopologyReference) ([]string, bool) {
+	t.RLock()
+	defer t.RUnlock()
+	tInfo, ok := t.topologies[name]
+	return slices.Clone(tInfo.Levels), ok
+}
+
 // Clone returns a shallow copy of the map
 func (t *tasCache) Clone() map[kueue.ResourceFlavorReference]*TASFlavorCache {
 	t.RLock()
@@ -34,6 +34,7 @@ import (
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
 	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 // PodSetTestCase defines a test case for a single podset in the consolidated test.
@@ -895,6 +896,221 @@ func TestFindTopologyAssignments(t *testing.T) {
 				},
 			}},
 		},
+		"spread across blocks; at least 2 domains; at most 3 pods per domain": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{{
+				topologyRequest: &kueue.PodSetTopologyRequest{
+					SpreadTopology:         ptr.To(tasBlockLabel),
+					SpreadMinDomains:       ptr.To[int32](2),
+					SpreadMaxPodsPerDomain: ptr.To[int32](3),
+				},
+				requests: resources.Requests{
+					corev1.ResourceCPU: 1000,
+				},
+				count: 4,
+				wantAssignment: &tas.TopologyAssignment{
+					Levels: []string{corev1.LabelHostname},
+					Domains: []tas.TopologyDomainAssignment{
+						{Count: 1, Values: []string{"x1"}},
+						{Count: 1, Values: []string{"x5"}},
+						{Count: 2, Values: []string{"x4"}},
+					},
+				},
+			}},
+		},
+		"spread across racks; at most 1 pod per domain": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{{
+				topologyRequest: &kueue.PodSetTopologyRequest{
+					SpreadTopology:         ptr.To(tasRackLabel),
+					SpreadMaxPodsPerDomain: ptr.To[int32](1),
+				},
+				requests: resources.Requests{
+					corev1.ResourceCPU: 1000,
+				},
+				count: 4,
+				wantAssignment: &tas.TopologyAssignment{
+					Levels: []string{corev1.LabelHostname},
+					Domains: []tas.TopologyDomainAssignment{
+						{Count: 1, Values: []string{"x3"}},
+						{Count: 1, Values: []string{"x1"}},
+						{Count: 1, Values: []string{"x2"}},
+						{Count: 1, Values: []string{"x4"}},
+					},
+				},
+			}},
+		},
+		"spread across blocks; not enough domains": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{{
+				topologyRequest: &kueue.PodSetTopologyRequest{
+					SpreadTopology:   ptr.To(tasBlockLabel),
+					SpreadMinDomains: ptr.To[int32](3),
+				},
+				requests: resources.Requests{
+					corev1.ResourceCPU: 1000,
+				},
+				count:      3,
+				wantReason: "only 2 domain(s) at level cloud.com/topology-block can fit pods, but spreading across at least 3 is required",
+			}},
+		},
+		"spread across blocks; too many pods for the per domain limit": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{{
+				topologyRequest: &kueue.PodSetTopologyRequest{
+					SpreadTopology:         ptr.To(tasBlockLabel),
+					SpreadMaxPodsPerDomain: ptr.To[int32](1),
+				},
+				requests: resources.Requests{
+					corev1.ResourceCPU: 1000,
+				},
+				count:      3,
+				wantReason: `topology "default" allows to fit only 2 out of 3 pod(s)`,
+			}},
+		},
+		"spread across blocks; fewer pods than the minimum number of domains": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{{
+				topologyRequest: &kueue.PodSetTopologyRequest{
+					SpreadTopology:   ptr.To(tasBlockLabel),
+					SpreadMinDomains: ptr.To[int32](2),
+				},
+				requests: resources.Requests{
+					corev1.ResourceCPU: 1000,
+				},
+				count:      1,
+				wantReason: "cannot spread 1 pod(s) across at least 2 domains at level: cloud.com/topology-block",
+			}},
+		},
+		"podset constraints; same block but different rack than another podset": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{
+				{
+					podSetName: "a",
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required: ptr.To(tasRackLabel),
+					},
+					requests: resources.Requests{
+						corev1.ResourceCPU: 1000,
+					},
+					count: 1,
+					wantAssignment: &tas.TopologyAssignment{
+						Levels: []string{corev1.LabelHostname},
+						Domains: []tas.TopologyDomainAssignment{
+							{Count: 1, Values: []string{"x3"}},
+						},
+					},
+				},
+				{
+					podSetName: "b",
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required: ptr.To(tasRackLabel),
+						PodSetConstraints: []kueue.PodSetTopologyConstraint{
+							{PodSetName: "a", Level: tasBlockLabel, Relation: kueue.SameDomainPodSetTopologyRelation},
+							{PodSetName: "a", Level: tasRackLabel, Relation: kueue.DifferentDomainPodSetTopologyRelation},
+						},
+					},
+					requests: resources.Requests{
+						corev1.ResourceCPU: 1000,
+					},
+					count: 2,
+					wantAssignment: &tas.TopologyAssignment{
+						Levels: []string{corev1.LabelHostname},
+						Domains: []tas.TopologyDomainAssignment{
+							{Count: 1, Values: []string{"x1"}},
+							{Count: 1, Values: []string{"x5"}},
+						},
+					},
+				},
+			},
+		},
+		"podset constraints; leader in a different rack than workers within the same block": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{
+				{
+					podSetName:      "leader",
+					podSetGroupName: ptr.To("group"),
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required:        ptr.To(tasBlockLabel),
+						PodSetGroupName: ptr.To("group"),
+						PodSetConstraints: []kueue.PodSetTopologyConstraint{
+							{PodSetName: "workers", Level: tasRackLabel, Relation: kueue.DifferentDomainPodSetTopologyRelation},
+						},
+					},
+					requests: resources.Requests{
+						corev1.ResourceCPU: 1000,
+					},
+					count: 1,
+					wantAssignment: &tas.TopologyAssignment{
+						Levels: []string{corev1.LabelHostname},
+						Domains: []tas.TopologyDomainAssignment{
+							{Count: 1, Values: []string{"x2"}},
+						},
+					},
+				},
+				{
+					podSetName:      "workers",
+					podSetGroupName: ptr.To("group"),
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required:        ptr.To(tasBlockLabel),
+						PodSetGroupName: ptr.To("group"),
+					},
+					requests: resources.Requests{
+						corev1.ResourceCPU: 1000,
+					},
+					count: 2,
+					wantAssignment: &tas.TopologyAssignment{
+						Levels: []string{corev1.LabelHostname},
+						Domains: []tas.TopologyDomainAssignment{
+							{Count: 2, Values: []string{"x4"}},
+						},
+					},
+				},
+			},
+		},
+		"podset constraints; no capacity left in the same rack as another podset": {
+			nodes:  defaultNodes,
+			levels: defaultThreeLevels,
+			podSets: []PodSetTestCase{
+				{
+					podSetName: "a",
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required: ptr.To(tasRackLabel),
+					},
+					requests: resources.Requests{
+						corev1.ResourceCPU: 1000,
+					},
+					count: 1,
+					wantAssignment: &tas.TopologyAssignment{
+						Levels: []string{corev1.LabelHostname},
+						Domains: []tas.TopologyDomainAssignment{
+							{Count: 1, Values: []string{"x3"}},
+						},
+					},
+				},
+				{
+					podSetName: "b",
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required: ptr.To(tasRackLabel),
+						PodSetConstraints: []kueue.PodSetTopologyConstraint{
+							{PodSetName: "a", Level: tasRackLabel, Relation: kueue.SameDomainPodSetTopologyRelation},
+						},
+					},
+					requests: resources.Requests{
+						corev1.ResourceCPU: 1000,
+					},
+					count:      1,
+					wantReason: `topology "default" doesn't allow to fit any of 1 pod(s). Total nodes: 6; excluded: resource "cpu": 1, topologyDomain: 5`,
+				},
+			},
+		},
 		"rack required; too many pods to fit in any rack; BestFit": {
 			nodes:  defaultNodes,
 			levels: defaultTwoLevels,
@@ -5478,3 +5694,136 @@ func TestFindTopologyAssignments(t *testing.T) {
 		})
 	}
 }
+
+func TestDomainsUsage(t *testing.T) {
+	const (
+		tasBlockLabel = "cloud.com/topology-block"
+	)
+
+	nodes := []corev1.Node{
+		*testingnode.MakeNode("x1").
+			Label(tasBlockLabel, "b1").
+			Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("2"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj(),
+		*testingnode.MakeNode("x2").
+			Label(tasBlockLabel, "b1").
+			Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("2"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj(),
+		*testingnode.MakeNode("x3").
+			Label(tasBlockLabel, "b2").
+			Label(corev1.LabelHostname, "x3").
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("2"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Unschedulable().
+			Obj(),
+	}
+	pods := []corev1.Pod{
+		*testingpod.MakePod("daemon", "kube-system").NodeName("x2").
+			Request(corev1.ResourceCPU, "500m").
+			Obj(),
+	}
+
+	cases := map[string]struct {
+		levels    []string
+		wlUsage   map[workload.Reference][]workload.TopologyDomainRequests
+		wantUsage []TopologyDomainUsage
+	}{
+		"hostname as the lowest level": {
+			levels: []string{tasBlockLabel, corev1.LabelHostname},
+			wlUsage: map[workload.Reference][]workload.TopologyDomainRequests{
+				"default/wl-a": {{
+					Values:            []string{"x1"},
+					SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+					Count:             1,
+				}},
+				"default/wl-b": {{
+					Values:            []string{"x1"},
+					SinglePodRequests: resources.Requests{corev1.ResourceCPU: 500},
+					Count:             1,
+				}, {
+					Values:            []string{"x3"},
+					SinglePodRequests: resources.Requests{corev1.ResourceCPU: 500},
+					Count:             1,
+				}},
+			},
+			wantUsage: []TopologyDomainUsage{
+				{
+					Values:      []string{"b1", "x1"},
+					Capacity:    resources.Requests{corev1.ResourceCPU: 2000, corev1.ResourcePods: 10},
+					TASUsage:    resources.Requests{corev1.ResourceCPU: 1500, corev1.ResourcePods: 2},
+					NonTASUsage: resources.Requests{},
+					Workloads:   []workload.Reference{"default/wl-a", "default/wl-b"},
+				},
+				{
+					Values:      []string{"b1", "x2"},
+					Capacity:    resources.Requests{corev1.ResourceCPU: 2000, corev1.ResourcePods: 10},
+					TASUsage:    resources.Requests{},
+					NonTASUsage: resources.Requests{corev1.ResourceCPU: 500, corev1.ResourcePods: 1},
+				},
+			},
+		},
+		"block as the lowest level": {
+			levels: []string{tasBlockLabel},
+			wlUsage: map[workload.Reference][]workload.TopologyDomainRequests{
+				"default/wl-a": {{
+					Values:            []string{"b1"},
+					SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+					Count:             2,
+				}},
+			},
+			wantUsage: []TopologyDomainUsage{
+				{
+					Values:      []string{"b1"},
+					Capacity:    resources.Requests{corev1.ResourceCPU: 4000, corev1.ResourcePods: 20},
+					TASUsage:    resources.Requests{corev1.ResourceCPU: 2000, corev1.ResourcePods: 2},
+					NonTASUsage: resources.Requests{corev1.ResourceCPU: 500, corev1.ResourcePods: 1},
+					Workloads:   []workload.Reference{"default/wl-a"},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+
+			initialObjects := make([]client.Object, 0, len(nodes))
+			for i := range nodes {
+				initialObjects = append(initialObjects, &nodes[i])
+			}
+			clientBuilder := utiltesting.NewClientBuilder()
+			clientBuilder.WithObjects(initialObjects...)
+			_ = tasindexer.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder))
+			client := clientBuilder.Build()
+
+			tasCache := NewTASCache(client)
+			for _, pod := range pods {
+				tasCache.Update(&pod, log)
+			}
+			tasFlavorCache := tasCache.NewTASFlavorCache(topologyInformation{Levels: tc.levels}, flavorInformation{TopologyName: "default"})
+			for key, topologyRequests := range tc.wlUsage {
+				tasFlavorCache.addUsage(key, topologyRequests)
+			}
+
+			gotUsage, err := tasFlavorCache.DomainsUsage(ctx)
+			if err != nil {
+				t.Fatalf("failed to compute the usage of domains: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantUsage, gotUsage); diff != "" {
+				t.Errorf("unexpected usage of domains (-want,+got): %s", diff)
+			}
+		})
+	}
+}
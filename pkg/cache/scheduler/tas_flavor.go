@@ -109,6 +109,16 @@ func (t *tasCache) NewTASFlavorCache(topologyInfo topologyInformation,
 
 func (c *TASFlavorCache) snapshot(ctx context.Context) (*TASFlavorSnapshot, error) {
 	log := ctrl.LoggerFrom(ctx)
+	nodes, err := c.listNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.snapshotForNodes(log, nodes), nil
+}
+
+// listNodes lists the nodes matching the flavor, which are ready and
+// schedulable.
+func (c *TASFlavorCache) listNodes(ctx context.Context) ([]corev1.Node, error) {
 	nodes := &corev1.NodeList{}
 
 	var requiredLabels client.MatchingLabels = maps.Clone(c.flavor.NodeLabels)
@@ -121,7 +131,7 @@ func (c *TASFlavorCache) snapshot(ctx context.Context) (*TASFlavorSnapshot, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to list nodes for TAS: %w", err)
 	}
-	return c.snapshotForNodes(log, nodes.Items), nil
+	return nodes.Items, nil
 }
 
 func (c *TASFlavorCache) NodeLabels() map[string]string {
@@ -136,6 +146,131 @@ func (c *TASFlavorCache) TopologyLevels() []string {
 	return c.topology.Levels
 }
 
+// FindNodeReplacement checks if the Pods of the PodSet, which are assigned to
+// the given node, can be moved to other nodes without changing the rest of the
+// topology assignment. It returns the names of the replacement nodes, or the
+// reason why the replacement cannot be found.
+// The node is expected to be unschedulable, so that it is not part of the
+// snapshot used to compute the replacement.
+func (c *TASFlavorCache) FindNodeReplacement(ctx context.Context, wl *kueue.Workload, psa *kueue.PodSetAssignment, nodeName string) ([]string, string, error) {
+	if psa.TopologyAssignment == nil {
+		return nil, "", fmt.Errorf("podSet %q has no topology assignment", psa.Name)
+	}
+	psIdx := slices.IndexFunc(wl.Spec.PodSets, func(ps kueue.PodSet) bool {
+		return ps.Name == psa.Name
+	})
+	if psIdx < 0 {
+		return nil, "", fmt.Errorf("podSet %q not found in workload", psa.Name)
+	}
+	snapshot, err := c.snapshot(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	ps := &wl.Spec.PodSets[psIdx]
+	tr := TASPodSetRequests{
+		PodSet:            ps,
+		SinglePodRequests: resources.NewRequestsFromPodSpec(&ps.Template.Spec),
+		Implied:           !workload.IsExplicitlyRequestingTAS(*ps),
+	}
+	wlCopy := wl.DeepCopy()
+	wlCopy.Status.UnhealthyNodes = []kueue.UnhealthyNode{{Name: nodeName}}
+	_, replacement, reason := snapshot.findReplacementAssignment(&tr, utiltas.InternalFrom(psa.TopologyAssignment), wlCopy, make(map[utiltas.TopologyDomainID]resources.Requests))
+	if reason != "" {
+		return nil, reason, nil
+	}
+	nodes := make([]string, 0, len(replacement.Domains))
+	for _, domain := range replacement.Domains {
+		nodes = append(nodes, domain.Values[len(domain.Values)-1])
+	}
+	return nodes, "", nil
+}
+
+// TopologyDomainUsage describes the capacity and usage of a lowest-level
+// topology domain.
+type TopologyDomainUsage struct {
+	// Values are the values of the topology levels identifying the domain,
+	// ordered from the highest to the lowest level.
+	Values []string
+
+	// Capacity is the total allocatable capacity of the nodes in the domain.
+	Capacity resources.Requests
+
+	// TASUsage is the usage coming from the workloads admitted by TAS.
+	TASUsage resources.Requests
+
+	// NonTASUsage is the usage coming from Pods which are not managed by
+	// workloads admitted by TAS.
+	NonTASUsage resources.Requests
+
+	// Workloads lists the workloads with Pods assigned to the domain.
+	Workloads []workload.Reference
+}
+
+// DomainsUsage returns the capacity and usage of the lowest-level topology
+// domains, computed for the ready and schedulable nodes matching the flavor.
+// The domains are sorted by their level values.
+func (c *TASFlavorCache) DomainsUsage(ctx context.Context) ([]TopologyDomainUsage, error) {
+	nodes, err := c.listNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	lowestLevelNode := c.topology.Levels[len(c.topology.Levels)-1] == corev1.LabelHostname
+	domainID := func(levelValues []string) utiltas.TopologyDomainID {
+		if lowestLevelNode {
+			return utiltas.DomainID(levelValues[len(levelValues)-1:])
+		}
+		return utiltas.DomainID(levelValues)
+	}
+
+	domains := make(map[utiltas.TopologyDomainID]*TopologyDomainUsage)
+	nodeToDomain := make(map[string]utiltas.TopologyDomainID, len(nodes))
+	for _, node := range nodes {
+		levelValues := utiltas.LevelValues(c.topology.Levels, node.Labels)
+		id := domainID(levelValues)
+		if _, found := domains[id]; !found {
+			domains[id] = &TopologyDomainUsage{
+				Values:      levelValues,
+				Capacity:    resources.Requests{},
+				TASUsage:    resources.Requests{},
+				NonTASUsage: resources.Requests{},
+			}
+		}
+		domains[id].Capacity.Add(resources.NewRequests(node.Status.Allocatable))
+		nodeToDomain[node.Name] = id
+	}
+	for nodeName, usage := range c.nonTasUsageCache.usagePerNode() {
+		if id, ok := nodeToDomain[nodeName]; ok {
+			domains[id].NonTASUsage.Add(usage)
+		}
+	}
+	for id, usage := range c.usage {
+		if d, ok := domains[id]; ok {
+			d.TASUsage.Add(usage)
+		}
+	}
+	for wlKey, topologyRequests := range c.wlUsage {
+		for _, tr := range topologyRequests {
+			if d, ok := domains[domainID(tr.Values)]; ok && !slices.Contains(d.Workloads, wlKey) {
+				d.Workloads = append(d.Workloads, wlKey)
+			}
+		}
+	}
+
+	result := make([]TopologyDomainUsage, 0, len(domains))
+	for _, d := range domains {
+		slices.Sort(d.Workloads)
+		result = append(result, *d)
+	}
+	slices.SortFunc(result, func(a, b TopologyDomainUsage) int {
+		return slices.Compare(a.Values, b.Values)
+	})
+	return result, nil
+}
+
 func (c *TASFlavorCache) snapshotForNodes(log logr.Logger, nodes []corev1.Node) *TASFlavorSnapshot {
 	c.RLock()
 	defer c.RUnlock()
@@ -160,17 +295,21 @@ func (c *TASFlavorCache) snapshotForNodes(log logr.Logger, nodes []corev1.Node)
 }
 
 func (c *TASFlavorCache) addUsage(key workload.Reference, topologyRequests []workload.TopologyDomainRequests) {
+	c.Lock()
 	c.wlUsage[key] = slices.Clone(topologyRequests)
+	c.Unlock()
 	c.updateUsage(topologyRequests, add)
 }
 
 func (c *TASFlavorCache) removeUsage(key workload.Reference) {
+	c.Lock()
 	value, found := c.wlUsage[key]
+	delete(c.wlUsage, key)
+	c.Unlock()
 	if !found {
 		return
 	}
 	c.updateUsage(value, subtract)
-	delete(c.wlUsage, key)
 }
 
 func (c *TASFlavorCache) updateUsage(topologyRequests []workload.TopologyDomainRequests, op usageOp) {
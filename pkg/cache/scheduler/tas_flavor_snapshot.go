@@ -24,7 +24,6 @@ import (
 	"maps"
 	"math"
 	"slices"
-	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -401,8 +400,9 @@ func (s *TASFlavorSnapshot) Fits(flavorUsage workload.TASFlavorUsage) bool {
 }
 
 type findTopologyAssignmentsOption struct {
-	simulateEmpty bool
-	workload      *kueue.Workload
+	simulateEmpty     bool
+	workload          *kueue.Workload
+	domainConstraints podSetDomainConstraints
 }
 
 // ExclusionStats tracks why nodes were excluded during TAS scheduling.
@@ -476,24 +476,9 @@ func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(flavorTASRequests F
 
 	result := make(map[kueue.PodSetReference]tasPodSetAssignmentResult)
 	assumedUsage := make(map[utiltas.TopologyDomainID]resources.Requests)
+	assigned := make(map[kueue.PodSetReference]*utiltas.TopologyAssignment)
 
-	groupedTASRequests := make(map[string]FlavorTASRequests)
-	groupsOrder := make([]string, 0)
-
-	for idx, tr := range flavorTASRequests {
-		groupKey := strconv.Itoa(idx)
-		if tr.PodSetGroupName != nil {
-			groupKey = *tr.PodSetGroupName
-		}
-
-		if !slices.Contains(groupsOrder, groupKey) {
-			groupsOrder = append(groupsOrder, groupKey)
-		}
-		groupedTASRequests[groupKey] = append(groupedTASRequests[groupKey], tr)
-	}
-
-	for _, groupKey := range groupsOrder {
-		trs := groupedTASRequests[groupKey]
+	for _, trs := range groupTASRequests(flavorTASRequests) {
 		if workload.HasUnhealthyNodes(opts.workload) {
 			for _, tr := range trs {
 				// In case of looking for Node replacement, TopologyRequest has only
@@ -527,7 +512,12 @@ func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(flavorTASRequests F
 			}
 
 			// Normal path: no previous assignment or stale assignment
-			assignments, reason := s.findTopologyAssignment(workers, leader, assumedUsage, opts.simulateEmpty, "")
+			constraints, reason := s.podSetDomainConstraints(trs, flavorTASRequests, assigned)
+			var assignments map[kueue.PodSetReference]*utiltas.TopologyAssignment
+			constraints = append(constraints, opts.domainConstraints...)
+			if reason == "" {
+				assignments, reason = s.findTopologyAssignment(workers, leader, assumedUsage, opts.simulateEmpty, "", constraints)
+			}
 			for _, tr := range trs {
 				podSetName := tr.PodSet.Name
 				result[podSetName] = tasPodSetAssignmentResult{TopologyAssignment: assignments[podSetName], FailureReason: reason}
@@ -538,6 +528,7 @@ func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(flavorTASRequests F
 			}
 			for _, tr := range trs {
 				addAssumedUsage(assumedUsage, assignments[tr.PodSet.Name], &tr)
+				assigned[tr.PodSet.Name] = assignments[tr.PodSet.Name]
 			}
 		}
 	}
@@ -574,7 +565,7 @@ func (s *TASFlavorSnapshot) findReplacementAssignment(tr *TASPodSetRequests, exi
 		trCopy.PodSet = tr.PodSet.DeepCopy()
 		trCopy.PodSet.TopologyRequest.PodSetSliceSize = ptr.To(int32(1))
 	}
-	replacementAssignment, reason := s.findTopologyAssignment(trCopy, nil, assumedUsage, false, requiredReplacementDomain)
+	replacementAssignment, reason := s.findTopologyAssignment(trCopy, nil, assumedUsage, false, requiredReplacementDomain, nil)
 	if reason != "" {
 		return nil, nil, reason
 	}
@@ -718,7 +709,9 @@ func (s *TASFlavorSnapshot) findIncompleteSliceDomain(tr *TASPodSetRequests, ta
 // Phase 2:
 //
 //	a) sort domains using chosen strategy (i.e. starting from the highest free capacity)
-//	b) select consecutive domains at requested level that can fit the workload
+//	b) select consecutive domains at requested level that can fit the workload,
+//	or, for spread requests, distribute the pods round-robin across the domains
+//	at the spread level
 //	c) traverse the structure down level-by-level optimizing the number of used
 //	domains at each level
 //	d) build the assignment for the lowest level in the hierarchy
@@ -726,7 +719,8 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	workersTasPodSetRequests TASPodSetRequests,
 	leaderTasPodSetRequests *TASPodSetRequests,
 	assumedUsage map[utiltas.TopologyDomainID]resources.Requests,
-	simulateEmpty bool, requiredReplacementDomain utiltas.TopologyDomainID) (map[kueue.PodSetReference]*utiltas.TopologyAssignment, string) {
+	simulateEmpty bool, requiredReplacementDomain utiltas.TopologyDomainID,
+	constraints podSetDomainConstraints) (map[kueue.PodSetReference]*utiltas.TopologyAssignment, string) {
 	requests := workersTasPodSetRequests.SinglePodRequests.Clone()
 	requests.Add(resources.Requests{corev1.ResourcePods: 1})
 
@@ -760,6 +754,10 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	sliceTopologyKey := s.sliceLevelKeyWithDefault(workersTasPodSetRequests.PodSet.TopologyRequest, s.lowestLevel())
 
 	unconstrained := isUnconstrained(workersTasPodSetRequests.PodSet.TopologyRequest, &workersTasPodSetRequests)
+	spread := isSpreadRequest(workersTasPodSetRequests.PodSet.TopologyRequest)
+	if spread && leaderTasPodSetRequests != nil {
+		return nil, "spread topology is not supported for PodSet groups"
+	}
 	if topologyKey == nil {
 		return nil, "topology level not specified"
 	}
@@ -812,6 +810,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 		selector,
 		affinitySelector,
 		requiredReplacementDomain,
+		constraints,
 		stats,
 	)
 
@@ -820,7 +819,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	var currFitDomain []*domain
 	var fitLevelIdx int
 	var useBalancedPlacement bool
-	if features.Enabled(features.TASBalancedPlacement) && !required && !unconstrained {
+	if features.Enabled(features.TASBalancedPlacement) && !required && !unconstrained && !spread {
 		var bestThreshold int32
 		currFitDomain, bestThreshold = findBestDomainsForBalancedPlacement(s, levelIdx, sliceLevelIdx, count, leaderCount, sliceSize)
 		useBalancedPlacement = bestThreshold > 0
@@ -832,7 +831,14 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 		}
 	}
 
-	if !useBalancedPlacement {
+	switch {
+	case spread:
+		fitLevelIdx = levelIdx
+		currFitDomain, reason = s.findSpreadDomains(levelIdx, count, workersTasPodSetRequests.PodSet.TopologyRequest, stats)
+		if len(reason) > 0 {
+			return nil, reason
+		}
+	case !useBalancedPlacement:
 		fitLevelIdx, currFitDomain, reason = s.findLevelWithFitDomains(levelIdx, required, count, leaderCount, sliceSize, unconstrained, stats)
 		if len(reason) > 0 {
 			return nil, reason
@@ -841,9 +847,12 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	// phase 2b: traverse the tree down level-by-level optimizing the number of
 	// topology domains at each level
 	// if unconstrained is set, we'll only do it once
-	currFitDomain = s.updateCountsToMinimumGeneric(currFitDomain, count, leaderCount, sliceSize, unconstrained, true)
+	if !spread {
+		// for spread requests the counts at the spread level are already final
+		currFitDomain = s.updateCountsToMinimumGeneric(currFitDomain, count, leaderCount, sliceSize, unconstrained, true)
+	}
 	levelIdx = fitLevelIdx
-	for ; levelIdx < min(len(s.domainsPerLevel)-1, sliceLevelIdx) && !useBalancedPlacement; levelIdx++ {
+	for ; levelIdx < min(len(s.domainsPerLevel)-1, sliceLevelIdx) && !useBalancedPlacement && !spread; levelIdx++ {
 		// If we are "above" the requested slice topology level and we don't run the balanced placement algorithm,
 		// we're greedily assigning pods/slices to all domains without checking what we've assigned to parent domains.
 		sortedLowerDomains := s.sortedDomains(s.lowerLevelDomains(currFitDomain), unconstrained)
@@ -945,6 +954,8 @@ func (s *TASFlavorSnapshot) levelKey(topologyRequest *kueue.PodSetTopologyReques
 		return topologyRequest.Required
 	case topologyRequest.Preferred != nil:
 		return topologyRequest.Preferred
+	case topologyRequest.SpreadTopology != nil:
+		return topologyRequest.SpreadTopology
 	case isSliceTopologyOnlyRequest(topologyRequest):
 		return ptr.To(s.highestLevel())
 	case ptr.Deref(topologyRequest.Unconstrained, false):
@@ -966,6 +977,10 @@ func isSliceTopologyOnlyRequest(tr *kueue.PodSetTopologyRequest) bool {
 	return tr != nil && tr.Required == nil && tr.Preferred == nil && tr.PodSetSliceRequiredTopology != nil
 }
 
+func isSpreadRequest(tr *kueue.PodSetTopologyRequest) bool {
+	return tr != nil && tr.Required == nil && tr.Preferred == nil && tr.SpreadTopology != nil
+}
+
 func slicesRequested(tr *kueue.PodSetTopologyRequest) bool {
 	return tr != nil && tr.PodSetSliceRequiredTopology != nil && tr.PodSetSliceSize != nil
 }
@@ -1110,6 +1125,70 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(levelIdx int, required bool,
 	return levelIdx, []*domain{topDomain}, ""
 }
 
+// findSpreadDomains distributes count pods across the domains at levelIdx so
+// that at least SpreadMinDomains domains are used, and no domain receives more
+// than SpreadMaxPodsPerDomain pods. The pods are assigned round-robin, starting
+// from the domains with the most free capacity, so that they are spread as
+// evenly as the capacity of the domains allows.
+func (s *TASFlavorSnapshot) findSpreadDomains(levelIdx int, count int32, tr *kueue.PodSetTopologyRequest, stats *ExclusionStats) ([]*domain, string) {
+	levelDomains := s.domainsPerLevel[levelIdx]
+	if len(levelDomains) == 0 {
+		return nil, fmt.Sprintf("no topology domains at level: %s", s.levelKeys[levelIdx])
+	}
+	minDomains := ptr.Deref(tr.SpreadMinDomains, 1)
+	maxPodsPerDomain := ptr.Deref(tr.SpreadMaxPodsPerDomain, count)
+	if count < minDomains {
+		return nil, fmt.Sprintf("cannot spread %d pod(s) across at least %d domains at level: %s", count, minDomains, s.levelKeys[levelIdx])
+	}
+
+	candidates := make([]*domain, 0, len(levelDomains))
+	for _, d := range levelDomains {
+		if d.state > 0 {
+			candidates = append(candidates, d)
+		}
+	}
+	if int32(len(candidates)) < minDomains {
+		return nil, fmt.Sprintf("only %d domain(s) at level %s can fit pods, but spreading across at least %d is required", len(candidates), s.levelKeys[levelIdx], minDomains)
+	}
+	slices.SortFunc(candidates, func(a, b *domain) int {
+		if a.state != b.state {
+			return cmp.Compare(b.state, a.state)
+		}
+		return slices.Compare(a.levelValues, b.levelValues)
+	})
+
+	assigned := make([]int32, len(candidates))
+	remaining := count
+	for remaining > 0 {
+		progress := false
+		for i, d := range candidates {
+			if remaining == 0 {
+				break
+			}
+			if assigned[i] < min(d.state, maxPodsPerDomain) {
+				assigned[i]++
+				remaining--
+				progress = true
+			}
+		}
+		if !progress {
+			return nil, s.notFitMessage(count-remaining, count, 1, stats)
+		}
+	}
+
+	result := make([]*domain, 0, len(candidates))
+	for i, d := range candidates {
+		if assigned[i] == 0 {
+			continue
+		}
+		d.state = assigned[i]
+		d.sliceState = assigned[i]
+		d.leaderState = 0
+		result = append(result, d)
+	}
+	return result, ""
+}
+
 func useBestFitAlgorithm(unconstrained bool) bool {
 	// following the matrix from KEP#2724
 	return !useLeastFreeCapacityAlgorithm(unconstrained)
@@ -1354,6 +1433,7 @@ func (s *TASFlavorSnapshot) fillInCounts(
 	selector labels.Selector,
 	affinityNodeSelector *nodeaffinity.NodeSelector,
 	requiredReplacementDomain utiltas.TopologyDomainID,
+	constraints podSetDomainConstraints,
 	stats *ExclusionStats) {
 	isNodeLevel := s.isLowestLevelNode()
 	for _, domain := range s.domains {
@@ -1408,6 +1488,13 @@ func (s *TASFlavorSnapshot) fillInCounts(
 			continue
 		}
 
+		// 5. Check if the leaf satisfies the topology constraints relative to
+		// the already assigned PodSets
+		if !constraints.allows(leaf) {
+			stats.TopologyDomain++
+			continue
+		}
+
 		remainingCapacity := leaf.freeCapacity.Clone()
 		if !simulateEmpty {
 			remainingCapacity.Sub(leaf.tasUsage)
@@ -405,6 +405,18 @@ func TestHasLevel(t *testing.T) {
 			},
 			want: true,
 		},
+		"spread": {
+			podSetTopologyRequest: &kueue.PodSetTopologyRequest{
+				SpreadTopology: ptr.To("level-1"),
+			},
+			want: true,
+		},
+		"spread – invalid level": {
+			podSetTopologyRequest: &kueue.PodSetTopologyRequest{
+				SpreadTopology: ptr.To("invalid-level"),
+			},
+			want: false,
+		},
 		"slice-only": {
 			podSetTopologyRequest: &kueue.PodSetTopologyRequest{
 				PodSetSliceRequiredTopology: ptr.To("level-1"),
@@ -0,0 +1,140 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+)
+
+// withinDomain restricts the assignment to the leaves of the topology domain
+// at the given level.
+func withinDomain(levelIdx int, domainID utiltas.TopologyDomainID) FindTopologyAssignmentsOption {
+	return func(o *findTopologyAssignmentsOption) {
+		o.domainConstraints = append(o.domainConstraints, podSetDomainConstraint{
+			levelIdx: levelIdx,
+			domainID: domainID,
+			relation: kueue.SameDomainPodSetTopologyRelation,
+		})
+	}
+}
+
+// tasFlavorsByTopology groups the flavors of the TAS requests by the Topology
+// they use. The flavors are sorted by name within each group, and the groups
+// are ordered by their first flavor.
+func (c *ClusterQueueSnapshot) tasFlavorsByTopology(tasRequestsByFlavor WorkloadTASRequests) [][]kueue.ResourceFlavorReference {
+	var groups [][]kueue.ResourceFlavorReference
+	groupIdx := make(map[kueue.TopologyReference]int)
+	for _, tasFlavor := range slices.Sorted(maps.Keys(tasRequestsByFlavor)) {
+		topologyName := c.TASFlavors[tasFlavor].topologyName
+		if idx, found := groupIdx[topologyName]; found {
+			groups[idx] = append(groups[idx], tasFlavor)
+			continue
+		}
+		groupIdx[topologyName] = len(groups)
+		groups = append(groups, []kueue.ResourceFlavorReference{tasFlavor})
+	}
+	return groups
+}
+
+// commonRequiredLevel returns the highest topology level required by any of
+// the PodSets assigned to the flavors sharing a Topology.
+func (c *ClusterQueueSnapshot) commonRequiredLevel(tasRequestsByFlavor WorkloadTASRequests, flavors []kueue.ResourceFlavorReference) (string, bool) {
+	var level string
+	levelIdx := -1
+	for _, tasFlavor := range flavors {
+		for _, tr := range tasRequestsByFlavor[tasFlavor] {
+			if !isRequired(tr.PodSet.TopologyRequest) {
+				continue
+			}
+			idx, found := c.TASFlavors[tasFlavor].resolveLevelIdx(*tr.PodSet.TopologyRequest.Required)
+			if found && (levelIdx == -1 || idx < levelIdx) {
+				level, levelIdx = *tr.PodSet.TopologyRequest.Required, idx
+			}
+		}
+	}
+	return level, levelIdx != -1
+}
+
+// findTopologyAssignmentsInCommonDomain returns TAS assignments for the PodSets
+// assigned to multiple flavors sharing a Topology, such that all of them are
+// placed within a common topology domain at the given level. The candidate
+// domains, present in the snapshots of all the flavors, are checked in the order
+// of their IDs, and the first one fitting the PodSets of all the flavors is used.
+func (c *ClusterQueueSnapshot) findTopologyAssignmentsInCommonDomain(
+	tasRequestsByFlavor WorkloadTASRequests,
+	flavors []kueue.ResourceFlavorReference,
+	level string,
+	options ...FindTopologyAssignmentsOption,
+) TASAssignmentsResult {
+	for _, domainID := range c.commonDomainIDs(flavors, level) {
+		result := make(TASAssignmentsResult)
+		for _, tasFlavor := range flavors {
+			tasFlavorCache := c.TASFlavors[tasFlavor]
+			levelIdx, _ := tasFlavorCache.resolveLevelIdx(level)
+			flvOptions := append(slices.Clone(options), withinDomain(levelIdx, domainID))
+			flvResult := tasFlavorCache.FindTopologyAssignmentsForFlavor(tasRequestsByFlavor[tasFlavor], flvOptions...)
+			maps.Copy(result, flvResult)
+			if flvResult.Failure() != nil {
+				break
+			}
+		}
+		if result.Failure() == nil {
+			return result
+		}
+	}
+
+	names := make([]string, len(flavors))
+	for i, tasFlavor := range flavors {
+		names[i] = string(tasFlavor)
+	}
+	reason := fmt.Sprintf("cannot place the PodSets of flavors %s within a common domain at level: %s", strings.Join(names, ", "), level)
+	result := make(TASAssignmentsResult)
+	for _, tasFlavor := range flavors {
+		for _, tr := range tasRequestsByFlavor[tasFlavor] {
+			result[tr.PodSet.Name] = tasPodSetAssignmentResult{FailureReason: reason}
+		}
+	}
+	return result
+}
+
+// commonDomainIDs returns the sorted IDs of the topology domains at the given
+// level which are present in the snapshots of all the flavors.
+func (c *ClusterQueueSnapshot) commonDomainIDs(flavors []kueue.ResourceFlavorReference, level string) []utiltas.TopologyDomainID {
+	var common sets.Set[utiltas.TopologyDomainID]
+	for _, tasFlavor := range flavors {
+		tasFlavorCache := c.TASFlavors[tasFlavor]
+		levelIdx, found := tasFlavorCache.resolveLevelIdx(level)
+		if !found {
+			return nil
+		}
+		domainIDs := sets.KeySet(tasFlavorCache.domainsPerLevel[levelIdx])
+		if common == nil {
+			common = domainIDs
+		} else {
+			common = common.Intersection(domainIDs)
+		}
+	}
+	return sets.List(common)
+}
@@ -0,0 +1,271 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	tasindexer "sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/tas"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestFindTopologyAssignmentsForWorkloadMultiFlavor(t *testing.T) {
+	const (
+		tasBlockLabel = "cloud.com/topology-block"
+		poolLabel     = "cloud.com/pool"
+	)
+
+	//          b1                      b2
+	//   /             \        /        |        \
+	//  cpu-x1       gpu-x2   cpu-x3   gpu-x4   gpu-x5
+	makeNode := func(name, block, pool string, cpu string) corev1.Node {
+		return *testingnode.MakeNode(name).
+			Label(tasBlockLabel, block).
+			Label(poolLabel, pool).
+			Label(corev1.LabelHostname, name).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse(cpu),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj()
+	}
+	nodes := []corev1.Node{
+		makeNode("x1", "b1", "cpu", "2"),
+		makeNode("x2", "b1", "gpu", "1"),
+		makeNode("x3", "b2", "cpu", "1"),
+		makeNode("x4", "b2", "gpu", "1"),
+		makeNode("x5", "b2", "gpu", "1"),
+	}
+	levels := []string{tasBlockLabel, corev1.LabelHostname}
+	hostnameLevel := []string{corev1.LabelHostname}
+
+	type podSetCase struct {
+		name            kueue.PodSetReference
+		flavor          kueue.ResourceFlavorReference
+		topologyRequest *kueue.PodSetTopologyRequest
+		cpu             int64
+		count           int32
+	}
+	headPodSet := func(cpu int64) podSetCase {
+		return podSetCase{
+			name:   "head",
+			flavor: "cpu",
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Unconstrained: ptr.To(true),
+			},
+			cpu:   cpu,
+			count: 1,
+		}
+	}
+	workersPodSet := podSetCase{
+		name:   "workers",
+		flavor: "gpu",
+		topologyRequest: &kueue.PodSetTopologyRequest{
+			Required: ptr.To(tasBlockLabel),
+		},
+		cpu:   1000,
+		count: 2,
+	}
+
+	cases := map[string]struct {
+		enableMultiFlavorPlacement bool
+		// sharedNodes makes the flavors select all the nodes, rather than the
+		// nodes of their pool.
+		sharedNodes bool
+		podSets     []podSetCase
+		want        TASAssignmentsResult
+	}{
+		"feature disabled; PodSets of different flavors placed independently": {
+			podSets: []podSetCase{headPodSet(2000), workersPodSet},
+			want: TASAssignmentsResult{
+				"head": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels:  hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{{Values: []string{"x1"}, Count: 1}},
+					},
+				},
+				"workers": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels: hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{
+							{Values: []string{"x4"}, Count: 1},
+							{Values: []string{"x5"}, Count: 1},
+						},
+					},
+				},
+			},
+		},
+		"feature enabled; PodSets of different flavors placed within a common block": {
+			enableMultiFlavorPlacement: true,
+			podSets:                    []podSetCase{headPodSet(1000), workersPodSet},
+			want: TASAssignmentsResult{
+				"head": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels:  hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{{Values: []string{"x3"}, Count: 1}},
+					},
+				},
+				"workers": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels: hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{
+							{Values: []string{"x4"}, Count: 1},
+							{Values: []string{"x5"}, Count: 1},
+						},
+					},
+				},
+			},
+		},
+		"feature enabled; no common block fits the PodSets of all flavors": {
+			enableMultiFlavorPlacement: true,
+			podSets:                    []podSetCase{headPodSet(2000), workersPodSet},
+			want: TASAssignmentsResult{
+				"head": {
+					FailureReason: "cannot place the PodSets of flavors cpu, gpu within a common domain at level: cloud.com/topology-block",
+				},
+				"workers": {
+					FailureReason: "cannot place the PodSets of flavors cpu, gpu within a common domain at level: cloud.com/topology-block",
+				},
+			},
+		},
+		"feature enabled; no required level, PodSets placed independently": {
+			enableMultiFlavorPlacement: true,
+			podSets: []podSetCase{
+				headPodSet(2000),
+				{
+					name:   "workers",
+					flavor: "gpu",
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Preferred: ptr.To(tasBlockLabel),
+					},
+					cpu:   1000,
+					count: 2,
+				},
+			},
+			want: TASAssignmentsResult{
+				"head": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels:  hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{{Values: []string{"x1"}, Count: 1}},
+					},
+				},
+				"workers": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels: hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{
+							{Values: []string{"x4"}, Count: 1},
+							{Values: []string{"x5"}, Count: 1},
+						},
+					},
+				},
+			},
+		},
+		"feature enabled; PodSets of different flavors placed on a common host": {
+			enableMultiFlavorPlacement: true,
+			sharedNodes:                true,
+			podSets: []podSetCase{
+				headPodSet(1000),
+				{
+					name:   "workers",
+					flavor: "gpu",
+					topologyRequest: &kueue.PodSetTopologyRequest{
+						Required: ptr.To(corev1.LabelHostname),
+					},
+					cpu:   1000,
+					count: 2,
+				},
+			},
+			want: TASAssignmentsResult{
+				"head": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels:  hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{{Values: []string{"x1"}, Count: 1}},
+					},
+				},
+				"workers": {
+					TopologyAssignment: &tas.TopologyAssignment{
+						Levels:  hostnameLevel,
+						Domains: []tas.TopologyDomainAssignment{{Values: []string{"x1"}, Count: 2}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASMultiFlavorPlacement, tc.enableMultiFlavorPlacement)
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			initialObjects := make([]client.Object, 0, len(nodes))
+			for i := range nodes {
+				initialObjects = append(initialObjects, &nodes[i])
+			}
+			clientBuilder := utiltesting.NewClientBuilder()
+			clientBuilder.WithObjects(initialObjects...)
+			_ = tasindexer.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder))
+			tasCache := NewTASCache(clientBuilder.Build())
+
+			cq := &ClusterQueueSnapshot{
+				TASFlavors: make(map[kueue.ResourceFlavorReference]*TASFlavorSnapshot),
+			}
+			for _, pool := range []string{"cpu", "gpu"} {
+				nodeLabels := map[string]string{poolLabel: pool}
+				if tc.sharedNodes {
+					nodeLabels = nil
+				}
+				tasFlavorCache := tasCache.NewTASFlavorCache(
+					topologyInformation{Levels: levels},
+					flavorInformation{TopologyName: "default", NodeLabels: nodeLabels},
+				)
+				snapshot, err := tasFlavorCache.snapshot(ctx)
+				if err != nil {
+					t.Fatalf("failed to build the snapshot: %v", err)
+				}
+				cq.TASFlavors[kueue.ResourceFlavorReference(pool)] = snapshot
+			}
+
+			tasRequests := make(WorkloadTASRequests)
+			for _, ps := range tc.podSets {
+				tasRequests[ps.flavor] = append(tasRequests[ps.flavor], TASPodSetRequests{
+					PodSet: &kueue.PodSet{
+						Name:            ps.name,
+						TopologyRequest: ps.topologyRequest,
+					},
+					SinglePodRequests: resources.Requests{corev1.ResourceCPU: ps.cpu},
+					Count:             ps.count,
+					Flavor:            ps.flavor,
+				})
+			}
+			got := cq.FindTopologyAssignmentsForWorkload(tasRequests)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected topology assignments (-want,+got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+)
+
+// podSetDomainConstraint requires (or forbids) placing the pods of a PodSet
+// within the topology domain at the given level.
+type podSetDomainConstraint struct {
+	levelIdx int
+	domainID utiltas.TopologyDomainID
+	relation kueue.PodSetTopologyRelation
+}
+
+// podSetDomainConstraints restricts the leaves which can be used by a PodSet
+// based on the topology assignments of the PodSets it relates to.
+type podSetDomainConstraints []podSetDomainConstraint
+
+func (c podSetDomainConstraints) allows(leaf *leafDomain) bool {
+	for _, constraint := range c {
+		inDomain := leaf.domainIDAtLevel(constraint.levelIdx) == constraint.domainID
+		if inDomain != (constraint.relation == kueue.SameDomainPodSetTopologyRelation) {
+			return false
+		}
+	}
+	return true
+}
+
+// domainIDAtLevel returns the ID of the domain at levelIdx which contains the
+// leaf, as used by the snapshot. The leaves at the hostname level are
+// identified by the hostname only.
+func (l *leafDomain) domainIDAtLevel(levelIdx int) utiltas.TopologyDomainID {
+	if levelIdx == len(l.levelValues)-1 {
+		return l.id
+	}
+	return utiltas.DomainID(l.levelValues[:levelIdx+1])
+}
+
+// groupTASRequests groups the TAS requests by the PodSet group name, keeping
+// the order of the first PodSet in each group. The PodSets of a group which
+// need to be placed in different topology domains are not placed jointly, but
+// one after another, so that the relation between them can be honored.
+func groupTASRequests(flavorTASRequests FlavorTASRequests) []FlavorTASRequests {
+	var groups []FlavorTASRequests
+	groupIdx := make(map[string]int)
+	for _, tr := range flavorTASRequests {
+		if tr.PodSetGroupName != nil {
+			if idx, found := groupIdx[*tr.PodSetGroupName]; found && !hasDifferentDomainRelation(groups[idx], tr) {
+				groups[idx] = append(groups[idx], tr)
+				continue
+			}
+			groupIdx[*tr.PodSetGroupName] = len(groups)
+		}
+		groups = append(groups, FlavorTASRequests{tr})
+	}
+	return groups
+}
+
+func hasDifferentDomainRelation(trs FlavorTASRequests, tr TASPodSetRequests) bool {
+	for _, other := range trs {
+		for _, relation := range podSetTopologyRelations(other, tr) {
+			if relation.Relation == kueue.DifferentDomainPodSetTopologyRelation {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// podSetTopologyRelations returns the topology relations between the PodSet of
+// tr and the PodSet of other, declared by any of them. For PodSets of the same
+// group which are placed separately the relation implied by the required
+// topology of the group is also returned.
+func podSetTopologyRelations(tr, other TASPodSetRequests) []kueue.PodSetTopologyConstraint {
+	var result []kueue.PodSetTopologyConstraint
+	if tr.PodSet.TopologyRequest != nil {
+		for _, constraint := range tr.PodSet.TopologyRequest.PodSetConstraints {
+			if constraint.PodSetName == other.PodSet.Name {
+				result = append(result, constraint)
+			}
+		}
+	}
+	if other.PodSet.TopologyRequest != nil {
+		for _, constraint := range other.PodSet.TopologyRequest.PodSetConstraints {
+			if constraint.PodSetName == tr.PodSet.Name {
+				constraint.PodSetName = other.PodSet.Name
+				result = append(result, constraint)
+			}
+		}
+	}
+	if tr.PodSetGroupName != nil && other.PodSetGroupName != nil && *tr.PodSetGroupName == *other.PodSetGroupName {
+		if level := requiredLevel(tr, other); level != nil {
+			result = append(result, kueue.PodSetTopologyConstraint{
+				PodSetName: other.PodSet.Name,
+				Level:      *level,
+				Relation:   kueue.SameDomainPodSetTopologyRelation,
+			})
+		}
+	}
+	return result
+}
+
+func requiredLevel(trs ...TASPodSetRequests) *string {
+	for _, tr := range trs {
+		if isRequired(tr.PodSet.TopologyRequest) {
+			return tr.PodSet.TopologyRequest.Required
+		}
+	}
+	return nil
+}
+
+// podSetDomainConstraints computes the constraints for placing the PodSets of
+// trs based on the assignments of the already assigned PodSets they relate to.
+// The relations to PodSets which are not assigned yet are honored when the
+// related PodSets are placed.
+func (s *TASFlavorSnapshot) podSetDomainConstraints(trs, flavorTASRequests FlavorTASRequests, assigned map[kueue.PodSetReference]*utiltas.TopologyAssignment) (podSetDomainConstraints, string) {
+	var result podSetDomainConstraints
+	for _, tr := range trs {
+		for _, other := range flavorTASRequests {
+			assignment, found := assigned[other.PodSet.Name]
+			if !found || assignment == nil {
+				continue
+			}
+			for _, relation := range podSetTopologyRelations(tr, other) {
+				levelIdx, found := s.resolveLevelIdx(relation.Level)
+				if !found {
+					return nil, fmt.Sprintf("no requested topology level: %s", relation.Level)
+				}
+				domainIDs := s.assignedDomainIDs(assignment, levelIdx)
+				if relation.Relation == kueue.SameDomainPodSetTopologyRelation && len(domainIDs) > 1 {
+					return nil, fmt.Sprintf("cannot place PodSet %s in the same domain as PodSet %s, which is assigned to %d domains at level: %s",
+						tr.PodSet.Name, other.PodSet.Name, len(domainIDs), relation.Level)
+				}
+				for _, domainID := range domainIDs {
+					result = append(result, podSetDomainConstraint{
+						levelIdx: levelIdx,
+						domainID: domainID,
+						relation: relation.Relation,
+					})
+				}
+			}
+		}
+	}
+	return result, ""
+}
+
+// assignedDomainIDs returns the sorted IDs of the topology domains at levelIdx
+// used by the assignment.
+func (s *TASFlavorSnapshot) assignedDomainIDs(assignment *utiltas.TopologyAssignment, levelIdx int) []utiltas.TopologyDomainID {
+	domainIDs := sets.New[utiltas.TopologyDomainID]()
+	for _, domain := range assignment.Domains {
+		leaf, found := s.leaves[utiltas.DomainID(domain.Values)]
+		if !found {
+			continue
+		}
+		domainIDs.Insert(leaf.domainIDAtLevel(levelIdx))
+	}
+	return sets.List(domainIDs)
+}
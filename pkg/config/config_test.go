@@ -408,10 +408,11 @@ objectRetentionPolicies:
 	}
 
 	defaultMultiKueue := &configapi.MultiKueue{
-		GCInterval:        &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
-		Origin:            ptr.To(configapi.DefaultMultiKueueOrigin),
-		WorkerLostTimeout: &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
-		DispatcherName:    ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+		GCInterval:         &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
+		Origin:             ptr.To(configapi.DefaultMultiKueueOrigin),
+		WorkerLostTimeout:  &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
+		DispatcherName:     ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+		StatusSyncInterval: &metav1.Duration{Duration: configapi.DefaultMultiKueueStatusSyncInterval},
 	}
 
 	testcases := []struct {
@@ -726,10 +727,11 @@ objectRetentionPolicies:
 				ClientConnection:           defaultClientConnection,
 				Integrations:               defaultIntegrations,
 				MultiKueue: &configapi.MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: 90 * time.Second},
-					Origin:            ptr.To("multikueue-manager1"),
-					WorkerLostTimeout: &metav1.Duration{Duration: 10 * time.Minute},
-					DispatcherName:    ptr.To(configapi.MultiKueueDispatcherModeIncremental),
+					GCInterval:         &metav1.Duration{Duration: 90 * time.Second},
+					Origin:             ptr.To("multikueue-manager1"),
+					WorkerLostTimeout:  &metav1.Duration{Duration: 10 * time.Minute},
+					DispatcherName:     ptr.To(configapi.MultiKueueDispatcherModeIncremental),
+					StatusSyncInterval: &metav1.Duration{Duration: configapi.DefaultMultiKueueStatusSyncInterval},
 					ClusterProfile: &configapi.ClusterProfile{
 						CredentialsProviders: []configapi.ClusterProfileCredentialsProvider{
 							{
@@ -919,10 +921,11 @@ webhook:
 					Frameworks: []string{job.FrameworkName},
 				},
 				MultiKueue: &configapi.MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
-					Origin:            ptr.To(configapi.DefaultMultiKueueOrigin),
-					WorkerLostTimeout: &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
-					DispatcherName:    ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					GCInterval:         &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
+					Origin:             ptr.To(configapi.DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:     ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					StatusSyncInterval: &metav1.Duration{Duration: configapi.DefaultMultiKueueStatusSyncInterval},
 				},
 				ManagedJobsNamespaceSelector: &metav1.LabelSelector{
 					MatchExpressions: []metav1.LabelSelectorRequirement{
@@ -969,10 +972,11 @@ webhook:
 					Frameworks: []string{job.FrameworkName},
 				},
 				MultiKueue: &configapi.MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
-					Origin:            ptr.To(configapi.DefaultMultiKueueOrigin),
-					WorkerLostTimeout: &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
-					DispatcherName:    ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					GCInterval:         &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
+					Origin:             ptr.To(configapi.DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:     ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					StatusSyncInterval: &metav1.Duration{Duration: configapi.DefaultMultiKueueStatusSyncInterval},
 				},
 				ManagedJobsNamespaceSelector: &metav1.LabelSelector{
 					MatchExpressions: []metav1.LabelSelectorRequirement{
@@ -1019,10 +1023,11 @@ webhook:
 					Frameworks: []string{job.FrameworkName},
 				},
 				MultiKueue: &configapi.MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
-					Origin:            ptr.To(configapi.DefaultMultiKueueOrigin),
-					WorkerLostTimeout: &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
-					DispatcherName:    ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					GCInterval:         &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
+					Origin:             ptr.To(configapi.DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:     ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					StatusSyncInterval: &metav1.Duration{Duration: configapi.DefaultMultiKueueStatusSyncInterval},
 				},
 				ManagedJobsNamespaceSelector: &metav1.LabelSelector{
 					MatchExpressions: []metav1.LabelSelectorRequirement{
@@ -1065,10 +1070,11 @@ webhook:
 					Frameworks: []string{job.FrameworkName},
 				},
 				MultiKueue: &configapi.MultiKueue{
-					GCInterval:        &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
-					Origin:            ptr.To(configapi.DefaultMultiKueueOrigin),
-					WorkerLostTimeout: &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
-					DispatcherName:    ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					GCInterval:         &metav1.Duration{Duration: configapi.DefaultMultiKueueGCInterval},
+					Origin:             ptr.To(configapi.DefaultMultiKueueOrigin),
+					WorkerLostTimeout:  &metav1.Duration{Duration: configapi.DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:     ptr.To(configapi.MultiKueueDispatcherModeAllAtOnce),
+					StatusSyncInterval: &metav1.Duration{Duration: configapi.DefaultMultiKueueStatusSyncInterval},
 				},
 				ManagedJobsNamespaceSelector: &metav1.LabelSelector{
 					MatchExpressions: []metav1.LabelSelectorRequirement{
@@ -1213,10 +1219,11 @@ func TestEncode(t *testing.T) {
 					"frameworks": []any{"batch/job"},
 				},
 				"multiKueue": map[string]any{
-					"gcInterval":        "1m0s",
-					"origin":            "multikueue",
-					"workerLostTimeout": "15m0s",
-					"dispatcherName":    configapi.MultiKueueDispatcherModeAllAtOnce,
+					"gcInterval":         "1m0s",
+					"origin":             "multikueue",
+					"workerLostTimeout":  "15m0s",
+					"statusSyncInterval": "1m0s",
+					"dispatcherName":     configapi.MultiKueueDispatcherModeAllAtOnce,
 				},
 			},
 		},
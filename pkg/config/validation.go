@@ -19,6 +19,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 
@@ -40,20 +41,27 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podworkload "sigs.k8s.io/kueue/pkg/controller/jobs/pod"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
 	stringsutils "sigs.k8s.io/kueue/pkg/util/strings"
 	"sigs.k8s.io/kueue/pkg/util/tlsconfig"
 	"sigs.k8s.io/kueue/pkg/util/waitforpodsready"
 )
 
+// maxDeclarativePodSets is the maximum number of PodSets of a Workload.
+const maxDeclarativePodSets = 8
+
 var (
 	integrationsPath                             = field.NewPath("integrations")
 	integrationsFrameworksPath                   = integrationsPath.Child("frameworks")
 	integrationsExternalFrameworkPath            = integrationsPath.Child("externalFrameworks")
+	integrationsDeclarativeFrameworksPath        = integrationsPath.Child("declarativeFrameworks")
 	managedJobsNamespaceSelectorPath             = field.NewPath("managedJobsNamespaceSelector")
 	waitForPodsReadyPath                         = field.NewPath("waitForPodsReady")
 	requeuingStrategyPath                        = waitForPodsReadyPath.Child("requeuingStrategy")
 	multiKueuePath                               = field.NewPath("multiKueue")
 	clusterProfileCredentialProvidersPath        = multiKueuePath.Child("clusterProfile").Child("credentialsProviders")
+	multiKueueScoringDispatcherPath              = multiKueuePath.Child("scoringDispatcher")
+	multiKueueCredentialsProvidersPath           = multiKueuePath.Child("credentialsProviders")
 	clusterProfileCredentialProvidersExecCfgPath = clusterProfileCredentialProvidersPath.Child("execConfig")
 	fsPreemptionStrategiesPath                   = field.NewPath("fairSharing", "preemptionStrategies")
 	afsResourceWeightsPath                       = field.NewPath("admissionFairSharing", "resourceWeights")
@@ -64,6 +72,8 @@ var (
 	objectRetentionPoliciesPath                  = field.NewPath("objectRetentionPolicies")
 	objectRetentionPoliciesWorkloadsPath         = objectRetentionPoliciesPath.Child("workloads")
 	tlsPath                                      = field.NewPath("tls")
+	tasNodeDrainPath                             = field.NewPath("topologyAwareScheduling", "nodeDrain")
+	checkpointAwareEvictionPath                  = field.NewPath("checkpointAwareEviction")
 )
 
 func validate(c *configapi.Configuration, scheme *runtime.Scheme) field.ErrorList {
@@ -79,6 +89,8 @@ func validate(c *configapi.Configuration, scheme *runtime.Scheme) field.ErrorLis
 	allErrs = append(allErrs, validateManagedJobsNamespaceSelector(c)...)
 	allErrs = append(allErrs, validateObjectRetentionPolicies(c)...)
 	allErrs = append(allErrs, validateTLS(c)...)
+	allErrs = append(allErrs, validateTASNodeDrain(c)...)
+	allErrs = append(allErrs, validateCheckpointAwareEviction(c)...)
 	return allErrs
 }
 
@@ -150,6 +162,7 @@ func validateMultiKueue(c *configapi.Configuration) field.ErrorList {
 				if builtInGVKs.Has(gvk) {
 					allErrs = append(allErrs, field.Invalid(fldPath, f.Name, "conflicts with a built-in MultiKueue adapter"))
 				}
+				allErrs = append(allErrs, validateMultiKueueExternalFrameworkPaths(f, path.Index(i))...)
 			}
 		}
 
@@ -186,6 +199,81 @@ func validateMultiKueue(c *configapi.Configuration) field.ErrorList {
 				}
 			}
 		}
+
+		if sd := c.MultiKueue.ScoringDispatcher; sd != nil {
+			if sd.CapacitySyncInterval != nil && sd.CapacitySyncInterval.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(multiKueueScoringDispatcherPath.Child("capacitySyncInterval"),
+					sd.CapacitySyncInterval.Duration.String(), "must be greater than 0"))
+			}
+			if sd.FreeCapacityWeight != nil {
+				allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*sd.FreeCapacityWeight), multiKueueScoringDispatcherPath.Child("freeCapacityWeight"))...)
+			}
+			if sd.PendingWorkloadsWeight != nil {
+				allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*sd.PendingWorkloadsWeight), multiKueueScoringDispatcherPath.Child("pendingWorkloadsWeight"))...)
+			}
+			for i, preference := range sd.ClusterPreferences {
+				path := multiKueueScoringDispatcherPath.Child("clusterPreferences").Index(i)
+				allErrs = append(allErrs, validation.ValidateLabelSelector(&preference.Selector, validation.LabelSelectorValidationOptions{}, path.Child("selector"))...)
+				allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(preference.Weight), path.Child("weight"))...)
+			}
+		}
+
+		if i := c.MultiKueue.StatusSyncInterval; i != nil && i.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(multiKueuePath.Child("statusSyncInterval"),
+				i.Duration.String(), "must be greater than 0"))
+		}
+
+		if m := c.MultiKueue.Migration; m != nil && m.PodsReadyTimeout != nil && m.PodsReadyTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(multiKueuePath.Child("migration", "podsReadyTimeout"),
+				m.PodsReadyTimeout.Duration.String(), "must be greater than 0"))
+		}
+
+		if qf := c.MultiKueue.QuotaFederation; qf != nil && qf.Mode != "" && qf.Mode != configapi.MultiKueueQuotaFederationModeLease {
+			allErrs = append(allErrs, field.NotSupported(multiKueuePath.Child("quotaFederation", "mode"),
+				qf.Mode, []configapi.MultiKueueQuotaFederationMode{configapi.MultiKueueQuotaFederationModeLease}))
+		}
+
+		allErrs = append(allErrs, validateMultiKueueCredentialsProviders(c.MultiKueue.CredentialsProviders)...)
+	}
+	return allErrs
+}
+
+func validateMultiKueueCredentialsProviders(providers []configapi.MultiKueueCredentialsProvider) field.ErrorList {
+	var allErrs field.ErrorList
+	seenNames := sets.New[string]()
+	for i, provider := range providers {
+		path := multiKueueCredentialsProvidersPath.Index(i)
+		switch {
+		case len(provider.Name) == 0:
+			allErrs = append(allErrs, field.Required(path.Child("name"), "must be specified"))
+		case seenNames.Has(provider.Name):
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), provider.Name))
+		default:
+			seenNames.Insert(provider.Name)
+		}
+		if (provider.Exec == nil) == (provider.ServiceAccountTokenExchange == nil) {
+			allErrs = append(allErrs, field.Invalid(path, provider.Name, "exactly one of exec and serviceAccountTokenExchange must be specified"))
+		}
+		if exec := provider.Exec; exec != nil {
+			if len(exec.Command) == 0 {
+				allErrs = append(allErrs, field.Required(path.Child("exec", "command"), "must be specified"))
+			}
+			for j, v := range exec.Env {
+				if len(v.Name) == 0 {
+					allErrs = append(allErrs, field.Required(path.Child("exec", "env").Index(j).Child("name"), "must be specified"))
+				}
+			}
+		}
+		if exchange := provider.ServiceAccountTokenExchange; exchange != nil {
+			if len(exchange.TokenPath) == 0 {
+				allErrs = append(allErrs, field.Required(path.Child("serviceAccountTokenExchange", "tokenPath"), "must be specified"))
+			}
+			if len(exchange.ExchangeURL) == 0 {
+				allErrs = append(allErrs, field.Required(path.Child("serviceAccountTokenExchange", "exchangeURL"), "must be specified"))
+			} else if u, err := url.Parse(exchange.ExchangeURL); err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+				allErrs = append(allErrs, field.Invalid(path.Child("serviceAccountTokenExchange", "exchangeURL"), exchange.ExchangeURL, "must be an absolute http or https URL"))
+			}
+		}
 	}
 	return allErrs
 }
@@ -261,11 +349,70 @@ func validateIntegrations(c *configapi.Configuration, scheme *runtime.Scheme) fi
 			managedFrameworks = managedFrameworks.Insert(gvk.String())
 		}
 	}
+	for idx, framework := range c.Integrations.DeclarativeFrameworks {
+		fldPath := integrationsDeclarativeFrameworksPath.Index(idx)
+		gvk, _ := schema.ParseKindArg(framework.Name)
+		switch {
+		case gvk == nil:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), framework.Name, "must be format, 'Kind.version.group.com'"))
+		case managedFrameworks.Has(gvk.String()):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), framework.Name))
+		default:
+			managedFrameworks = managedFrameworks.Insert(gvk.String())
+		}
+		allErrs = append(allErrs, validateDeclarativeFramework(framework, fldPath)...)
+	}
 
 	allErrs = append(allErrs, validatePodIntegrationOptions(c)...)
 	return allErrs
 }
 
+func validateDeclarativeFramework(f configapi.DeclarativeFramework, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	validatePath := func(fldPath *field.Path, value string, required bool) {
+		if value == "" {
+			if required {
+				allErrs = append(allErrs, field.Required(fldPath, ""))
+			}
+			return
+		}
+		if _, err := fieldpath.Parse(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
+		}
+	}
+
+	validatePath(fldPath.Child("suspendPath"), f.SuspendPath, false)
+	validatePath(fldPath.Child("conditionsPath"), f.ConditionsPath, false)
+	if f.ActiveConditionType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("activeConditionType"), ""))
+	}
+	if f.SucceededConditionType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("succeededConditionType"), ""))
+	}
+
+	podSetsPath := fldPath.Child("podSets")
+	switch {
+	case len(f.PodSets) == 0:
+		allErrs = append(allErrs, field.Required(podSetsPath, ""))
+	case len(f.PodSets) > maxDeclarativePodSets:
+		allErrs = append(allErrs, field.TooMany(podSetsPath, len(f.PodSets), maxDeclarativePodSets))
+	}
+	podSetNames := sets.New[string]()
+	for i, ps := range f.PodSets {
+		psPath := podSetsPath.Index(i)
+		if errs := apimachineryutilvalidation.IsDNS1123Label(ps.Name); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(psPath.Child("name"), ps.Name, strings.Join(errs, ",")))
+		} else if podSetNames.Has(ps.Name) {
+			allErrs = append(allErrs, field.Duplicate(psPath.Child("name"), ps.Name))
+		}
+		podSetNames.Insert(ps.Name)
+		validatePath(psPath.Child("templatePath"), ps.TemplatePath, true)
+		validatePath(psPath.Child("replicasPath"), ps.ReplicasPath, false)
+		validatePath(psPath.Child("nodeSelectorPath"), ps.NodeSelectorPath, false)
+	}
+	return allErrs
+}
+
 func validateNamespaceSelectorForPodIntegration(c *configapi.Configuration, namespaceSelector *metav1.LabelSelector, namespaceSelectorPath *field.Path, allErrs field.ErrorList) field.ErrorList {
 	allErrs = append(allErrs, validation.ValidateLabelSelector(namespaceSelector, validation.LabelSelectorValidationOptions{}, namespaceSelectorPath)...)
 	selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
@@ -562,3 +709,69 @@ func validateTLS(c *configapi.Configuration) field.ErrorList {
 	}
 	return allErrs
 }
+
+func validateTASNodeDrain(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if c.TopologyAwareScheduling == nil || c.TopologyAwareScheduling.NodeDrain == nil {
+		return allErrs
+	}
+	nd := c.TopologyAwareScheduling.NodeDrain
+	if nd.MaintenanceLabel != nil {
+		allErrs = append(allErrs, validation.ValidateLabelName(*nd.MaintenanceLabel, tasNodeDrainPath.Child("maintenanceLabel"))...)
+	}
+	if nd.MaintenanceTaintKey != nil {
+		allErrs = append(allErrs, validation.ValidateLabelName(*nd.MaintenanceTaintKey, tasNodeDrainPath.Child("maintenanceTaintKey"))...)
+	}
+	if nd.MigrationLeadTime != nil && nd.MigrationLeadTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(tasNodeDrainPath.Child("migrationLeadTime"),
+			nd.MigrationLeadTime.Duration.String(), apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+func validateCheckpointAwareEviction(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if c.CheckpointAwareEviction == nil {
+		return allErrs
+	}
+	if mgp := c.CheckpointAwareEviction.MaxGracePeriod; mgp != nil && mgp.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(checkpointAwareEvictionPath.Child("maxGracePeriod"),
+			mgp.Duration.String(), "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func validateMultiKueueExternalFrameworkPaths(f configapi.MultiKueueExternalFramework, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	validatePath := func(fldPath *field.Path, value string, prefixes ...[]string) {
+		path, err := fieldpath.Parse(value)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
+			return
+		}
+		for _, prefix := range prefixes {
+			if len(path) > len(prefix) && path.HasPrefix(prefix...) {
+				return
+			}
+		}
+		allowed := make([]string, 0, len(prefixes))
+		for _, prefix := range prefixes {
+			allowed = append(allowed, fieldpath.Path(prefix).String())
+		}
+		allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must be a field under %s", strings.Join(allowed, ", "))))
+	}
+
+	if f.ManagedByPath != "" {
+		validatePath(fldPath.Child("managedByPath"), f.ManagedByPath, []string{"spec"})
+	}
+	for i, p := range f.SyncedSpecPaths {
+		validatePath(fldPath.Child("syncedSpecPaths").Index(i), p, []string{"spec"})
+	}
+	for i, p := range f.StatusPaths {
+		validatePath(fldPath.Child("statusPaths").Index(i), p, []string{"status"})
+	}
+	for i, p := range f.RemovedPathsOnCreate {
+		validatePath(fldPath.Child("removedPathsOnCreate").Index(i), p, []string{"spec"}, []string{"metadata", "labels"}, []string{"metadata", "annotations"})
+	}
+	return allErrs
+}
@@ -157,6 +157,96 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid integrations.declarativeFrameworks": {
+			cfg: &configapi.Configuration{
+				Integrations: &configapi.Integrations{
+					Frameworks: []string{"batch/job"},
+					DeclarativeFrameworks: []configapi.DeclarativeFramework{{
+						Name:        "TrainingRun.v1.example.com",
+						SuspendPath: ".spec.paused",
+						PodSets: []configapi.DeclarativePodSet{
+							{Name: "launcher", TemplatePath: ".spec.launcher.template"},
+							{
+								Name:             "worker",
+								TemplatePath:     ".spec.worker.template",
+								ReplicasPath:     ".spec.worker.replicas",
+								NodeSelectorPath: ".spec.worker.nodeSelector",
+							},
+						},
+						ActiveConditionType:    "Running",
+						SucceededConditionType: "Succeeded",
+						FailedConditionType:    "Failed",
+					}},
+				},
+			},
+		},
+		"invalid integrations.declarativeFrameworks": {
+			cfg: &configapi.Configuration{
+				Integrations: &configapi.Integrations{
+					Frameworks:         []string{"batch/job"},
+					ExternalFrameworks: []string{"TrainingRun.v1.example.com"},
+					DeclarativeFrameworks: []configapi.DeclarativeFramework{
+						{
+							Name:        "TrainingRun.v1.example.com",
+							SuspendPath: "spec.paused",
+							PodSets: []configapi.DeclarativePodSet{
+								{Name: "worker", TemplatePath: ".spec.worker.template"},
+								{Name: "worker", ReplicasPath: ".spec.replicas[0]"},
+							},
+						},
+						{
+							Name: "invalid",
+						},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "integrations.declarativeFrameworks[0].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "integrations.declarativeFrameworks[0].suspendPath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.declarativeFrameworks[0].activeConditionType",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.declarativeFrameworks[0].succeededConditionType",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "integrations.declarativeFrameworks[0].podSets[1].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.declarativeFrameworks[0].podSets[1].templatePath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "integrations.declarativeFrameworks[0].podSets[1].replicasPath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "integrations.declarativeFrameworks[1].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.declarativeFrameworks[1].activeConditionType",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.declarativeFrameworks[1].succeededConditionType",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.declarativeFrameworks[1].podSets",
+				},
+			},
+		},
 		"nil managedJobsNamespaceSelector with pod framework": {
 			cfg: &configapi.Configuration{
 				Integrations: &configapi.Integrations{
@@ -427,6 +517,52 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid multiKueue.externalFrameworks field paths": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ExternalFrameworks: []configapi.MultiKueueExternalFramework{{
+						Name:                 "Workflow.v1alpha1.argoproj.io",
+						ManagedByPath:        ".spec.controller",
+						SyncedSpecPaths:      []string{".spec.suspend"},
+						StatusPaths:          []string{".status.phase", ".status.nodes"},
+						RemovedPathsOnCreate: []string{".metadata.labels['example.com/owner']", ".spec.serviceAccountName"},
+					}},
+				},
+			},
+		},
+		"invalid multiKueue.externalFrameworks field paths": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ExternalFrameworks: []configapi.MultiKueueExternalFramework{{
+						Name:                 "Workflow.v1alpha1.argoproj.io",
+						ManagedByPath:        "spec.controller",
+						SyncedSpecPaths:      []string{".spec"},
+						StatusPaths:          []string{".status.nodes[*]"},
+						RemovedPathsOnCreate: []string{".metadata.name"},
+					}},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].managedByPath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].syncedSpecPaths[0]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].statusPaths[0]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].removedPathsOnCreate[0]",
+				},
+			},
+		},
 		"empty multiKueue.clusterProfile.credentialsProviders.name": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -555,6 +691,187 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid multiKueue.scoringDispatcher": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: ptr.To(configapi.MultiKueueDispatcherModeScoring),
+					ScoringDispatcher: &configapi.MultiKueueScoringDispatcher{
+						CapacitySyncInterval:   &metav1.Duration{Duration: time.Minute},
+						FreeCapacityWeight:     ptr.To[int32](0),
+						PendingWorkloadsWeight: ptr.To[int32](5),
+						ClusterPreferences: []configapi.MultiKueueClusterPreference{{
+							Selector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "us-east"}},
+							Weight:   20,
+						}},
+					},
+				},
+			},
+		},
+		"invalid multiKueue.scoringDispatcher": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: ptr.To(configapi.MultiKueueDispatcherModeScoring),
+					ScoringDispatcher: &configapi.MultiKueueScoringDispatcher{
+						CapacitySyncInterval:   &metav1.Duration{},
+						FreeCapacityWeight:     ptr.To[int32](-1),
+						PendingWorkloadsWeight: ptr.To[int32](-1),
+						ClusterPreferences: []configapi.MultiKueueClusterPreference{{
+							Selector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "us east"}},
+							Weight:   -1,
+						}},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.scoringDispatcher.capacitySyncInterval",
+				},
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "multiKueue.scoringDispatcher.freeCapacityWeight",
+					Origin: "minimum",
+				},
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "multiKueue.scoringDispatcher.pendingWorkloadsWeight",
+					Origin: "minimum",
+				},
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "multiKueue.scoringDispatcher.clusterPreferences[0].selector.matchLabels",
+					Origin: "format=k8s-label-value",
+				},
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "multiKueue.scoringDispatcher.clusterPreferences[0].weight",
+					Origin: "minimum",
+				},
+			},
+		},
+		"invalid multiKueue.migration.podsReadyTimeout": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					Migration: &configapi.MultiKueueMigration{
+						PodsReadyTimeout: &metav1.Duration{},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.migration.podsReadyTimeout",
+				},
+			},
+		},
+		"invalid multiKueue.statusSyncInterval": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					StatusSyncInterval: &metav1.Duration{},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.statusSyncInterval",
+				},
+			},
+		},
+		"unsupported multiKueue.quotaFederation.mode": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					QuotaFederation: &configapi.MultiKueueQuotaFederation{
+						Mode: "Partition",
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "multiKueue.quotaFederation.mode",
+				},
+			},
+		},
+		"valid multiKueue.credentialsProviders": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					CredentialsProviders: []configapi.MultiKueueCredentialsProvider{
+						{
+							Name: "exec",
+							Exec: &configapi.MultiKueueExecCredentialsProvider{
+								Command: "/bin/plugin",
+								Env:     []clientcmdapi.ExecEnvVar{{Name: "KEY", Value: "value"}},
+							},
+						},
+						{
+							Name: "token-exchange",
+							ServiceAccountTokenExchange: &configapi.MultiKueueServiceAccountTokenExchange{
+								TokenPath:   "/var/run/secrets/tokens/token",
+								ExchangeURL: "https://sts.example.com/token",
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid multiKueue.credentialsProviders": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					CredentialsProviders: []configapi.MultiKueueCredentialsProvider{
+						{
+							Name: "exec",
+							Exec: &configapi.MultiKueueExecCredentialsProvider{
+								Env: []clientcmdapi.ExecEnvVar{{Value: "value"}},
+							},
+						},
+						{
+							Name: "exec",
+							ServiceAccountTokenExchange: &configapi.MultiKueueServiceAccountTokenExchange{
+								ExchangeURL: "sts.example.com/token",
+							},
+						},
+						{},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "multiKueue.credentialsProviders[0].exec.command",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "multiKueue.credentialsProviders[0].exec.env[0].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "multiKueue.credentialsProviders[1].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "multiKueue.credentialsProviders[1].serviceAccountTokenExchange.tokenPath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.credentialsProviders[1].serviceAccountTokenExchange.exchangeURL",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "multiKueue.credentialsProviders[2].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.credentialsProviders[2]",
+				},
+			},
+		},
 		"invalid multiKueue.clusterProfile.credentialsProviders.execConfig.interactiveMode": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -961,6 +1278,68 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid .topologyAwareScheduling.nodeDrain": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				TopologyAwareScheduling: &configapi.TopologyAwareScheduling{
+					NodeDrain: &configapi.TASNodeDrain{
+						MaintenanceLabel:    ptr.To("example.com/maintenance"),
+						MaintenanceTaintKey: ptr.To("example.com/maintenance"),
+						MigrationLeadTime:   ptr.To(metav1.Duration{Duration: 0}),
+					},
+				},
+			},
+		},
+		"invalid .topologyAwareScheduling.nodeDrain": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				TopologyAwareScheduling: &configapi.TopologyAwareScheduling{
+					NodeDrain: &configapi.TASNodeDrain{
+						MaintenanceLabel:    ptr.To("example.com/main tenance"),
+						MaintenanceTaintKey: ptr.To("/maintenance"),
+						MigrationLeadTime:   ptr.To(metav1.Duration{Duration: -1}),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "topologyAwareScheduling.nodeDrain.maintenanceLabel",
+					Origin: "format=k8s-label-key",
+				},
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "topologyAwareScheduling.nodeDrain.maintenanceTaintKey",
+					Origin: "format=k8s-label-key",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "topologyAwareScheduling.nodeDrain.migrationLeadTime",
+				},
+			},
+		},
+		"valid .checkpointAwareEviction": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				CheckpointAwareEviction: &configapi.CheckpointAwareEviction{
+					MaxGracePeriod: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+				},
+			},
+		},
+		"invalid .checkpointAwareEviction.maxGracePeriod": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				CheckpointAwareEviction: &configapi.CheckpointAwareEviction{
+					MaxGracePeriod: ptr.To(metav1.Duration{Duration: 0}),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "checkpointAwareEviction.maxGracePeriod",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -21,12 +21,15 @@ import (
 )
 
 const (
-	KueueName                    = "kueue"
-	JobControllerName            = KueueName + "-job-controller"
-	WorkloadControllerName       = KueueName + "-workload-controller"
-	PodTerminationControllerName = KueueName + "-pod-termination-controller"
-	AdmissionName                = KueueName + "-admission"
-	ReclaimablePodsMgr           = KueueName + "-reclaimable-pods"
+	KueueName                        = "kueue"
+	JobControllerName                = KueueName + "-job-controller"
+	WorkloadControllerName           = KueueName + "-workload-controller"
+	PodTerminationControllerName     = KueueName + "-pod-termination-controller"
+	AdmissionName                    = KueueName + "-admission"
+	ReclaimablePodsMgr               = KueueName + "-reclaimable-pods"
+	TasksStatusMgr                   = KueueName + "-tasks-status"
+	TASNodeDrainControllerName       = KueueName + "-tas-node-drain-controller"
+	WorkloadDependencyControllerName = KueueName + "-workload-dependency-controller"
 
 	// UpdatesBatchPeriod is the batch period to hold workload updates
 	// before syncing a Queue and ClusterQueue objects.
@@ -0,0 +1,166 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// ClusterQueue is the capacity of a ClusterQueue in a worker cluster.
+type ClusterQueue struct {
+	NominalQuota     resources.FlavorResourceQuantities
+	Reservation      resources.FlavorResourceQuantities
+	Usage            resources.FlavorResourceQuantities
+	PendingWorkloads int32
+}
+
+// FreeQuota returns the nominal quota which is not reserved, per resource,
+// summed over all the flavors.
+func (cq *ClusterQueue) FreeQuota() resources.Requests {
+	free := cq.NominalQuota.FlattenFlavors()
+	free.Sub(cq.Reservation.FlattenFlavors())
+	return free
+}
+
+// Worker is the capacity of a worker cluster.
+type Worker struct {
+	ClusterQueues map[kueue.ClusterQueueReference]*ClusterQueue
+	// LocalQueues maps the LocalQueues of the worker cluster to their ClusterQueues.
+	LocalQueues map[utilqueue.LocalQueueReference]kueue.ClusterQueueReference
+	// SyncTime is the time at which the capacity was read from the worker cluster.
+	SyncTime time.Time
+	// ForeignReservation is the quota reserved by the Workloads which were not
+	// dispatched by this manager, per LocalQueue.
+	// It is only loaded when the quota federation is enabled.
+	ForeignReservation map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities
+}
+
+// ClusterQueueFor returns the capacity of the ClusterQueue which would be used
+// by the Workload in the worker cluster.
+func (w *Worker) ClusterQueueFor(wl *kueue.Workload) (*ClusterQueue, bool) {
+	cqName, found := w.LocalQueues[utilqueue.KeyFromWorkload(wl)]
+	if !found {
+		return nil, false
+	}
+	cq, found := w.ClusterQueues[cqName]
+	return cq, found
+}
+
+// Cache holds the capacity of the worker clusters, indexed by the MultiKueueCluster name.
+type Cache = utilmaps.SyncMap[string, *Worker]
+
+func NewCache() *Cache {
+	return utilmaps.NewSyncMap[string, *Worker](0)
+}
+
+// Load reads the capacity of the worker cluster using its client.
+func Load(ctx context.Context, c client.Client, now time.Time) (*Worker, error) {
+	cqs := &kueue.ClusterQueueList{}
+	if err := c.List(ctx, cqs); err != nil {
+		return nil, err
+	}
+	lqs := &kueue.LocalQueueList{}
+	if err := c.List(ctx, lqs); err != nil {
+		return nil, err
+	}
+
+	w := &Worker{
+		ClusterQueues: make(map[kueue.ClusterQueueReference]*ClusterQueue, len(cqs.Items)),
+		LocalQueues:   make(map[utilqueue.LocalQueueReference]kueue.ClusterQueueReference, len(lqs.Items)),
+		SyncTime:      now,
+	}
+	for i := range cqs.Items {
+		cq := &cqs.Items[i]
+		w.ClusterQueues[kueue.ClusterQueueReference(cq.Name)] = NewClusterQueue(cq)
+	}
+	for i := range lqs.Items {
+		lq := &lqs.Items[i]
+		w.LocalQueues[utilqueue.Key(lq)] = lq.Spec.ClusterQueue
+	}
+	return w, nil
+}
+
+// NewClusterQueue returns the capacity of the ClusterQueue, read from its spec and status.
+func NewClusterQueue(cq *kueue.ClusterQueue) *ClusterQueue {
+	result := &ClusterQueue{
+		NominalQuota:     make(resources.FlavorResourceQuantities),
+		Reservation:      make(resources.FlavorResourceQuantities),
+		Usage:            make(resources.FlavorResourceQuantities),
+		PendingWorkloads: cq.Status.PendingWorkloads,
+	}
+	for _, rg := range cq.Spec.ResourceGroups {
+		for _, fq := range rg.Flavors {
+			for _, rq := range fq.Resources {
+				fr := resources.FlavorResource{Flavor: fq.Name, Resource: rq.Name}
+				result.NominalQuota[fr] += resources.ResourceValue(rq.Name, rq.NominalQuota)
+			}
+		}
+	}
+	for _, fu := range cq.Status.FlavorsReservation {
+		for _, ru := range fu.Resources {
+			fr := resources.FlavorResource{Flavor: fu.Name, Resource: ru.Name}
+			result.Reservation[fr] += resources.ResourceValue(ru.Name, ru.Total)
+		}
+	}
+	for _, fu := range cq.Status.FlavorsUsage {
+		for _, ru := range fu.Resources {
+			fr := resources.FlavorResource{Flavor: fu.Name, Resource: ru.Name}
+			result.Usage[fr] += resources.ResourceValue(ru.Name, ru.Total)
+		}
+	}
+	return result
+}
+
+// LoadForeignReservation reads, using the client of the worker cluster, the quota
+// reserved by the Workloads which were not dispatched by the manager with the given
+// origin, per LocalQueue.
+func LoadForeignReservation(ctx context.Context, c client.Client, origin string) (map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities, error) {
+	notDispatched, err := labels.NewRequirement(kueue.MultiKueueOriginLabel, selection.NotEquals, []string{origin})
+	if err != nil {
+		return nil, err
+	}
+	wls := &kueue.WorkloadList{}
+	if err := c.List(ctx, wls, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*notDispatched)}); err != nil {
+		return nil, err
+	}
+	result := make(map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities)
+	for i := range wls.Items {
+		wl := &wls.Items[i]
+		if !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) {
+			continue
+		}
+		lqKey := utilqueue.KeyFromWorkload(wl)
+		if _, found := result[lqKey]; !found {
+			result[lqKey] = make(resources.FlavorResourceQuantities)
+		}
+		for fr, q := range workload.NewInfo(wl).FlavorResourceUsage() {
+			result[lqKey][fr] += q
+		}
+	}
+	return result, nil
+}
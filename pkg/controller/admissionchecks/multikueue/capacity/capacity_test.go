@@ -0,0 +1,128 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestLoad(t *testing.T) {
+	now := time.Now()
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("on-demand").Resource(corev1.ResourceCPU, "8").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "4").Obj(),
+		).
+		Obj()
+	cq.Status.PendingWorkloads = 2
+	cq.Status.FlavorsReservation = []kueue.FlavorUsage{{
+		Name: "on-demand",
+		Resources: []kueue.ResourceUsage{{
+			Name:  corev1.ResourceCPU,
+			Total: resource.MustParse("6"),
+		}},
+	}}
+	cq.Status.FlavorsUsage = []kueue.FlavorUsage{{
+		Name: "on-demand",
+		Resources: []kueue.ResourceUsage{{
+			Name:  corev1.ResourceCPU,
+			Total: resource.MustParse("4"),
+		}},
+	}}
+	lq := utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj()
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	c := utiltesting.NewClientBuilder().WithObjects(cq, lq).Build()
+
+	got, err := Load(ctx, c, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := &Worker{
+		ClusterQueues: map[kueue.ClusterQueueReference]*ClusterQueue{
+			"cq": {
+				NominalQuota: resources.FlavorResourceQuantities{
+					{Flavor: "on-demand", Resource: corev1.ResourceCPU}: 8000,
+					{Flavor: "spot", Resource: corev1.ResourceCPU}:      4000,
+				},
+				Reservation: resources.FlavorResourceQuantities{
+					{Flavor: "on-demand", Resource: corev1.ResourceCPU}: 6000,
+				},
+				Usage: resources.FlavorResourceQuantities{
+					{Flavor: "on-demand", Resource: corev1.ResourceCPU}: 4000,
+				},
+				PendingWorkloads: 2,
+			},
+		},
+		LocalQueues: map[utilqueue.LocalQueueReference]kueue.ClusterQueueReference{
+			"ns/lq": "cq",
+		},
+		SyncTime: now,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected worker capacity (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(resources.Requests{corev1.ResourceCPU: 6000}, got.ClusterQueues["cq"].FreeQuota()); diff != "" {
+		t.Errorf("Unexpected free quota (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLoadForeignReservation(t *testing.T) {
+	now := time.Now()
+	reserved := func(name, cpu string) *utiltestingapi.WorkloadWrapper {
+		return utiltestingapi.MakeWorkload(name, "ns").
+			Queue("lq").
+			Request(corev1.ResourceCPU, cpu).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "on-demand", cpu).
+					Obj()).Obj(), now)
+	}
+	wls := []client.Object{
+		reserved("local", "2").Obj(),
+		reserved("other-manager", "3").Label(kueue.MultiKueueOriginLabel, "other").Obj(),
+		reserved("dispatched", "4").Label(kueue.MultiKueueOriginLabel, "origin").Obj(),
+		reserved("finished", "5").Finished().Obj(),
+		utiltestingapi.MakeWorkload("pending", "ns").Queue("lq").Request(corev1.ResourceCPU, "6").Obj(),
+	}
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	c := utiltesting.NewClientBuilder().WithObjects(wls...).Build()
+
+	got, err := LoadForeignReservation(ctx, c, "origin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities{
+		"ns/lq": {{Flavor: "on-demand", Resource: corev1.ResourceCPU}: 5000},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected foreign reservation (-want,+got):\n%s", diff)
+	}
+}
@@ -23,7 +23,11 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
@@ -37,14 +41,23 @@ const (
 )
 
 type SetupOptions struct {
-	gcInterval           time.Duration
-	origin               string
-	workerLostTimeout    time.Duration
-	eventsBatchPeriod    time.Duration
-	adapters             map[string]jobframework.MultiKueueAdapter
-	dispatcherName       string
-	clusterProfileConfig *configapi.ClusterProfile
-	roleTracker          *roletracker.RoleTracker
+	gcInterval                time.Duration
+	origin                    string
+	workerLostTimeout         time.Duration
+	eventsBatchPeriod         time.Duration
+	adapters                  map[string]jobframework.MultiKueueAdapter
+	dispatcherName            string
+	clusterProfileConfig      *configapi.ClusterProfile
+	roleTracker               *roletracker.RoleTracker
+	capacityCache             *capacity.Cache
+	capacitySyncInterval      time.Duration
+	workerClients             *workers.Clients
+	statusSyncInterval        time.Duration
+	migrationPodsReadyTimeout time.Duration
+	migrateFromDrained        bool
+	schedulerCache            *schdcache.Cache
+	queues                    *qcache.Manager
+	credentialsProviders      map[string]CredentialsProvider
 }
 
 type SetupOption func(o *SetupOptions)
@@ -101,6 +114,76 @@ func WithClusterProfiles(clusterProfiles *configapi.ClusterProfile) SetupOption
 	}
 }
 
+// WithCapacityCache - sets the cache in which the capacity of the worker
+// clusters is kept, and the interval between two consecutive refreshes.
+func WithCapacityCache(cache *capacity.Cache, syncInterval time.Duration) SetupOption {
+	return func(o *SetupOptions) {
+		o.capacityCache = cache
+		o.capacitySyncInterval = syncInterval
+	}
+}
+
+// WithWorkerClients - sets the registry in which the clients of the
+// connected worker clusters are kept.
+func WithWorkerClients(clients *workers.Clients) SetupOption {
+	return func(o *SetupOptions) {
+		o.workerClients = clients
+	}
+}
+
+// WithStatusSyncInterval - sets the interval between two consecutive reads of
+// the stats of the worker clusters published in the status of their
+// MultiKueueClusters and in metrics.
+// If 0 the worker clusters stats are not published.
+func WithStatusSyncInterval(i time.Duration) SetupOption {
+	return func(o *SetupOptions) {
+		o.statusSyncInterval = i
+	}
+}
+
+// WithMigrationPodsReadyTimeout - sets the time after which a workload whose
+// pods are not ready in its worker cluster is migrated to another one.
+// If 0 the workloads are not migrated automatically.
+func WithMigrationPodsReadyTimeout(d time.Duration) SetupOption {
+	return func(o *SetupOptions) {
+		o.migrationPodsReadyTimeout = d
+	}
+}
+
+// WithMigrationFromDrainedClusters - enables migrating the workloads admitted in
+// a worker cluster with the Drain cordon policy to other worker clusters.
+func WithMigrationFromDrainedClusters(enabled bool) SetupOption {
+	return func(o *SetupOptions) {
+		o.migrateFromDrained = enabled
+	}
+}
+
+// WithQuotaFederation - enables recording the quota reserved in the worker
+// clusters by the workloads which were not dispatched by this manager as the
+// usage of the manager ClusterQueues in the scheduler cache, and requeueing the
+// inadmissible workloads of the ClusterQueues when it changes.
+// It requires the capacity cache to be set.
+func WithQuotaFederation(cache *schdcache.Cache, queues *qcache.Manager) SetupOption {
+	return func(o *SetupOptions) {
+		o.schedulerCache = cache
+		o.queues = queues
+	}
+}
+
+// WithCredentialsProviders - registers the providers of the credentials used to
+// connect to the worker clusters whose MultiKueueCluster has the
+// kueue.x-k8s.io/multikueue-credentials-provider annotation set to their name.
+func WithCredentialsProviders(providers ...CredentialsProvider) SetupOption {
+	return func(o *SetupOptions) {
+		if o.credentialsProviders == nil {
+			o.credentialsProviders = make(map[string]CredentialsProvider, len(providers))
+		}
+		for _, p := range providers {
+			o.credentialsProviders[p.Name()] = p
+		}
+	}
+}
+
 // WithRoleTracker sets the role tracker for HA logging.
 func WithRoleTracker(tracker *roletracker.RoleTracker) SetupOption {
 	return func(o *SetupOptions) {
@@ -110,12 +193,13 @@ func WithRoleTracker(tracker *roletracker.RoleTracker) SetupOption {
 
 func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) error {
 	options := &SetupOptions{
-		gcInterval:        defaultGCInterval,
-		origin:            defaultOrigin,
-		workerLostTimeout: defaultWorkerLostTimeout,
-		eventsBatchPeriod: constants.UpdatesBatchPeriod,
-		adapters:          make(map[string]jobframework.MultiKueueAdapter),
-		dispatcherName:    configapi.MultiKueueDispatcherModeAllAtOnce,
+		gcInterval:           defaultGCInterval,
+		origin:               defaultOrigin,
+		workerLostTimeout:    defaultWorkerLostTimeout,
+		eventsBatchPeriod:    constants.UpdatesBatchPeriod,
+		adapters:             make(map[string]jobframework.MultiKueueAdapter),
+		dispatcherName:       configapi.MultiKueueDispatcherModeAllAtOnce,
+		capacitySyncInterval: configapi.DefaultMultiKueueCapacitySyncInterval,
 	}
 
 	for _, o := range opts {
@@ -149,6 +233,14 @@ func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) e
 	}
 
 	cRec := newClustersReconciler(mgr.GetClient(), namespace, options.gcInterval, options.origin, fsWatcher, options.adapters, cpCreds, options.roleTracker)
+	cRec.capacityCache = options.capacityCache
+	cRec.capacitySyncInterval = options.capacitySyncInterval
+	cRec.workerClients = options.workerClients
+	cRec.statusSyncInterval = options.statusSyncInterval
+	cRec.quotaFederation = options.schedulerCache != nil && options.queues != nil && options.capacityCache != nil
+	cRec.schedulerCache = options.schedulerCache
+	cRec.queues = options.queues
+	cRec.credentialsProviders = options.credentialsProviders
 	err = cRec.setupWithManager(mgr)
 	if err != nil {
 		return err
@@ -162,5 +254,7 @@ func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) e
 
 	wlRec := newWlReconciler(mgr.GetClient(), helper, cRec, options.origin, mgr.GetEventRecorderFor(constants.WorkloadControllerName),
 		options.workerLostTimeout, options.eventsBatchPeriod, options.adapters, options.dispatcherName, options.roleTracker)
+	wlRec.migrationPodsReadyTimeout = options.migrationPodsReadyTimeout
+	wlRec.migrateFromDrainedClusters = options.migrateFromDrained
 	return wlRec.setupWithManager(mgr)
 }
@@ -0,0 +1,354 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	"k8s.io/client-go/rest"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+const (
+	// minCredentialsRefreshInterval is the minimum time waited before requesting
+	// new credentials from a provider.
+	minCredentialsRefreshInterval = 10 * time.Second
+
+	// tokenExchangeTimeout is the time limit of a token exchange request.
+	tokenExchangeTimeout = 30 * time.Second
+
+	execInfoEnv = "KUBERNETES_EXEC_INFO"
+
+	tokenExchangeGrantType       = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenExchangeJWTTokenType    = "urn:ietf:params:oauth:token-type:jwt"
+	tokenExchangeAccessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// Credentials are used to authenticate to a worker cluster.
+type Credentials struct {
+	// BearerToken is the token used to authenticate to the worker cluster.
+	BearerToken string
+	// ClientCertificateData and ClientKeyData are the PEM encoded client
+	// certificate and key used to authenticate to the worker cluster.
+	ClientCertificateData []byte
+	ClientKeyData         []byte
+	// ExpirationTime - if not zero, new credentials are requested from the
+	// provider before it, and the connection to the worker cluster is
+	// re-established with them.
+	ExpirationTime time.Time
+}
+
+// CredentialsProvider provides the credentials used to connect to the worker
+// clusters whose MultiKueueCluster has the kueue.x-k8s.io/multikueue-credentials-provider
+// annotation set to its name.
+type CredentialsProvider interface {
+	// Name of the provider.
+	Name() string
+	// Credentials returns the credentials to connect to the worker cluster.
+	// config is loaded from the cluster source of the MultiKueueCluster, without
+	// its credentials, and provides the endpoint and the TLS settings of the cluster.
+	Credentials(ctx context.Context, cluster *kueue.MultiKueueCluster, config *rest.Config) (*Credentials, error)
+}
+
+// credentialsRefreshAfter returns the time after which new credentials are
+// requested, when 80% of the remaining validity of the current ones has elapsed.
+func credentialsRefreshAfter(now, expirationTime time.Time) time.Duration {
+	remaining := expirationTime.Sub(now)
+	return max(remaining-remaining/5, minCredentialsRefreshInterval)
+}
+
+// cachedCredentials are the credentials minted by a provider for a cluster,
+// reused until their refreshTime while the cluster source is not changed.
+type cachedCredentials struct {
+	providerName string
+	// config is the client config loaded from the cluster source.
+	config      *clientConfig
+	credentials *Credentials
+	// refreshTime - zero if the credentials do not expire.
+	refreshTime time.Time
+}
+
+// applyCredentials - returns the client config authenticating to the worker cluster
+// with the credentials of the provider set for the cluster, and the time after
+// which new credentials need to be requested.
+func (c *clustersReconciler) applyCredentials(ctx context.Context, cluster *kueue.MultiKueueCluster, config *clientConfig) (*clientConfig, time.Time, string, error) {
+	if !features.Enabled(features.MultiKueueCredentialsProviders) {
+		return nil, time.Time{}, "MultiKueueCredentialsProvidersFeatureDisabled", errors.New("MultiKueueCredentialsProviders feature gate is disabled")
+	}
+	providerName := cluster.Annotations[kueue.MultiKueueCredentialsProviderAnnotation]
+	provider, found := c.credentialsProviders[providerName]
+	if !found {
+		return nil, time.Time{}, "CredentialsProviderNotFound", fmt.Errorf("credentials provider %q not found", providerName)
+	}
+
+	baseConfig, err := restConfigFor(config)
+	if err != nil {
+		return nil, time.Time{}, "BadKubeConfig", err
+	}
+	restConfig := rest.AnonymousClientConfig(baseConfig)
+
+	cached, found := c.getCachedCredentials(cluster.Name, providerName, config)
+	if !found {
+		creds, err := provider.Credentials(ctx, cluster, rest.CopyConfig(restConfig))
+		if err != nil {
+			return nil, time.Time{}, "CredentialsProviderFailed", err
+		}
+		cached = &cachedCredentials{providerName: providerName, config: config, credentials: creds}
+		if !creds.ExpirationTime.IsZero() {
+			now := c.clock.Now()
+			cached.refreshTime = now.Add(credentialsRefreshAfter(now, creds.ExpirationTime))
+		}
+	}
+	restConfig.BearerToken = cached.credentials.BearerToken
+	restConfig.CertData = cached.credentials.ClientCertificateData
+	restConfig.KeyData = cached.credentials.ClientKeyData
+	if err := validateRestConfig(restConfig, validateRestConfigOptions{}); err != nil {
+		c.deleteCachedCredentials(cluster.Name)
+		return nil, time.Time{}, "BadRestConfig", err
+	}
+	if !found {
+		c.setCachedCredentials(cluster.Name, cached)
+	}
+	return &clientConfig{RestConfig: restConfig}, cached.refreshTime, "", nil
+}
+
+// getCachedCredentials - returns the credentials minted for the cluster by the
+// provider, if they were minted for the same cluster source and do not need to
+// be refreshed yet.
+func (c *clustersReconciler) getCachedCredentials(clusterName, providerName string, config *clientConfig) (*cachedCredentials, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	cached, found := c.credentialsCache[clusterName]
+	if !found || cached.providerName != providerName || !equality.Semantic.DeepEqual(cached.config, config) {
+		return nil, false
+	}
+	if !cached.refreshTime.IsZero() && !c.clock.Now().Before(cached.refreshTime) {
+		return nil, false
+	}
+	return cached, true
+}
+
+func (c *clustersReconciler) setCachedCredentials(clusterName string, cached *cachedCredentials) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.credentialsCache == nil {
+		c.credentialsCache = make(map[string]*cachedCredentials)
+	}
+	c.credentialsCache[clusterName] = cached
+}
+
+func (c *clustersReconciler) deleteCachedCredentials(clusterName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.credentialsCache, clusterName)
+}
+
+// NewCredentialsProviders - returns the credentials providers defined in the configuration.
+func NewCredentialsProviders(providers []configapi.MultiKueueCredentialsProvider) []CredentialsProvider {
+	ret := make([]CredentialsProvider, 0, len(providers))
+	for _, p := range providers {
+		switch {
+		case p.Exec != nil:
+			env := make([]string, 0, len(p.Exec.Env))
+			for _, v := range p.Exec.Env {
+				env = append(env, v.Name+"="+v.Value)
+			}
+			ret = append(ret, NewExecCredentialsProvider(p.Name, p.Exec.Command, p.Exec.Args, env))
+		case p.ServiceAccountTokenExchange != nil:
+			ret = append(ret, NewServiceAccountTokenExchangeProvider(p.Name, p.ServiceAccountTokenExchange.TokenPath, p.ServiceAccountTokenExchange.ExchangeURL))
+		}
+	}
+	return ret
+}
+
+// ExecCredentialsProvider mints the credentials by running an exec plugin
+// implementing the client.authentication.k8s.io/v1 ExecCredential protocol.
+// The MultiKueueCluster name is passed to the plugin in the KUEUE_MULTIKUEUE_CLUSTER
+// environment variable.
+type ExecCredentialsProvider struct {
+	name    string
+	command string
+	args    []string
+	env     []string
+}
+
+var _ CredentialsProvider = (*ExecCredentialsProvider)(nil)
+
+// NewExecCredentialsProvider returns a provider running command with args.
+// env, in the "key=value" form, is added to the environment of the manager.
+func NewExecCredentialsProvider(name, command string, args, env []string) *ExecCredentialsProvider {
+	return &ExecCredentialsProvider{
+		name:    name,
+		command: command,
+		args:    args,
+		env:     env,
+	}
+}
+
+func (p *ExecCredentialsProvider) Name() string {
+	return p.name
+}
+
+func (p *ExecCredentialsProvider) Credentials(ctx context.Context, cluster *kueue.MultiKueueCluster, config *rest.Config) (*Credentials, error) {
+	execInfo, err := json.Marshal(&clientauthenticationv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clientauthenticationv1.SchemeGroupVersion.String(),
+			Kind:       "ExecCredential",
+		},
+		Spec: clientauthenticationv1.ExecCredentialSpec{
+			Cluster: &clientauthenticationv1.Cluster{
+				Server:                   config.Host,
+				TLSServerName:            config.ServerName,
+				CertificateAuthorityData: config.CAData,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, p.command, p.args...)
+	cmd.Env = append(os.Environ(), p.env...)
+	cmd.Env = append(cmd.Env, execInfoEnv+"="+string(execInfo), "KUEUE_MULTIKUEUE_CLUSTER="+cluster.Name)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running credentials plugin %q: %w: %s", p.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	execCredential := &clientauthenticationv1.ExecCredential{}
+	if err := json.Unmarshal(stdout.Bytes(), execCredential); err != nil {
+		return nil, fmt.Errorf("decoding the output of credentials plugin %q: %w", p.command, err)
+	}
+	if execCredential.Status == nil {
+		return nil, fmt.Errorf("credentials plugin %q did not return a status", p.command)
+	}
+	status := execCredential.Status
+	if status.Token == "" && (status.ClientCertificateData == "" || status.ClientKeyData == "") {
+		return nil, fmt.Errorf("credentials plugin %q did not return a token or a client certificate and key", p.command)
+	}
+	creds := &Credentials{BearerToken: status.Token}
+	if status.ClientCertificateData != "" {
+		creds.ClientCertificateData = []byte(status.ClientCertificateData)
+		creds.ClientKeyData = []byte(status.ClientKeyData)
+	}
+	if status.ExpirationTimestamp != nil {
+		creds.ExpirationTime = status.ExpirationTimestamp.Time
+	}
+	return creds, nil
+}
+
+// ServiceAccountTokenExchangeProvider exchanges a projected service account token of
+// the manager for an access token of the worker cluster, using the OAuth 2.0
+// token exchange (RFC 8693). The API server URL of the worker cluster is sent as
+// the audience of the requested token.
+type ServiceAccountTokenExchangeProvider struct {
+	name        string
+	tokenPath   string
+	exchangeURL string
+	httpClient  *http.Client
+}
+
+var _ CredentialsProvider = (*ServiceAccountTokenExchangeProvider)(nil)
+
+// NewServiceAccountTokenExchangeProvider returns a provider exchanging the service
+// account token projected in tokenPath at the exchangeURL endpoint.
+func NewServiceAccountTokenExchangeProvider(name, tokenPath, exchangeURL string) *ServiceAccountTokenExchangeProvider {
+	return &ServiceAccountTokenExchangeProvider{
+		name:        name,
+		tokenPath:   tokenPath,
+		exchangeURL: exchangeURL,
+		httpClient:  &http.Client{Timeout: tokenExchangeTimeout},
+	}
+}
+
+// tokenExchangeResponse is the successful response of a token exchange.
+type tokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+	// ExpiresIn is the lifetime in seconds of the access token.
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+}
+
+func (p *ServiceAccountTokenExchangeProvider) Name() string {
+	return p.name
+}
+
+func (p *ServiceAccountTokenExchangeProvider) Credentials(ctx context.Context, _ *kueue.MultiKueueCluster, config *rest.Config) (*Credentials, error) {
+	// the projected token is rotated by the kubelet, read it on each exchange
+	saToken, err := os.ReadFile(p.tokenPath)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrantType},
+		"subject_token":        {strings.TrimSpace(string(saToken))},
+		"subject_token_type":   {tokenExchangeJWTTokenType},
+		"requested_token_type": {tokenExchangeAccessTokenType},
+		"audience":             {config.Host},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.exchangeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	requestTime := time.Now()
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token exchange failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	exchanged := &tokenExchangeResponse{}
+	if err := json.Unmarshal(body, exchanged); err != nil {
+		return nil, fmt.Errorf("decoding the token exchange response: %w", err)
+	}
+	if exchanged.AccessToken == "" {
+		return nil, errors.New("the token exchange response has no access token")
+	}
+	creds := &Credentials{BearerToken: exchanged.AccessToken}
+	if exchanged.ExpiresIn > 0 {
+		creds.ExpirationTime = requestTime.Add(time.Duration(exchanged.ExpiresIn) * time.Second)
+	}
+	return creds, nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestCredentialsRefreshAfter(t *testing.T) {
+	now := time.Now()
+	cases := map[string]struct {
+		expirationTime time.Time
+		want           time.Duration
+	}{
+		"refreshed when 80% of the validity elapsed": {
+			expirationTime: now.Add(time.Hour),
+			want:           48 * time.Minute,
+		},
+		"short lived credentials": {
+			expirationTime: now.Add(5 * time.Second),
+			want:           minCredentialsRefreshInterval,
+		},
+		"expired credentials": {
+			expirationTime: now.Add(-time.Minute),
+			want:           minCredentialsRefreshInterval,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := credentialsRefreshAfter(now, tc.expirationTime); got != tc.want {
+				t.Errorf("Unexpected refresh interval, want: %s, got: %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestExecCredentialsProvider(t *testing.T) {
+	writePlugin := func(t *testing.T, script string) string {
+		path := filepath.Join(t.TempDir(), "plugin.sh")
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o700); err != nil {
+			t.Fatalf("Writing the plugin: %v", err)
+		}
+		return path
+	}
+
+	cases := map[string]struct {
+		script  string
+		env     []string
+		want    *Credentials
+		wantErr bool
+	}{
+		"token with expiration": {
+			script: `[ "$KUEUE_MULTIKUEUE_CLUSTER" = "worker1" ] || exit 1
+echo "$KUBERNETES_EXEC_INFO" | grep -q '"server":"https://10.10.10.10"' || exit 1
+echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"'$TOKEN'","expirationTimestamp":"2030-01-01T00:00:00Z"}}'`,
+			env: []string{"TOKEN=minted-token"},
+			want: &Credentials{
+				BearerToken:    "minted-token",
+				ExpirationTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"client certificate": {
+			script: `echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"clientCertificateData":"cert","clientKeyData":"key"}}'`,
+			want: &Credentials{
+				ClientCertificateData: []byte("cert"),
+				ClientKeyData:         []byte("key"),
+			},
+		},
+		"no status": {
+			script:  `echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential"}'`,
+			wantErr: true,
+		},
+		"plugin fails": {
+			script:  `echo "not allowed" >&2; exit 1`,
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			provider := NewExecCredentialsProvider("exec", writePlugin(t, tc.script), nil, tc.env)
+			cluster := utiltestingapi.MakeMultiKueueCluster("worker1").Obj()
+			got, err := provider.Credentials(ctx, cluster, &rest.Config{Host: "https://10.10.10.10"})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected credentials (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenExchangeProvider(t *testing.T) {
+	cases := map[string]struct {
+		status        int
+		response      string
+		wantToken     string
+		wantExpiresIn time.Duration
+		wantErr       bool
+	}{
+		"token exchanged": {
+			status:        http.StatusOK,
+			response:      `{"access_token":"exchanged-token","issued_token_type":"urn:ietf:params:oauth:token-type:access_token","expires_in":3600}`,
+			wantToken:     "exchanged-token",
+			wantExpiresIn: time.Hour,
+		},
+		"exchange denied": {
+			status:   http.StatusForbidden,
+			response: `{"error":"access_denied"}`,
+			wantErr:  true,
+		},
+		"no access token": {
+			status:   http.StatusOK,
+			response: `{}`,
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			var requestErr error
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					requestErr = err
+				} else if r.PostForm.Get("subject_token") != "sa-token" || r.PostForm.Get("audience") != "https://10.10.10.10" ||
+					r.PostForm.Get("grant_type") != tokenExchangeGrantType {
+					requestErr = errors.New("unexpected token exchange request")
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			tokenPath := filepath.Join(t.TempDir(), "token")
+			if err := os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600); err != nil {
+				t.Fatalf("Writing the token: %v", err)
+			}
+
+			provider := NewServiceAccountTokenExchangeProvider("exchange", tokenPath, server.URL)
+			start := time.Now()
+			got, err := provider.Credentials(ctx, utiltestingapi.MakeMultiKueueCluster("worker1").Obj(), &rest.Config{Host: "https://10.10.10.10"})
+			if requestErr != nil {
+				t.Errorf("Unexpected request: %v", requestErr)
+			}
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if got.BearerToken != tc.wantToken {
+				t.Errorf("Unexpected token, want: %q, got: %q", tc.wantToken, got.BearerToken)
+			}
+			if expiresIn := got.ExpirationTime.Sub(start); expiresIn < tc.wantExpiresIn || expiresIn > tc.wantExpiresIn+time.Minute {
+				t.Errorf("Unexpected expiration time, want about %s from now, got: %s", tc.wantExpiresIn, expiresIn)
+			}
+		})
+	}
+}
+
+func TestNewCredentialsProviders(t *testing.T) {
+	providers := NewCredentialsProviders([]configapi.MultiKueueCredentialsProvider{
+		{
+			Name: "exec",
+			Exec: &configapi.MultiKueueExecCredentialsProvider{
+				Command: "/bin/plugin",
+				Args:    []string{"--cluster"},
+				Env:     []clientcmdapi.ExecEnvVar{{Name: "KEY", Value: "value"}},
+			},
+		},
+		{
+			Name: "token-exchange",
+			ServiceAccountTokenExchange: &configapi.MultiKueueServiceAccountTokenExchange{
+				TokenPath:   "/var/run/secrets/tokens/token",
+				ExchangeURL: "https://sts.example.com/token",
+			},
+		},
+	})
+
+	want := []CredentialsProvider{
+		&ExecCredentialsProvider{
+			name:    "exec",
+			command: "/bin/plugin",
+			args:    []string{"--cluster"},
+			env:     []string{"KEY=value"},
+		},
+		&ServiceAccountTokenExchangeProvider{
+			name:        "token-exchange",
+			tokenPath:   "/var/run/secrets/tokens/token",
+			exchangeURL: "https://sts.example.com/token",
+			httpClient:  &http.Client{Timeout: tokenExchangeTimeout},
+		},
+	}
+	if diff := cmp.Diff(want, providers, cmp.AllowUnexported(ExecCredentialsProvider{}, ServiceAccountTokenExchangeProvider{})); diff != "" {
+		t.Errorf("Unexpected providers (-want/+got):\n%s", diff)
+	}
+}
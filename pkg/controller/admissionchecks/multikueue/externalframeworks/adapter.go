@@ -35,12 +35,23 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
 )
 
-// Adapter implements the MultiKueueAdapter interface for external frameworks
-// with hardcoded default behavior as specified in the KEP.
+// Adapter implements the MultiKueueAdapter interface for external frameworks.
+// Without field paths configured, it has the default behavior specified in the KEP.
 type Adapter struct {
 	gvk schema.GroupVersionKind
+
+	// managedByPath is the path of the managedBy field, .spec.managedBy if empty.
+	managedByPath fieldpath.Path
+	// syncedSpecPaths are the spec fields updated in the remote object.
+	syncedSpecPaths []fieldpath.Path
+	// statusPaths are the status fields copied from the remote object,
+	// the whole status is copied if empty.
+	statusPaths []fieldpath.Path
+	// removedPaths are the fields removed from the remote object on creation.
+	removedPaths []fieldpath.Path
 }
 
 var (
@@ -80,6 +91,10 @@ func (a *Adapter) SyncJob(ctx context.Context, localClient client.Client, remote
 		return a.createRemoteObject(ctx, remoteClient, localObj, workloadName, origin)
 	}
 
+	if err := a.syncSpec(ctx, remoteClient, localObj, remoteObj); err != nil {
+		return err
+	}
+
 	// Update existing remote object status
 	return a.syncStatus(ctx, localClient, localObj, remoteObj)
 }
@@ -93,6 +108,9 @@ func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Cl
 
 	// Apply default transformation: remove the managedBy field
 	a.removeManagedByField(remoteObj)
+	for _, path := range a.removedPaths {
+		unstructured.RemoveNestedField(remoteObj.Object, path...)
+	}
 
 	// Add MultiKueue labels
 	labels := remoteObj.GetLabels()
@@ -108,6 +126,23 @@ func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Cl
 	return remoteClient.Create(ctx, remoteObj)
 }
 
+// syncSpec updates the synced spec fields of the remote object which differ from the local object.
+func (a *Adapter) syncSpec(ctx context.Context, remoteClient client.Client, localObj, remoteObj *unstructured.Unstructured) error {
+	if len(a.syncedSpecPaths) == 0 {
+		return nil
+	}
+	originalObj := remoteObj.DeepCopy()
+	changed := false
+	for _, path := range a.syncedSpecPaths {
+		changed = copyField(remoteObj, localObj, path) || changed
+	}
+	if !changed {
+		return nil
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Syncing spec to remote object", "gvk", a.gvk, "name", remoteObj.GetName())
+	return remoteClient.Patch(ctx, remoteObj, client.MergeFrom(originalObj))
+}
+
 func (a *Adapter) syncStatus(ctx context.Context, localClient client.Client, localObj, remoteObj *unstructured.Unstructured) error {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -128,19 +163,29 @@ func (a *Adapter) syncStatus(ctx context.Context, localClient client.Client, loc
 	return localClient.Status().Patch(ctx, localObj, patch)
 }
 
-// removeManagedByField removes the .spec.managedBy field from the object
-func (a *Adapter) removeManagedByField(obj *unstructured.Unstructured) {
-	spec, exists, err := unstructured.NestedMap(obj.Object, "spec")
-	if !exists || err != nil {
-		return
+// getManagedByPath returns the path of the managedBy field.
+func (a *Adapter) getManagedByPath() fieldpath.Path {
+	if len(a.managedByPath) == 0 {
+		return defaultManagedByPath
 	}
+	return a.managedByPath
+}
 
-	delete(spec, "managedBy")
-	obj.Object["spec"] = spec
+// removeManagedByField removes the managedBy field from the object
+func (a *Adapter) removeManagedByField(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, a.getManagedByPath()...)
 }
 
-// copyStatusFromRemote copies the entire status from remote object to local object
+// copyStatusFromRemote copies the status fields from remote object to local object,
+// the entire status if no status fields are configured.
 func (a *Adapter) copyStatusFromRemote(localObj, remoteObj *unstructured.Unstructured) {
+	if len(a.statusPaths) > 0 {
+		for _, path := range a.statusPaths {
+			copyField(localObj, remoteObj, path)
+		}
+		return
+	}
+
 	remoteStatus, exists, err := unstructured.NestedMap(remoteObj.Object, "status")
 	if !exists || err != nil {
 		return
@@ -177,14 +222,14 @@ func (a *Adapter) IsJobManagedByKueue(ctx context.Context, c client.Client, key
 		return false, "", err
 	}
 
-	// Use the default managedBy path: .spec.managedBy
-	managedByValue, _, err := unstructured.NestedString(obj.Object, "spec", "managedBy")
+	managedByPath := a.getManagedByPath()
+	managedByValue, _, err := unstructured.NestedString(obj.Object, managedByPath...)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to read .spec.managedBy: %w", err)
+		return false, "", fmt.Errorf("failed to read %s: %w", managedByPath, err)
 	}
 
 	if managedByValue != kueue.MultiKueueControllerName {
-		return false, fmt.Sprintf("Expecting .spec.managedBy to be %q not %q", kueue.MultiKueueControllerName, managedByValue), nil
+		return false, fmt.Sprintf("Expecting %s to be %q not %q", managedByPath, kueue.MultiKueueControllerName, managedByValue), nil
 	}
 
 	return true, "", nil
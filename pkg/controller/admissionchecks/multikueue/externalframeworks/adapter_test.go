@@ -31,12 +31,14 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
 )
 
 func TestAdapter_IsJobManagedByKueue(t *testing.T) {
 	tests := []struct {
 		name           string
 		object         *unstructured.Unstructured
+		managedByPath  fieldpath.Path
 		featureEnabled bool
 		want           bool
 		wantReason     string
@@ -112,6 +114,35 @@ func TestAdapter_IsJobManagedByKueue(t *testing.T) {
 			wantReason:     "Expecting .spec.managedBy to be \"kueue.x-k8s.io/multikueue\" not \"not-a-string\"",
 			wantErr:        nil,
 		},
+		{
+			name: "managed by kueue with configured path",
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"spec": map[string]any{
+						"controller": map[string]any{
+							"name": kueue.MultiKueueControllerName,
+						},
+					},
+				},
+			},
+			managedByPath:  fieldpath.Path{"spec", "controller", "name"},
+			featureEnabled: true,
+			want:           true,
+		},
+		{
+			name: "not managed by kueue with configured path",
+			object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"spec": map[string]any{
+						"managedBy": kueue.MultiKueueControllerName,
+					},
+				},
+			},
+			managedByPath:  fieldpath.Path{"spec", "controller", "name"},
+			featureEnabled: true,
+			want:           false,
+			wantReason:     "Expecting .spec.controller.name to be \"kueue.x-k8s.io/multikueue\" not \"\"",
+		},
 	}
 
 	for _, tt := range tests {
@@ -124,6 +155,7 @@ func TestAdapter_IsJobManagedByKueue(t *testing.T) {
 					Version: "v1",
 					Kind:    "TestJob",
 				},
+				managedByPath: tt.managedByPath,
 			}
 
 			// Set GVK on test object
@@ -228,6 +260,81 @@ func TestAdapter_CopyStatusFromRemote(t *testing.T) {
 	}
 }
 
+func TestAdapter_SyncJobWithFieldPaths(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "test.example.com", Version: "v1", Kind: "TestJob"}
+	adapter := &Adapter{
+		gvk:             gvk,
+		managedByPath:   fieldpath.Path{"spec", "controller"},
+		syncedSpecPaths: []fieldpath.Path{{"spec", "suspend"}},
+		statusPaths:     []fieldpath.Path{{"status", "phase"}},
+		removedPaths:    []fieldpath.Path{{"spec", "serviceAccountName"}, {"metadata", "labels", "example.com/owner"}},
+	}
+	key := types.NamespacedName{Name: "test-job", Namespace: "default"}
+	newObj := func(object map[string]any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: object}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetName(key.Name)
+		obj.SetNamespace(key.Namespace)
+		return obj
+	}
+
+	localObj := newObj(map[string]any{
+		"spec": map[string]any{
+			"controller":         kueue.MultiKueueControllerName,
+			"serviceAccountName": "manager-sa",
+			"suspend":            false,
+		},
+	})
+	localObj.SetLabels(map[string]string{"example.com/owner": "team-a"})
+	localClient := fake.NewClientBuilder().WithObjects(localObj).WithStatusSubresource(localObj).Build()
+	remoteClient := fake.NewClientBuilder().Build()
+	ctx := context.Background()
+
+	if err := adapter.SyncJob(ctx, localClient, remoteClient, key, "wl", "origin"); err != nil {
+		t.Fatalf("Unexpected error creating the remote object: %v", err)
+	}
+	remoteObj := newObj(nil)
+	if err := remoteClient.Get(ctx, key, remoteObj); err != nil {
+		t.Fatalf("Unexpected error getting the remote object: %v", err)
+	}
+	wantRemoteSpec := map[string]any{"suspend": false}
+	if diff := cmp.Diff(wantRemoteSpec, remoteObj.Object["spec"]); diff != "" {
+		t.Errorf("Unexpected remote spec (-want,+got):\n%s", diff)
+	}
+	if _, found := remoteObj.GetLabels()["example.com/owner"]; found {
+		t.Error("The removed label should not be set in the remote object")
+	}
+
+	// the local object is suspended and the remote object makes progress
+	if err := unstructured.SetNestedField(localObj.Object, true, "spec", "suspend"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := localClient.Update(ctx, localObj); err != nil {
+		t.Fatalf("Unexpected error updating the local object: %v", err)
+	}
+	remoteObj.Object["status"] = map[string]any{"phase": "Running", "nodes": map[string]any{"a": "Succeeded"}}
+	if err := remoteClient.Update(ctx, remoteObj); err != nil {
+		t.Fatalf("Unexpected error updating the remote object: %v", err)
+	}
+
+	if err := adapter.SyncJob(ctx, localClient, remoteClient, key, "wl", "origin"); err != nil {
+		t.Fatalf("Unexpected error syncing the objects: %v", err)
+	}
+	if err := remoteClient.Get(ctx, key, remoteObj); err != nil {
+		t.Fatalf("Unexpected error getting the remote object: %v", err)
+	}
+	if suspend, _, _ := unstructured.NestedBool(remoteObj.Object, "spec", "suspend"); !suspend {
+		t.Error("The remote object should be suspended")
+	}
+	if err := localClient.Get(ctx, key, localObj); err != nil {
+		t.Fatalf("Unexpected error getting the local object: %v", err)
+	}
+	wantLocalStatus := map[string]any{"phase": "Running"}
+	if diff := cmp.Diff(wantLocalStatus, localObj.Object["status"]); diff != "" {
+		t.Errorf("Unexpected local status (-want,+got):\n%s", diff)
+	}
+}
+
 func TestAdapter_GetEmptyList(t *testing.T) {
 	tests := []struct {
 		name string
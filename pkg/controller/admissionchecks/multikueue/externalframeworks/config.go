@@ -24,6 +24,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
 )
 
 // NewAdapters creates and returns adapters from the given configurations.
@@ -51,12 +52,53 @@ func NewAdapters(configs []configapi.MultiKueueExternalFramework) ([]*Adapter, e
 	}
 
 	var adapters []*Adapter
-	for gvk := range configsMap {
-		adapters = append(adapters, &Adapter{gvk: gvk})
+	for gvk, config := range configsMap {
+		adapter, err := newConfiguredAdapter(gvk, config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid external framework configuration for %q: %w", config.Name, err))
+			continue
+		}
+		adapters = append(adapters, adapter)
+	}
+	if len(errs) > 0 {
+		return nil, k8serrors.NewAggregate(errs)
 	}
 	return adapters, nil
 }
 
+// newConfiguredAdapter creates an adapter using the field paths of the configuration.
+func newConfiguredAdapter(gvk schema.GroupVersionKind, config configapi.MultiKueueExternalFramework) (*Adapter, error) {
+	adapter := &Adapter{gvk: gvk}
+	var err error
+	if config.ManagedByPath != "" {
+		if adapter.managedByPath, err = fieldpath.Parse(config.ManagedByPath); err != nil {
+			return nil, fmt.Errorf("managedByPath %q: %w", config.ManagedByPath, err)
+		}
+	}
+	if adapter.syncedSpecPaths, err = parseFieldPaths("syncedSpecPaths", config.SyncedSpecPaths); err != nil {
+		return nil, err
+	}
+	if adapter.statusPaths, err = parseFieldPaths("statusPaths", config.StatusPaths); err != nil {
+		return nil, err
+	}
+	if adapter.removedPaths, err = parseFieldPaths("removedPathsOnCreate", config.RemovedPathsOnCreate); err != nil {
+		return nil, err
+	}
+	return adapter, nil
+}
+
+func parseFieldPaths(name string, paths []string) ([]fieldpath.Path, error) {
+	var parsed []fieldpath.Path
+	for _, p := range paths {
+		path, err := fieldpath.Parse(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", name, p, err)
+		}
+		parsed = append(parsed, path)
+	}
+	return parsed, nil
+}
+
 // parseGVK parses a string to a GVK
 func parseGVK(name string) (*schema.GroupVersionKind, error) {
 	if name == "" {
@@ -61,6 +61,26 @@ func TestNewAdapters(t *testing.T) {
 			},
 			wantErr: errors.New("duplicate configuration for GVK tekton.dev/v1, Kind=PipelineRun"),
 		},
+		{
+			name: "valid field paths",
+			configs: []configapi.MultiKueueExternalFramework{
+				{
+					Name:                 "Workflow.v1alpha1.argoproj.io",
+					ManagedByPath:        ".spec.controller",
+					SyncedSpecPaths:      []string{".spec.suspend"},
+					StatusPaths:          []string{".status.phase"},
+					RemovedPathsOnCreate: []string{".metadata.annotations['example.com/owner']"},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid field path",
+			configs: []configapi.MultiKueueExternalFramework{
+				{Name: "Workflow.v1alpha1.argoproj.io", StatusPaths: []string{".status.nodes[*]"}},
+			},
+			wantErr: errors.New("invalid external framework configuration for \"Workflow.v1alpha1.argoproj.io\": statusPaths \".status.nodes[*]\": wildcards, array indexes and filters are not supported"),
+		},
 		{
 			name: "mixed valid and invalid",
 			configs: []configapi.MultiKueueExternalFramework{
@@ -0,0 +1,54 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalframeworks
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+)
+
+var defaultManagedByPath = fieldpath.Path{"spec", "managedBy"}
+
+// copyField sets the field of dst to its value in src, or removes it from dst
+// if it is not set in src. It returns true if dst is changed.
+func copyField(dst, src *unstructured.Unstructured, path fieldpath.Path) bool {
+	srcValue, srcFound, _ := unstructured.NestedFieldNoCopy(src.Object, path...)
+	dstValue, dstFound, _ := unstructured.NestedFieldNoCopy(dst.Object, path...)
+	if srcFound == dstFound && equality.Semantic.DeepEqual(srcValue, dstValue) {
+		return false
+	}
+	if !srcFound {
+		unstructured.RemoveNestedField(dst.Object, path...)
+		return true
+	}
+	// null parents, for example `status: null`, cannot be set by SetNestedField
+	parent := dst.Object
+	for _, f := range path[:len(path)-1] {
+		if parent[f] == nil {
+			delete(parent, f)
+			break
+		}
+		next, ok := parent[f].(map[string]any)
+		if !ok {
+			break
+		}
+		parent = next
+	}
+	return unstructured.SetNestedField(dst.Object, srcValue, path...) == nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalframeworks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+)
+
+func TestCopyField(t *testing.T) {
+	cases := map[string]struct {
+		dst         map[string]any
+		src         map[string]any
+		path        fieldpath.Path
+		want        map[string]any
+		wantChanged bool
+	}{
+		"field set": {
+			dst:         map[string]any{"status": nil},
+			src:         map[string]any{"status": map[string]any{"phase": "Running"}},
+			path:        fieldpath.Path{"status", "phase"},
+			want:        map[string]any{"status": map[string]any{"phase": "Running"}},
+			wantChanged: true,
+		},
+		"field removed": {
+			dst:         map[string]any{"spec": map[string]any{"suspend": true, "parallelism": int64(2)}},
+			src:         map[string]any{"spec": map[string]any{"parallelism": int64(2)}},
+			path:        fieldpath.Path{"spec", "suspend"},
+			want:        map[string]any{"spec": map[string]any{"parallelism": int64(2)}},
+			wantChanged: true,
+		},
+		"field unchanged": {
+			dst:  map[string]any{"spec": map[string]any{"suspend": true}},
+			src:  map[string]any{"spec": map[string]any{"suspend": true}},
+			path: fieldpath.Path{"spec", "suspend"},
+			want: map[string]any{"spec": map[string]any{"suspend": true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dst := &unstructured.Unstructured{Object: tc.dst}
+			changed := copyField(dst, &unstructured.Unstructured{Object: tc.src}, tc.path)
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed, want: %v, got: %v", tc.wantChanged, changed)
+			}
+			if diff := cmp.Diff(tc.want, dst.Object); diff != "" {
+				t.Errorf("Unexpected object (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
@@ -38,12 +39,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	inventoryv1alpha1 "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
 	"sigs.k8s.io/cluster-inventory-api/pkg/credentials"
@@ -59,8 +62,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/metrics"
+	"sigs.k8s.io/kueue/pkg/resources"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/util/resource"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 )
 
@@ -70,6 +81,14 @@ const (
 	// this set will provide waiting time between 0 to 5m20s
 	retryIncrement = 5 * time.Second
 	retryMaxSteps  = 7
+
+	workloadCRDName = "workloads.kueue.x-k8s.io"
+	versionLabel    = "app.kubernetes.io/version"
+
+	// the limits of the lists in the MultiKueueCluster status
+	maxStatusClusterQueues = 1000
+	maxStatusFlavors       = 64
+	maxStatusResources     = 64
 )
 
 // retryAfter returns an exponentially increasing interval between
@@ -102,10 +121,17 @@ type remoteClient struct {
 	connecting         atomic.Bool
 	failedConnAttempts uint
 
+	// cordonPolicy is the last cordon policy of the cluster seen by the clustersReconciler.
+	cordonPolicy kueue.MultiKueueClusterCordonPolicy
+
 	// For unit testing only. There is now need of creating fully functional remote clients in the unit tests
 	// and creating valid kubeconfig content is not trivial.
 	// The full client creation and usage is validated in the integration and e2e tests.
 	builderOverride clientWithWatchBuilder
+
+	// podLogs - reads the container logs from the worker cluster, set on connection
+	// when the MultiKueueRemoteTerminationEvents feature gate is enabled.
+	podLogs podLogsGetter
 }
 
 func newRemoteClient(localClient client.Client, wlUpdateCh, watchEndedCh chan<- event.GenericEvent, origin, clusterName string, adapters map[string]jobframework.MultiKueueAdapter) *remoteClient {
@@ -121,11 +147,15 @@ func newRemoteClient(localClient client.Client, wlUpdateCh, watchEndedCh chan<-
 	return rc
 }
 
-func newClientWithWatch(config *clientConfig, options client.Options) (client.WithWatch, error) {
+func restConfigFor(config *clientConfig) (*rest.Config, error) {
 	if config.RestConfig != nil {
-		return client.NewWithWatch(config.RestConfig, options)
+		return config.RestConfig, nil
 	}
-	restConfig, err := clientcmd.RESTConfigFromKubeConfig(config.Kubeconfig)
+	return clientcmd.RESTConfigFromKubeConfig(config.Kubeconfig)
+}
+
+func newClientWithWatch(config *clientConfig, options client.Options) (client.WithWatch, error) {
+	restConfig, err := restConfigFor(config)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +203,11 @@ func (rc *remoteClient) setConfig(watchCtx context.Context, config *clientConfig
 	}
 
 	rc.client = remoteClient
+	if features.Enabled(features.MultiKueueRemoteTerminationEvents) {
+		if rc.podLogs, err = newPodLogsGetter(config); err != nil {
+			ctrl.LoggerFrom(watchCtx).Error(err, "Unable to create the pod logs client")
+		}
+	}
 
 	watchCtx, rc.watchCancel = context.WithCancel(watchCtx)
 	err = rc.startWatcher(watchCtx, kueue.GroupVersion.WithKind("Workload").GroupKind().String(), &workloadKueueWatcher{})
@@ -260,6 +295,20 @@ func (rc *remoteClient) queueWorkloadEvent(ctx context.Context, wlKey types.Name
 	}
 }
 
+// queueRemoteWorkloads - queues the local workloads of all the remote workloads
+// created by this manager.
+func (rc *remoteClient) queueRemoteWorkloads(ctx context.Context) error {
+	wls := &metav1.PartialObjectMetadataList{}
+	wls.SetGroupVersionKind(kueue.GroupVersion.WithKind("WorkloadList"))
+	if err := rc.client.List(ctx, wls, client.MatchingLabels{kueue.MultiKueueOriginLabel: rc.origin}); err != nil {
+		return err
+	}
+	for _, wl := range wls.Items {
+		rc.queueWorkloadEvent(ctx, client.ObjectKeyFromObject(&wl))
+	}
+	return nil
+}
+
 func (rc *remoteClient) queueWatchEndedEvent(ctx context.Context) {
 	cluster := &kueue.MultiKueueCluster{}
 	if err := rc.localClient.Get(ctx, types.NamespacedName{Name: rc.clusterName}, cluster); err == nil {
@@ -360,6 +409,34 @@ type clustersReconciler struct {
 	clusterProfileCreds clusterProfileCreds
 
 	roleTracker *roletracker.RoleTracker
+
+	// capacityCache - if set, the capacity of the worker clusters is periodically
+	// read and stored in it, every capacitySyncInterval.
+	capacityCache        *capacity.Cache
+	capacitySyncInterval time.Duration
+	// statusSyncInterval - the interval between two consecutive reads of the
+	// stats of the worker clusters published in the status of their
+	// MultiKueueClusters. If 0 the stats are not published.
+	statusSyncInterval time.Duration
+	// workerClients - if set, the clients of the connected worker clusters
+	// are registered in it.
+	workerClients *workers.Clients
+	// quotaFederation - if set, the quota reserved by the workloads which were
+	// not dispatched by this manager is stored in the capacity cache on each
+	// capacity sync, and recorded in the schedulerCache as the foreign usage of
+	// the manager ClusterQueues.
+	quotaFederation bool
+	schedulerCache  *schdcache.Cache
+	queues          *qcache.Manager
+	// foreignUsageCQs - the ClusterQueues with a foreign usage recorded in the schedulerCache.
+	foreignUsageCQs sets.Set[kueue.ClusterQueueReference]
+	// credentialsProviders - the providers which can be set for a cluster with the
+	// kueue.x-k8s.io/multikueue-credentials-provider annotation, indexed by name.
+	credentialsProviders map[string]CredentialsProvider
+	// credentialsCache - the credentials minted by the providers, indexed by cluster name.
+	credentialsCache map[string]*cachedCredentials
+
+	clock clock.Clock
 }
 
 type clusterProfileCreds interface {
@@ -381,6 +458,8 @@ var _ reconcile.Reconciler = (*clustersReconciler)(nil)
 func (c *clustersReconciler) Start(ctx context.Context) error {
 	c.rootContext = ctx
 	go c.runGC(ctx)
+	go c.runCapacitySync(ctx)
+	go c.runStatusSync(ctx)
 	return nil
 }
 
@@ -391,6 +470,12 @@ func (c *clustersReconciler) stopAndRemoveCluster(clusterName string) {
 		rc.StopWatchers()
 		delete(c.remoteClients, clusterName)
 	}
+	delete(c.credentialsCache, clusterName)
+	c.deleteCapacity(clusterName)
+	if c.workerClients != nil {
+		c.workerClients.Delete(clusterName)
+	}
+	metrics.ClearMultiKueueClusterMetrics(clusterName)
 }
 
 func (c *clustersReconciler) setRemoteClientConfig(ctx context.Context, clusterName string, config *clientConfig, origin string) (*time.Duration, error) {
@@ -411,8 +496,14 @@ func (c *clustersReconciler) setRemoteClientConfig(ctx context.Context, clusterN
 
 	if retryAfter, err := client.setConfig(clientCtx, config); err != nil {
 		ctrl.LoggerFrom(ctx).Error(err, "failed to set kubeConfig in the remote client")
+		if c.workerClients != nil {
+			c.workerClients.Delete(clusterName)
+		}
 		return retryAfter, err
 	}
+	if c.workerClients != nil {
+		c.workerClients.Add(clusterName, &workers.Client{Client: client.client, Origin: origin})
+	}
 	return nil, nil
 }
 
@@ -455,8 +546,24 @@ func (c *clustersReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		return reconcile.Result{}, fmt.Errorf("failed to load client config, reason: %s, error: %w", reason, err)
 	}
 
+	var credentialsRefreshTime time.Time
+	if _, found := cluster.Annotations[kueue.MultiKueueCredentialsProviderAnnotation]; found {
+		var reason string
+		clientConfig, credentialsRefreshTime, reason, err = c.applyCredentials(ctx, cluster, clientConfig)
+		if err != nil {
+			log.Error(err, "applying the credentials of the provider failed")
+			c.stopAndRemoveCluster(req.Name)
+			if updateErr := c.updateStatus(ctx, cluster, false, reason, fmt.Sprintf("load client config failed: %v", err)); updateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to update MultiKueueCluster status: %w after failing to apply credentials: %w", updateErr, err)
+			}
+			return reconcile.Result{}, fmt.Errorf("failed to apply credentials, reason: %s, error: %w", reason, err)
+		}
+	}
+
 	if retryAfter, err := c.setRemoteClientConfig(ctx, cluster.Name, clientConfig, c.origin); err != nil {
 		log.Error(err, "setting client config", "retryAfter", retryAfter)
+		// the credentials might be rejected by the worker cluster, mint new ones on retry
+		c.deleteCachedCredentials(cluster.Name)
 		if err := c.updateStatus(ctx, cluster, false, "ClientConnectionFailed", err.Error()); err != nil {
 			return reconcile.Result{}, err
 		} else {
@@ -464,7 +571,36 @@ func (c *clustersReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		}
 	}
 
-	return reconcile.Result{}, client.IgnoreNotFound(c.updateStatus(ctx, cluster, true, "Active", "Connected"))
+	c.syncCordonPolicy(ctx, cluster)
+
+	result := reconcile.Result{}
+	if !credentialsRefreshTime.IsZero() {
+		// rotate the credentials, the connection is re-established with the new ones
+		result.RequeueAfter = max(credentialsRefreshTime.Sub(c.clock.Now()), time.Second)
+	}
+	return result, client.IgnoreNotFound(c.updateStatus(ctx, cluster, true, "Active", "Connected"))
+}
+
+// syncCordonPolicy - queues the local workloads having remote workloads in the cluster
+// when its cordon policy changes, for instance to remove them from a drained cluster.
+func (c *clustersReconciler) syncCordonPolicy(ctx context.Context, cluster *kueue.MultiKueueCluster) {
+	rc, found := c.controllerFor(cluster.Name)
+	if !found {
+		return
+	}
+	policy := ptr.Deref(cluster.Spec.CordonPolicy, kueue.MultiKueueClusterCordonPolicyNone)
+	if rc.cordonPolicy == "" {
+		// the first reconcile of the cluster, the workloads are queued by the watch
+		rc.cordonPolicy = policy
+		return
+	}
+	if rc.cordonPolicy == policy {
+		return
+	}
+	rc.cordonPolicy = policy
+	if err := rc.queueRemoteWorkloads(ctx); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Queueing the workloads of the cluster after cordon policy change")
+	}
 }
 
 func (c *clustersReconciler) loadClientConfig(ctx context.Context, cluster *kueue.MultiKueueCluster) (*clientConfig, string, error) {
@@ -644,9 +780,10 @@ func (c *clustersReconciler) updateStatus(ctx context.Context, cluster *kueue.Mu
 		newCondition.Status = metav1.ConditionTrue
 	}
 
-	// if the condition is up-to-date
+	// if the conditions are up-to-date
 	oldCondition := apimeta.FindStatusCondition(cluster.Status.Conditions, kueue.MultiKueueClusterActive)
-	if cmpConditionState(oldCondition, &newCondition) {
+	cordonedUpdated := setCordonedCondition(cluster, ptr.Deref(cluster.Status.MirroredWorkloads, 0))
+	if cmpConditionState(oldCondition, &newCondition) && !cordonedUpdated {
 		return nil
 	}
 
@@ -654,6 +791,36 @@ func (c *clustersReconciler) updateStatus(ctx context.Context, cluster *kueue.Mu
 	return c.localClient.Status().Update(ctx, cluster)
 }
 
+// setCordonedCondition - sets the Cordoned condition of the cluster according to its
+// cordon policy and the number of workloads left in it, or removes the condition if
+// the cluster is not cordoned. Returns true if the conditions were updated.
+func setCordonedCondition(cluster *kueue.MultiKueueCluster, mirroredWorkloads int32) bool {
+	var reason, message string
+	switch ptr.Deref(cluster.Spec.CordonPolicy, kueue.MultiKueueClusterCordonPolicyNone) {
+	case kueue.MultiKueueClusterCordonPolicyCordon:
+		reason = "Cordoned"
+		message = fmt.Sprintf("No new workloads are dispatched, %d workloads remaining", mirroredWorkloads)
+	case kueue.MultiKueueClusterCordonPolicyDrain:
+		if mirroredWorkloads > 0 {
+			reason = "Draining"
+			message = fmt.Sprintf("Draining the cluster, %d workloads remaining", mirroredWorkloads)
+		} else {
+			reason = "Drained"
+			message = "No workloads remaining"
+		}
+	default:
+		return apimeta.RemoveStatusCondition(&cluster.Status.Conditions, kueue.MultiKueueClusterCordoned)
+	}
+	newCondition := metav1.Condition{
+		Type:               kueue.MultiKueueClusterCordoned,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cluster.Generation,
+	}
+	return apimeta.SetStatusCondition(&cluster.Status.Conditions, newCondition)
+}
+
 func (c *clustersReconciler) runGC(ctx context.Context) {
 	log := ctrl.LoggerFrom(ctx).WithName("MultiKueueGC")
 	if c.gcInterval == 0 {
@@ -675,6 +842,210 @@ func (c *clustersReconciler) runGC(ctx context.Context) {
 	}
 }
 
+func (c *clustersReconciler) runCapacitySync(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("MultiKueueCapacitySync")
+	if c.capacityCache == nil || c.capacitySyncInterval <= 0 {
+		log.V(2).Info("Capacity sync is disabled")
+		return
+	}
+	log.V(2).Info("Starting Capacity Sync")
+	for {
+		select {
+		case <-ctx.Done():
+			log.V(2).Info("Capacity Sync Stopped")
+			return
+		case <-c.clock.After(c.capacitySyncInterval):
+			log.V(4).Info("Run Capacity Sync for Worker Clusters")
+			for _, rc := range c.getRemoteClients() {
+				c.syncCapacity(ctrl.LoggerInto(ctx, log.WithValues("multiKueueCluster", rc.clusterName)), rc)
+			}
+			if c.quotaFederation {
+				c.syncForeignUsage(ctrl.LoggerInto(ctx, log))
+			}
+		}
+	}
+}
+
+// syncCapacity - reads the capacity of the worker cluster and stores it in the cache.
+// The capacity of a disconnected worker cluster is removed from the cache.
+func (c *clustersReconciler) syncCapacity(ctx context.Context, rc *remoteClient) {
+	log := ctrl.LoggerFrom(ctx)
+	if rc.connecting.Load() {
+		c.deleteCapacity(rc.clusterName)
+		return
+	}
+	w, err := capacity.Load(ctx, rc.client, c.clock.Now())
+	if err != nil {
+		log.Error(err, "Reading worker cluster capacity")
+		c.deleteCapacity(rc.clusterName)
+		return
+	}
+	if c.quotaFederation {
+		if w.ForeignReservation, err = capacity.LoadForeignReservation(ctx, rc.client, rc.origin); err != nil {
+			log.Error(err, "Reading worker cluster quota reservations")
+			c.deleteCapacity(rc.clusterName)
+			return
+		}
+	}
+	c.capacityCache.Add(rc.clusterName, w)
+}
+
+func (c *clustersReconciler) runStatusSync(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx).WithName("MultiKueueStatusSync")
+	if c.statusSyncInterval <= 0 {
+		log.V(2).Info("Worker status sync is disabled")
+		return
+	}
+	log.V(2).Info("Starting Worker Status Sync")
+	for {
+		select {
+		case <-ctx.Done():
+			log.V(2).Info("Worker Status Sync Stopped")
+			return
+		case <-c.clock.After(c.statusSyncInterval):
+			log.V(4).Info("Run Status Sync for Worker Clusters")
+			for _, rc := range c.getRemoteClients() {
+				c.syncStatus(ctrl.LoggerInto(ctx, log.WithValues("multiKueueCluster", rc.clusterName)), rc)
+			}
+		}
+	}
+}
+
+// workerStats are the stats of a worker cluster, published in the status of
+// its MultiKueueCluster.
+type workerStats struct {
+	capacity          *capacity.Worker
+	kueueVersion      string
+	mirroredWorkloads int
+	latency           time.Duration
+}
+
+// loadStats - reads the capacity and the stats of the worker cluster.
+func (rc *remoteClient) loadStats(ctx context.Context, clk clock.PassiveClock) (*workerStats, error) {
+	start := clk.Now()
+	w, err := capacity.Load(ctx, rc.client, start)
+	if err != nil {
+		return nil, err
+	}
+
+	wls := &metav1.PartialObjectMetadataList{}
+	wls.SetGroupVersionKind(kueue.GroupVersion.WithKind("WorkloadList"))
+	if err := rc.client.List(ctx, wls, client.MatchingLabels{kueue.MultiKueueOriginLabel: rc.origin}); err != nil {
+		return nil, err
+	}
+
+	return &workerStats{
+		capacity:          w,
+		kueueVersion:      rc.kueueVersion(ctx),
+		mirroredWorkloads: len(wls.Items),
+		latency:           clk.Since(start),
+	}, nil
+}
+
+// kueueVersion - returns the version of Kueue running in the worker cluster, as
+// reported by the version label of its Workload CRD, or an empty string if the
+// CRD cannot be read or is not labeled.
+func (rc *remoteClient) kueueVersion(ctx context.Context) string {
+	crd := &metav1.PartialObjectMetadata{}
+	crd.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+	if err := rc.client.Get(ctx, types.NamespacedName{Name: workloadCRDName}, crd); err != nil {
+		ctrl.LoggerFrom(ctx).V(3).Info("Unable to read the Workload CRD of the worker cluster", "err", err)
+		return ""
+	}
+	return crd.Labels[versionLabel]
+}
+
+// syncStatus - reads the capacity and the stats of the worker cluster and publishes
+// them in the MultiKueueCluster status and metrics.
+func (c *clustersReconciler) syncStatus(ctx context.Context, rc *remoteClient) {
+	log := ctrl.LoggerFrom(ctx)
+	if rc.connecting.Load() {
+		return
+	}
+	stats, err := rc.loadStats(ctx, c.clock)
+	if err != nil {
+		log.Error(err, "Reading worker cluster status")
+		return
+	}
+	c.reportStats(rc.clusterName, stats)
+	if err := c.updateStats(ctx, rc.clusterName, stats); err != nil {
+		log.Error(err, "Updating MultiKueueCluster status")
+	}
+}
+
+func (c *clustersReconciler) deleteCapacity(clusterName string) {
+	if c.capacityCache != nil {
+		c.capacityCache.Delete(clusterName)
+	}
+}
+
+func (c *clustersReconciler) reportStats(clusterName string, stats *workerStats) {
+	metrics.ReportMultiKueueClusterInfo(clusterName, stats.kueueVersion, c.roleTracker)
+	metrics.ReportMultiKueueClusterClusterQueues(clusterName, len(stats.capacity.ClusterQueues), c.roleTracker)
+	metrics.ReportMultiKueueClusterMirroredWorkloads(clusterName, stats.mirroredWorkloads, c.roleTracker)
+	metrics.ReportMultiKueueClusterSyncLatency(clusterName, stats.latency, c.roleTracker)
+	metrics.ClearMultiKueueClusterResourceMetrics(clusterName)
+	for _, fs := range flavorsStatus(stats.capacity) {
+		for _, rs := range fs.Resources {
+			metrics.ReportMultiKueueClusterResources(clusterName, string(fs.Name), string(rs.Name), resource.QuantityToFloat(&rs.NominalQuota), resource.QuantityToFloat(&rs.Usage), c.roleTracker)
+		}
+	}
+}
+
+func (c *clustersReconciler) updateStats(ctx context.Context, clusterName string, stats *workerStats) error {
+	cluster := &kueue.MultiKueueCluster{}
+	if err := c.localClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return clientutil.PatchStatus(ctx, c.localClient, cluster, func() (bool, error) {
+		cqNames := slices.Sorted(maps.Keys(stats.capacity.ClusterQueues))
+		cluster.Status.KueueVersion = stats.kueueVersion
+		cluster.Status.ClusterQueues = cqNames[:min(len(cqNames), maxStatusClusterQueues)]
+		cluster.Status.Flavors = flavorsStatus(stats.capacity)
+		cluster.Status.MirroredWorkloads = ptr.To(int32(stats.mirroredWorkloads))
+		cluster.Status.LastSyncTime = ptr.To(metav1.NewTime(stats.capacity.SyncTime))
+		cluster.Status.LastSyncLatencyMilliseconds = ptr.To(int32(stats.latency.Milliseconds()))
+		setCordonedCondition(cluster, int32(stats.mirroredWorkloads))
+		return true, nil
+	}, clientutil.WithLoose())
+}
+
+// flavorsStatus - sums the nominal quota and usage of the ClusterQueues of the
+// worker cluster, per flavor and resource, sorted by name.
+func flavorsStatus(w *capacity.Worker) []kueue.MultiKueueClusterFlavorStatus {
+	nominal := make(resources.FlavorResourceQuantities)
+	usage := make(resources.FlavorResourceQuantities)
+	for _, cq := range w.ClusterQueues {
+		for fr, v := range cq.NominalQuota {
+			nominal[fr] += v
+		}
+		for fr, v := range cq.Usage {
+			usage[fr] += v
+		}
+	}
+	byFlavor := make(map[kueue.ResourceFlavorReference][]kueue.MultiKueueClusterResourceStatus)
+	for fr := range sets.KeySet(nominal).Union(sets.KeySet(usage)) {
+		byFlavor[fr.Flavor] = append(byFlavor[fr.Flavor], kueue.MultiKueueClusterResourceStatus{
+			Name:         fr.Resource,
+			NominalQuota: resources.ResourceQuantity(fr.Resource, nominal[fr]),
+			Usage:        resources.ResourceQuantity(fr.Resource, usage[fr]),
+		})
+	}
+	flavors := slices.Sorted(maps.Keys(byFlavor))
+	result := make([]kueue.MultiKueueClusterFlavorStatus, 0, min(len(flavors), maxStatusFlavors))
+	for _, flavor := range flavors[:min(len(flavors), maxStatusFlavors)] {
+		rs := byFlavor[flavor]
+		slices.SortFunc(rs, func(a, b kueue.MultiKueueClusterResourceStatus) int {
+			return strings.Compare(string(a.Name), string(b.Name))
+		})
+		result = append(result, kueue.MultiKueueClusterFlavorStatus{
+			Name:      flavor,
+			Resources: rs[:min(len(rs), maxStatusResources)],
+		})
+	}
+	return result
+}
+
 func (c *clustersReconciler) getRemoteClients() []*remoteClient {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -698,6 +1069,7 @@ func newClustersReconciler(c client.Client, namespace string, gcInterval time.Du
 		adapters:            adapters,
 		clusterProfileCreds: cpCreds,
 		roleTracker:         roleTracker,
+		clock:               realClock,
 	}
 }
 
@@ -30,6 +30,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -37,6 +39,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	testingclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	inventoryv1alpha1 "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,8 +47,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
 	"sigs.k8s.io/kueue/pkg/util/slices"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
@@ -117,6 +125,26 @@ func (t *testClusterProfileCreds) BuildConfigFromCP(clusterprofile *inventoryv1a
 	return nil, errors.New("unsupported credential provider")
 }
 
+// testCredentialsProvider is a local credentials provider returning a fixed token.
+type testCredentialsProvider struct {
+	token          string
+	expirationTime time.Time
+	err            error
+}
+
+var _ CredentialsProvider = (*testCredentialsProvider)(nil)
+
+func (*testCredentialsProvider) Name() string {
+	return "test-provider"
+}
+
+func (p *testCredentialsProvider) Credentials(context.Context, *kueue.MultiKueueCluster, *rest.Config) (*Credentials, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &Credentials{BearerToken: p.token, ExpirationTime: p.expirationTime}, nil
+}
+
 func testRestConfig() *rest.Config {
 	return &rest.Config{
 		Host: "https://10.10.10.10",
@@ -174,6 +202,7 @@ func testKubeconfigInsecure(user string, tokenFile *string) string {
 
 func TestUpdateConfig(t *testing.T) {
 	ctx, _ := utiltesting.ContextWithLog(t)
+	now := time.Now().Truncate(time.Second)
 	cancelCalledCount := 0
 	cancelCalled := func() { cancelCalledCount++ }
 	validKubeconfigLocation := filepath.Join(t.TempDir(), "worker1KubeConfig")
@@ -185,6 +214,10 @@ func TestUpdateConfig(t *testing.T) {
 		secrets         []corev1.Secret
 		clusterprofiles []inventoryv1alpha1.ClusterProfile
 		cpCreds         clusterProfileCreds
+		credsProvider   CredentialsProvider
+		// credentialsCache - the credentials minted before the reconcile.
+		credentialsCache             map[string]*cachedCredentials
+		credentialsProvidersDisabled bool
 
 		wantRemoteClients      map[string]*remoteClient
 		wantClusters           []kueue.MultiKueueCluster
@@ -650,6 +683,254 @@ func TestUpdateConfig(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("failed to load client config, reason: BadRestConfig, error: %w", errors.New("bearerTokenFile is not allowed")),
 		},
+		"credentials are minted by the provider": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			credsProvider: &testCredentialsProvider{token: "minted-token"},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Active(metav1.ConditionTrue, "Active", "Connected", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantRemoteClients: map[string]*remoteClient{
+				"worker1": {
+					config: &clientConfig{
+						RestConfig: &rest.Config{
+							Host:            "https://10.10.10.10",
+							BearerToken:     "minted-token",
+							TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+						},
+					},
+				},
+			},
+		},
+		"expiring credentials are rotated": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			remoteClients: map[string]*remoteClient{
+				"worker1": newTestClient(ctx, nil, &rest.Config{
+					Host:            "https://10.10.10.10",
+					BearerToken:     "old-token",
+					TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+				}, cancelCalled),
+			},
+			credsProvider: &testCredentialsProvider{token: "new-token", expirationTime: now.Add(10 * time.Minute)},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Active(metav1.ConditionTrue, "Active", "Connected", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantRemoteClients: map[string]*remoteClient{
+				"worker1": {
+					config: &clientConfig{
+						RestConfig: &rest.Config{
+							Host:            "https://10.10.10.10",
+							BearerToken:     "new-token",
+							TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+						},
+					},
+				},
+			},
+			wantRequeueAfter: 8 * time.Minute,
+			wantCancelCalled: 1,
+		},
+		"cached credentials are reused until their refresh time": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			remoteClients: map[string]*remoteClient{
+				"worker1": newTestClient(ctx, nil, &rest.Config{
+					Host:            "https://10.10.10.10",
+					BearerToken:     "cached-token",
+					TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+				}, cancelCalled),
+			},
+			credentialsCache: map[string]*cachedCredentials{
+				"worker1": {
+					providerName: "test-provider",
+					config:       &clientConfig{Kubeconfig: []byte(testKubeconfig("worker1"))},
+					credentials:  &Credentials{BearerToken: "cached-token"},
+					refreshTime:  now.Add(5 * time.Minute),
+				},
+			},
+			credsProvider: &testCredentialsProvider{token: "new-token", expirationTime: now.Add(10 * time.Minute)},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Active(metav1.ConditionTrue, "Active", "Connected", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantRemoteClients: map[string]*remoteClient{
+				"worker1": {
+					config: &clientConfig{
+						RestConfig: &rest.Config{
+							Host:            "https://10.10.10.10",
+							BearerToken:     "cached-token",
+							TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+						},
+					},
+				},
+			},
+			wantRequeueAfter: 5 * time.Minute,
+		},
+		"cached credentials are refreshed after their refresh time": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			remoteClients: map[string]*remoteClient{
+				"worker1": newTestClient(ctx, nil, &rest.Config{
+					Host:            "https://10.10.10.10",
+					BearerToken:     "cached-token",
+					TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+				}, cancelCalled),
+			},
+			credentialsCache: map[string]*cachedCredentials{
+				"worker1": {
+					providerName: "test-provider",
+					config:       &clientConfig{Kubeconfig: []byte(testKubeconfig("worker1"))},
+					credentials:  &Credentials{BearerToken: "cached-token"},
+					refreshTime:  now,
+				},
+			},
+			credsProvider: &testCredentialsProvider{token: "new-token", expirationTime: now.Add(10 * time.Minute)},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Active(metav1.ConditionTrue, "Active", "Connected", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantRemoteClients: map[string]*remoteClient{
+				"worker1": {
+					config: &clientConfig{
+						RestConfig: &rest.Config{
+							Host:            "https://10.10.10.10",
+							BearerToken:     "new-token",
+							TLSClientConfig: rest.TLSClientConfig{CAData: []byte{'-', '-', '-', '-', '-'}},
+						},
+					},
+				},
+			},
+			wantRequeueAfter: 8 * time.Minute,
+			wantCancelCalled: 1,
+		},
+		"credentials providers feature gate disabled": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			credsProvider:                &testCredentialsProvider{token: "minted-token"},
+			credentialsProvidersDisabled: true,
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Active(metav1.ConditionFalse, "MultiKueueCredentialsProvidersFeatureDisabled", "load client config failed: MultiKueueCredentialsProviders feature gate is disabled", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantErr: fmt.Errorf("failed to apply credentials, reason: MultiKueueCredentialsProvidersFeatureDisabled, error: %w", errors.New("MultiKueueCredentialsProviders feature gate is disabled")),
+		},
+		"credentials provider not found": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "other-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			credsProvider: &testCredentialsProvider{token: "minted-token"},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "other-provider").
+					Active(metav1.ConditionFalse, "CredentialsProviderNotFound", "load client config failed: credentials provider \"other-provider\" not found", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantErr: fmt.Errorf("failed to apply credentials, reason: CredentialsProviderNotFound, error: %w", errors.New("credentials provider \"other-provider\" not found")),
+		},
+		"credentials provider fails": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Generation(1).
+					Obj(),
+			},
+			secrets: []corev1.Secret{
+				makeTestSecret("worker1", testKubeconfig("worker1")),
+			},
+			remoteClients: map[string]*remoteClient{
+				"worker1": newTestClient(ctx, nil, &rest.Config{Host: "https://10.10.10.10", BearerToken: "old-token"}, cancelCalled),
+			},
+			credsProvider: &testCredentialsProvider{err: errors.New("token endpoint unavailable")},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.SecretLocationType, "worker1").
+					Annotation(kueue.MultiKueueCredentialsProviderAnnotation, "test-provider").
+					Active(metav1.ConditionFalse, "CredentialsProviderFailed", "load client config failed: token endpoint unavailable", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantErr:          fmt.Errorf("failed to apply credentials, reason: CredentialsProviderFailed, error: %w", errors.New("token endpoint unavailable")),
+			wantCancelCalled: 1,
+		},
 	}
 
 	for name, tc := range cases {
@@ -671,6 +952,12 @@ func TestUpdateConfig(t *testing.T) {
 				reconciler.remoteClients = tc.remoteClients
 			}
 			reconciler.builderOverride = fakeClientBuilder(ctx)
+			reconciler.clock = testingclock.NewFakeClock(now)
+			if tc.credsProvider != nil {
+				features.SetFeatureGateDuringTest(t, features.MultiKueueCredentialsProviders, !tc.credentialsProvidersDisabled)
+				reconciler.credentialsProviders = map[string]CredentialsProvider{tc.credsProvider.Name(): tc.credsProvider}
+			}
+			reconciler.credentialsCache = tc.credentialsCache
 
 			if tc.skipInsecureKubeconfig {
 				features.SetFeatureGateDuringTest(t, features.MultiKueueAllowInsecureKubeconfigs, true)
@@ -884,6 +1171,357 @@ func TestRemoteClientGC(t *testing.T) {
 	}
 }
 
+func TestSyncStatus(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	workloadCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   workloadCRDName,
+			Labels: map[string]string{versionLabel: "v0.17.0"},
+		},
+	}
+	cq1 := utiltestingapi.MakeClusterQueue("cq1").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "8").
+			Resource(corev1.ResourceMemory, "8Gi").
+			Obj()).
+		Obj()
+	cq1.Status.FlavorsUsage = []kueue.FlavorUsage{{
+		Name: "default",
+		Resources: []kueue.ResourceUsage{{
+			Name:  corev1.ResourceCPU,
+			Total: resource.MustParse("3"),
+		}},
+	}}
+	cq2 := utiltestingapi.MakeClusterQueue("cq2").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "4").Obj(),
+		).
+		Obj()
+	baseWlBuilder := utiltestingapi.MakeWorkload("wl", TestNamespace)
+
+	cq2Flavors := []kueue.MultiKueueClusterFlavorStatus{
+		{
+			Name: "default",
+			Resources: []kueue.MultiKueueClusterResourceStatus{{
+				Name:         corev1.ResourceCPU,
+				NominalQuota: resource.MustParse("2"),
+				Usage:        resource.MustParse("0"),
+			}},
+		},
+		{
+			Name: "spot",
+			Resources: []kueue.MultiKueueClusterResourceStatus{{
+				Name:         corev1.ResourceCPU,
+				NominalQuota: resource.MustParse("4"),
+				Usage:        resource.MustParse("0"),
+			}},
+		},
+	}
+
+	cases := map[string]struct {
+		cordonPolicy  kueue.MultiKueueClusterCordonPolicy
+		workerObjects []client.Object
+		connecting    bool
+		wantStatus    kueue.MultiKueueClusterStatus
+	}{
+		"stats are published": {
+			workerObjects: []client.Object{
+				workloadCRD,
+				cq1,
+				cq2,
+				baseWlBuilder.Clone().Name("wl1").Label(kueue.MultiKueueOriginLabel, defaultOrigin).Obj(),
+				baseWlBuilder.Clone().Name("wl2").Label(kueue.MultiKueueOriginLabel, defaultOrigin).Obj(),
+				baseWlBuilder.Clone().Name("wl3").Label(kueue.MultiKueueOriginLabel, "other").Obj(),
+				baseWlBuilder.Clone().Name("wl4").Obj(),
+			},
+			wantStatus: kueue.MultiKueueClusterStatus{
+				KueueVersion:  "v0.17.0",
+				ClusterQueues: []kueue.ClusterQueueReference{"cq1", "cq2"},
+				Flavors: []kueue.MultiKueueClusterFlavorStatus{
+					{
+						Name: "default",
+						Resources: []kueue.MultiKueueClusterResourceStatus{
+							{
+								Name:         corev1.ResourceCPU,
+								NominalQuota: resource.MustParse("10"),
+								Usage:        resource.MustParse("3"),
+							},
+							{
+								Name:         corev1.ResourceMemory,
+								NominalQuota: resource.MustParse("8Gi"),
+								Usage:        resource.MustParse("0"),
+							},
+						},
+					},
+					{
+						Name: "spot",
+						Resources: []kueue.MultiKueueClusterResourceStatus{{
+							Name:         corev1.ResourceCPU,
+							NominalQuota: resource.MustParse("4"),
+							Usage:        resource.MustParse("0"),
+						}},
+					},
+				},
+				MirroredWorkloads:           ptr.To[int32](2),
+				LastSyncTime:                ptr.To(metav1.NewTime(now)),
+				LastSyncLatencyMilliseconds: ptr.To[int32](0),
+			},
+		},
+		"unknown kueue version": {
+			workerObjects: []client.Object{cq2},
+			wantStatus: kueue.MultiKueueClusterStatus{
+				ClusterQueues:               []kueue.ClusterQueueReference{"cq2"},
+				Flavors:                     cq2Flavors,
+				MirroredWorkloads:           ptr.To[int32](0),
+				LastSyncTime:                ptr.To(metav1.NewTime(now)),
+				LastSyncLatencyMilliseconds: ptr.To[int32](0),
+			},
+		},
+		"cordoned worker reports the remaining workloads": {
+			cordonPolicy: kueue.MultiKueueClusterCordonPolicyCordon,
+			workerObjects: []client.Object{
+				workloadCRD,
+				cq2,
+				baseWlBuilder.Clone().Name("wl1").Label(kueue.MultiKueueOriginLabel, defaultOrigin).Obj(),
+			},
+			wantStatus: kueue.MultiKueueClusterStatus{
+				Conditions: []metav1.Condition{{
+					Type:    kueue.MultiKueueClusterCordoned,
+					Status:  metav1.ConditionTrue,
+					Reason:  "Cordoned",
+					Message: "No new workloads are dispatched, 1 workloads remaining",
+				}},
+				KueueVersion:                "v0.17.0",
+				ClusterQueues:               []kueue.ClusterQueueReference{"cq2"},
+				Flavors:                     cq2Flavors,
+				MirroredWorkloads:           ptr.To[int32](1),
+				LastSyncTime:                ptr.To(metav1.NewTime(now)),
+				LastSyncLatencyMilliseconds: ptr.To[int32](0),
+			},
+		},
+		"draining worker reports the remaining workloads": {
+			cordonPolicy: kueue.MultiKueueClusterCordonPolicyDrain,
+			workerObjects: []client.Object{
+				workloadCRD,
+				cq2,
+				baseWlBuilder.Clone().Name("wl1").Label(kueue.MultiKueueOriginLabel, defaultOrigin).Obj(),
+				baseWlBuilder.Clone().Name("wl2").Label(kueue.MultiKueueOriginLabel, defaultOrigin).Obj(),
+			},
+			wantStatus: kueue.MultiKueueClusterStatus{
+				Conditions: []metav1.Condition{{
+					Type:    kueue.MultiKueueClusterCordoned,
+					Status:  metav1.ConditionTrue,
+					Reason:  "Draining",
+					Message: "Draining the cluster, 2 workloads remaining",
+				}},
+				KueueVersion:                "v0.17.0",
+				ClusterQueues:               []kueue.ClusterQueueReference{"cq2"},
+				Flavors:                     cq2Flavors,
+				MirroredWorkloads:           ptr.To[int32](2),
+				LastSyncTime:                ptr.To(metav1.NewTime(now)),
+				LastSyncLatencyMilliseconds: ptr.To[int32](0),
+			},
+		},
+		"drained worker": {
+			cordonPolicy:  kueue.MultiKueueClusterCordonPolicyDrain,
+			workerObjects: []client.Object{workloadCRD, cq2},
+			wantStatus: kueue.MultiKueueClusterStatus{
+				Conditions: []metav1.Condition{{
+					Type:    kueue.MultiKueueClusterCordoned,
+					Status:  metav1.ConditionTrue,
+					Reason:  "Drained",
+					Message: "No workloads remaining",
+				}},
+				KueueVersion:                "v0.17.0",
+				ClusterQueues:               []kueue.ClusterQueueReference{"cq2"},
+				Flavors:                     cq2Flavors,
+				MirroredWorkloads:           ptr.To[int32](0),
+				LastSyncTime:                ptr.To(metav1.NewTime(now)),
+				LastSyncLatencyMilliseconds: ptr.To[int32](0),
+			},
+		},
+		"disconnected worker is skipped": {
+			workerObjects: []client.Object{workloadCRD, cq1},
+			connecting:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clusterBuilder := utiltestingapi.MakeMultiKueueCluster("worker1")
+			if tc.cordonPolicy != "" {
+				clusterBuilder = clusterBuilder.CordonPolicy(tc.cordonPolicy)
+			}
+			cluster := clusterBuilder.Obj()
+			managerClient := getClientBuilder(ctx).
+				WithObjects(cluster).
+				WithStatusSubresource(cluster).
+				Build()
+			workerClient := getClientBuilder(ctx).WithObjects(tc.workerObjects...).Build()
+
+			reconciler := newClustersReconciler(managerClient, TestNamespace, 0, defaultOrigin, nil, nil, nil, nil)
+			reconciler.clock = testingclock.NewFakeClock(now)
+			rc := newRemoteClient(managerClient, nil, nil, defaultOrigin, "worker1", nil)
+			rc.client = workerClient
+			rc.connecting.Store(tc.connecting)
+
+			reconciler.syncStatus(ctx, rc)
+
+			gotCluster := &kueue.MultiKueueCluster{}
+			if err := managerClient.Get(ctx, types.NamespacedName{Name: "worker1"}, gotCluster); err != nil {
+				t.Fatalf("Unexpected error getting the MultiKueueCluster: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantStatus, gotCluster.Status,
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+			); diff != "" {
+				t.Errorf("Unexpected MultiKueueCluster status (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncCapacity(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cq := utiltestingapi.MakeClusterQueue("cq1").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "8").
+			Obj()).
+		Obj()
+
+	cases := map[string]struct {
+		connecting   bool
+		cached       bool
+		wantCapacity bool
+	}{
+		"capacity is cached": {
+			wantCapacity: true,
+		},
+		"capacity is refreshed": {
+			cached:       true,
+			wantCapacity: true,
+		},
+		"capacity of a disconnected worker is removed": {
+			connecting: true,
+			cached:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			managerClient := getClientBuilder(ctx).Build()
+			workerClient := getClientBuilder(ctx).WithObjects(cq).Build()
+
+			reconciler := newClustersReconciler(managerClient, TestNamespace, 0, defaultOrigin, nil, nil, nil, nil)
+			reconciler.clock = testingclock.NewFakeClock(now)
+			reconciler.capacityCache = capacity.NewCache()
+			if tc.cached {
+				reconciler.capacityCache.Add("worker1", &capacity.Worker{SyncTime: now.Add(-time.Minute)})
+			}
+			rc := newRemoteClient(managerClient, nil, nil, defaultOrigin, "worker1", nil)
+			rc.client = workerClient
+			rc.connecting.Store(tc.connecting)
+
+			reconciler.syncCapacity(ctx, rc)
+
+			w, gotCapacity := reconciler.capacityCache.Get("worker1")
+			if gotCapacity != tc.wantCapacity {
+				t.Fatalf("Unexpected capacity cached: %v, want %v", gotCapacity, tc.wantCapacity)
+			}
+			if gotCapacity && !w.SyncTime.Equal(now) {
+				t.Errorf("Unexpected capacity sync time: %v, want %v", w.SyncTime, now)
+			}
+		})
+	}
+}
+
+func TestSyncForeignUsage(t *testing.T) {
+	cq := utiltestingapi.MakeClusterQueue("cq1").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "8").
+			Obj()).
+		Obj()
+	lq1 := utiltestingapi.MakeLocalQueue("lq1", TestNamespace).ClusterQueue("cq1").Obj()
+	lq2 := utiltestingapi.MakeLocalQueue("lq2", "other").ClusterQueue("cq1").Obj()
+	defaultCPU := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	reserved := func(cpu string) []kueue.FlavorUsage {
+		return []kueue.FlavorUsage{{
+			Name: "default",
+			Resources: []kueue.ResourceUsage{{
+				Name:  corev1.ResourceCPU,
+				Total: resource.MustParse(cpu),
+			}},
+		}}
+	}
+
+	cases := map[string]struct {
+		previousUsage resources.FlavorResourceQuantities
+		workers       map[string]map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities
+		wantReserved  []kueue.FlavorUsage
+	}{
+		"the reservations of the LocalQueues of a ClusterQueue are summed across the workers": {
+			workers: map[string]map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities{
+				"worker1": {utilqueue.Key(lq1): {defaultCPU: 2000}},
+				"worker2": {
+					utilqueue.Key(lq1): {defaultCPU: 1000},
+					utilqueue.Key(lq2): {defaultCPU: 3000},
+				},
+			},
+			wantReserved: reserved("6"),
+		},
+		"the reservations of unknown LocalQueues are ignored": {
+			workers: map[string]map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities{
+				"worker1": {
+					utilqueue.Key(lq1): {defaultCPU: 2000},
+					"other/lq3":        {defaultCPU: 3000},
+				},
+			},
+			wantReserved: reserved("2"),
+		},
+		"the usage is released when the workers no longer reserve quota": {
+			previousUsage: resources.FlavorResourceQuantities{defaultCPU: 4000},
+			workers: map[string]map[utilqueue.LocalQueueReference]resources.FlavorResourceQuantities{
+				"worker1": {},
+			},
+			wantReserved: reserved("0"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			managerClient := getClientBuilder(ctx).WithObjects(lq1, lq2).Build()
+
+			reconciler := newClustersReconciler(managerClient, TestNamespace, 0, defaultOrigin, nil, nil, nil, nil)
+			reconciler.schedulerCache = schdcache.New(managerClient)
+			reconciler.queues = qcache.NewManagerForUnitTests(managerClient, nil)
+			reconciler.capacityCache = capacity.NewCache()
+			if err := reconciler.schedulerCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Failed adding the ClusterQueue: %v", err)
+			}
+			if tc.previousUsage != nil {
+				reconciler.schedulerCache.SetForeignUsage("cq1", tc.previousUsage)
+				reconciler.foreignUsageCQs = sets.New[kueue.ClusterQueueReference]("cq1")
+			}
+			for workerName, reservation := range tc.workers {
+				reconciler.capacityCache.Add(workerName, &capacity.Worker{ForeignReservation: reservation})
+			}
+
+			reconciler.syncForeignUsage(ctx)
+
+			stats, err := reconciler.schedulerCache.Usage(cq)
+			if err != nil {
+				t.Fatalf("Failed getting the ClusterQueue usage: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantReserved, stats.ReservedResources); diff != "" {
+				t.Errorf("Unexpected reserved resources (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateKubeconfig(t *testing.T) {
 	kubeconfigBase := utiltesting.NewTestKubeConfigWrapper().Cluster("test", "https://10.10.10.10", []byte{0x2d, 0x2d, 0x2d, 0x2d, 0x2d}).
 		User("u", nil, nil).
@@ -0,0 +1,77 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+)
+
+// syncForeignUsage records in the scheduler cache, as the foreign usage of each
+// manager ClusterQueue, the quota reserved in the worker clusters by the workloads
+// which were not dispatched by this manager, in the LocalQueues with the same
+// namespace and name as the LocalQueues of the ClusterQueue. The scheduler counts
+// it as usage of the ClusterQueue, so the quota leased to the worker clusters is
+// not reserved again in the manager cluster.
+func (c *clustersReconciler) syncForeignUsage(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx)
+	lqs := &kueue.LocalQueueList{}
+	if err := c.localClient.List(ctx, lqs); err != nil {
+		log.Error(err, "Listing the LocalQueues for the quota federation")
+		return
+	}
+
+	usage := make(map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities)
+	for _, workerName := range c.capacityCache.Keys() {
+		worker, found := c.capacityCache.Get(workerName)
+		if !found {
+			continue
+		}
+		for i := range lqs.Items {
+			lq := &lqs.Items[i]
+			reserved, found := worker.ForeignReservation[utilqueue.Key(lq)]
+			if !found {
+				continue
+			}
+			if _, found := usage[lq.Spec.ClusterQueue]; !found {
+				usage[lq.Spec.ClusterQueue] = make(resources.FlavorResourceQuantities)
+			}
+			for fr, q := range reserved {
+				usage[lq.Spec.ClusterQueue][fr] += q
+			}
+		}
+	}
+
+	changed := sets.New[kueue.ClusterQueueReference]()
+	for cqName := range c.foreignUsageCQs.Union(sets.KeySet(usage)) {
+		if c.schedulerCache.SetForeignUsage(cqName, usage[cqName]) {
+			changed.Insert(cqName)
+		}
+	}
+	c.foreignUsageCQs = sets.KeySet(usage)
+	if changed.Len() > 0 {
+		log.V(3).Info("Updated the quota leased to the worker clusters", "clusterQueues", sets.List(changed))
+		qcache.NotifyRetryInadmissible(c.queues, changed)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+const (
+	// maxReportedTerminations is the maximum number of failed containers
+	// reported for a workload.
+	maxReportedTerminations = 5
+	// terminationLogTailLines is the number of log lines reported for a failed container.
+	terminationLogTailLines = 10
+	// maxTerminationLogBytes bounds the size of the logs reported for a failed container,
+	// only the end of the logs is kept.
+	maxTerminationLogBytes = 512
+	// maxPodLogBytes bounds the size of the logs read from the worker cluster.
+	maxPodLogBytes = 16 * 1024
+
+	remoteTerminationEventReason = "RemotePodTerminated"
+)
+
+// podLogsGetter returns the last tailLines lines of the logs of a container.
+type podLogsGetter func(ctx context.Context, namespace, name, container string, tailLines int64) (string, error)
+
+func newPodLogsGetter(config *clientConfig) (podLogsGetter, error) {
+	restConfig, err := restConfigFor(config)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, namespace, name, container string, tailLines int64) (string, error) {
+		logs, err := clientset.CoreV1().Pods(namespace).GetLogs(name, &corev1.PodLogOptions{
+			Container:  container,
+			TailLines:  &tailLines,
+			LimitBytes: ptr.To[int64](maxPodLogBytes),
+		}).DoRaw(ctx)
+		return string(logs), err
+	}, nil
+}
+
+// remoteTerminations - returns the messages of the warning events recorded on the
+// local workload for the containers of the job's pods in the worker cluster which
+// terminated with a non-zero exit code, with their exit code, reason and last log
+// lines, so that the failures can be debugged without access to the worker cluster.
+// The pods are selected by the labels returned by the adapter, the jobs whose
+// adapter does not implement MultiKueuePodsSelector are not reported.
+// At most maxReportedTerminations containers are reported. Errors are only logged.
+func (w *wlReconciler) remoteTerminations(ctx context.Context, group *wlGroup, remote string) []string {
+	log := ctrl.LoggerFrom(ctx).WithValues("workerCluster", remote)
+	selector, implementsSelector := group.jobAdapter.(jobframework.MultiKueuePodsSelector)
+	if !implementsSelector {
+		log.V(3).Info("Skipped reporting the remote terminations, the pods of the job cannot be selected")
+		return nil
+	}
+	rc := group.remoteClients[remote]
+
+	pods := &corev1.PodList{}
+	if err := rc.client.List(ctx, pods, client.InNamespace(group.controllerKey.Namespace), client.MatchingLabels(selector.RemotePodsSelector(group.controllerKey))); err != nil {
+		log.V(2).Error(err, "Listing the remote pods")
+		return nil
+	}
+	slices.SortFunc(pods.Items, func(a, b corev1.Pod) int { return strings.Compare(a.Name, b.Name) })
+
+	var messages []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, status := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			if len(messages) == maxReportedTerminations {
+				log.V(3).Info("Skipped reporting the remaining failed containers", "limit", maxReportedTerminations)
+				return messages
+			}
+
+			var logs string
+			if rc.podLogs != nil {
+				var err error
+				if logs, err = rc.podLogs(ctx, pod.Namespace, pod.Name, status.Name, terminationLogTailLines); err != nil {
+					log.V(3).Info("Reading the remote container logs", "pod", pod.Name, "container", status.Name, "err", err)
+				}
+			}
+			if logs == "" {
+				logs = terminated.Message
+			}
+			messages = append(messages, terminationMessage(remote, pod.Name, status.Name, terminated, logs))
+		}
+	}
+	return messages
+}
+
+func terminationMessage(remote, podName, containerName string, terminated *corev1.ContainerStateTerminated, logs string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Container %q of pod %q in worker cluster %q terminated with exit code %d", containerName, podName, remote, terminated.ExitCode)
+	if terminated.Reason != "" {
+		fmt.Fprintf(&b, ", reason: %s", terminated.Reason)
+	}
+	logs = strings.TrimSpace(logs)
+	if len(logs) > maxTerminationLogBytes {
+		logs = "..." + logs[len(logs)-maxTerminationLogBytes:]
+	}
+	if logs != "" {
+		fmt.Fprintf(&b, "; last log lines:\n%s", logs)
+	}
+	return b.String()
+}
@@ -0,0 +1,40 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workers
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
+)
+
+// Client is the client of a connected worker cluster.
+type Client struct {
+	client.Client
+
+	// Origin is the value of the multikueue-origin label set on the
+	// objects created by this manager in the worker cluster.
+	Origin string
+}
+
+// Clients holds the clients of the connected worker clusters, indexed by the
+// MultiKueueCluster name, to be used outside of the MultiKueue controllers.
+type Clients = utilmaps.SyncMap[string, *Client]
+
+func NewClients() *Clients {
+	return utilmaps.NewSyncMap[string, *Client](0)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package multikueue
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -30,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -52,6 +54,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/api"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
 	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -62,30 +65,45 @@ var (
 	realClock = clock.RealClock{}
 )
 
+const (
+	migrationRequestedReason = "Migration requested"
+	migrationResumedReason   = "Migration resumed"
+
+	// preferredClustersTimeout is the time during which the AllAtOnce dispatcher
+	// only nominates the preferred worker clusters of a workload, before
+	// nominating all the selected ones.
+	preferredClustersTimeout = 5 * time.Minute
+)
+
 type wlReconciler struct {
-	client            client.Client
-	helper            *admissioncheck.MultiKueueStoreHelper
-	clusters          *clustersReconciler
-	origin            string
-	workerLostTimeout time.Duration
-	deletedWlCache    *utilmaps.SyncMap[string, *kueue.Workload]
-	eventsBatchPeriod time.Duration
-	adapters          map[string]jobframework.MultiKueueAdapter
-	recorder          record.EventRecorder
-	clock             clock.Clock
-	dispatcherName    string
-	roleTracker       *roletracker.RoleTracker
+	client                    client.Client
+	helper                    *admissioncheck.MultiKueueStoreHelper
+	clusters                  *clustersReconciler
+	origin                    string
+	workerLostTimeout         time.Duration
+	deletedWlCache            *utilmaps.SyncMap[string, *kueue.Workload]
+	eventsBatchPeriod         time.Duration
+	adapters                  map[string]jobframework.MultiKueueAdapter
+	recorder                  record.EventRecorder
+	clock                     clock.Clock
+	dispatcherName            string
+	roleTracker               *roletracker.RoleTracker
+	migrationPodsReadyTimeout time.Duration
+	// migrateFromDrainedClusters - if set, the workloads admitted in a worker
+	// cluster with the Drain cordon policy are migrated to other worker clusters.
+	migrateFromDrainedClusters bool
 }
 
 var _ reconcile.Reconciler = (*wlReconciler)(nil)
 
 type wlGroup struct {
-	local         *kueue.Workload
-	remotes       map[string]*kueue.Workload
-	remoteClients map[string]*remoteClient
-	acName        kueue.AdmissionCheckReference
-	jobAdapter    jobframework.MultiKueueAdapter
-	controllerKey types.NamespacedName
+	local          *kueue.Workload
+	remotes        map[string]*kueue.Workload
+	remoteClients  map[string]*remoteClient
+	cordonPolicies map[string]kueue.MultiKueueClusterCordonPolicy
+	acName         kueue.AdmissionCheckReference
+	jobAdapter     jobframework.MultiKueueAdapter
+	controllerKey  types.NamespacedName
 }
 
 type Option func(reconciler *wlReconciler)
@@ -218,6 +236,12 @@ func (w *wlReconciler) Reconcile(ctx context.Context, req reconcile.Request) (re
 		if !managed {
 			return reconcile.Result{}, w.updateACS(ctx, wl, mkAc, kueue.CheckStateRejected, fmt.Sprintf("The owner is not managed by Kueue: %s", unmanagedReason))
 		}
+
+		if features.Enabled(features.MultiKueueClusterAffinity) {
+			if _, _, err := admissioncheck.ClusterSelectors(wl); err != nil {
+				return reconcile.Result{}, w.updateACS(ctx, wl, mkAc, kueue.CheckStateRejected, err.Error())
+			}
+		}
 	}
 
 	grp, err := w.readGroup(ctx, wl, mkAc.Name, adapter, owner.Name)
@@ -299,13 +323,19 @@ func (w *wlReconciler) readGroup(ctx context.Context, local *kueue.Workload, acN
 		return nil, fmt.Errorf("admission check %q: %w", acName, err)
 	}
 
+	cordonPolicies, err := admissioncheck.CordonPolicies(ctx, w.client, sets.KeySet(rClients))
+	if err != nil {
+		return nil, err
+	}
+
 	grp := wlGroup{
-		local:         local,
-		remotes:       make(map[string]*kueue.Workload, len(rClients)),
-		remoteClients: rClients,
-		acName:        acName,
-		jobAdapter:    adapter,
-		controllerKey: types.NamespacedName{Name: controllerName, Namespace: local.Namespace},
+		local:          local,
+		remotes:        make(map[string]*kueue.Workload, len(rClients)),
+		remoteClients:  rClients,
+		cordonPolicies: cordonPolicies,
+		acName:         acName,
+		jobAdapter:     adapter,
+		controllerKey:  types.NamespacedName{Name: controllerName, Namespace: local.Namespace},
 	}
 
 	for remote, rClient := range rClients {
@@ -364,8 +394,20 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 			log.V(3).Info("Group with no adapter, skip owner status copy", "workerCluster", remote)
 		}
 
+		var terminations []string
+		if features.Enabled(features.MultiKueueRemoteTerminationEvents) {
+			terminations = w.remoteTerminations(ctx, group, remote)
+		}
+
 		// finish workload and copy the status to the local one
-		return reconcile.Result{}, workload.Finish(ctx, w.client, group.local, remoteFinishedCond.Reason, remoteFinishedCond.Message, w.clock, w.roleTracker)
+		if err := workload.Finish(ctx, w.client, group.local, remoteFinishedCond.Reason, remoteFinishedCond.Message, w.clock, w.roleTracker); err != nil {
+			return reconcile.Result{}, err
+		}
+		// the events are only recorded once, the remote objects are deleted once the local workload is finished
+		for _, message := range terminations {
+			w.recorder.Event(group.local, corev1.EventTypeWarning, remoteTerminationEventReason, message)
+		}
+		return reconcile.Result{}, nil
 	}
 
 	// 4. Handle workload eviction
@@ -487,11 +529,28 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 			return reconcile.Result{}, err
 		}
 
+		requeueAfter := w.workerLostTimeout
+		if features.Enabled(features.MultiKueueWorkloadMigration) && acs.State == kueue.CheckStateReady && workload.ClusterName(group.local) == reservingRemote {
+			reason, remainingWaitTime := w.migrationReason(group.local, remoteWl, remoteCond, group.cordonPolicies[reservingRemote])
+			if reason != "" {
+				return reconcile.Result{}, w.migrate(ctx, group, reservingRemote, acs, reason)
+			}
+			if remainingWaitTime > 0 {
+				requeueAfter = min(requeueAfter, remainingWaitTime)
+			}
+		}
+
 		if err := w.syncReservingRemoteState(ctx, group, reservingRemote, acs); err != nil {
 			return reconcile.Result{}, err
 		}
-		return reconcile.Result{RequeueAfter: w.workerLostTimeout}, nil
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	} else if acs.State == kueue.CheckStateReady {
+		if features.Enabled(features.MultiKueueWorkloadMigration) {
+			if reason, cluster := interruptedMigration(group.local); reason != "" {
+				log.V(3).Info("Resuming interrupted migration", "reason", reason)
+				return reconcile.Result{}, w.completeMigration(ctx, group, cluster, acs, reason)
+			}
+		}
 		// If there is no reserving and the AC is ready, the connection with the reserving remote might
 		// be lost, keep the workload admitted for keepReadyTimeout and put it back in the queue after that.
 		remainingWaitTime := w.workerLostTimeout - time.Since(acs.LastTransitionTime.Time)
@@ -506,6 +565,103 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 	return w.nominateAndSynchronizeWorkers(ctx, group)
 }
 
+// migrationReason returns the reason to migrate the workload from its worker cluster.
+// If no migration is due, it returns the time left until the workload should be
+// migrated automatically, if any.
+func (w *wlReconciler) migrationReason(local, remote *kueue.Workload, admittedCond *metav1.Condition, cordonPolicy kueue.MultiKueueClusterCordonPolicy) (string, time.Duration) {
+	if reason, requested := local.Annotations[kueue.MultiKueueMigrateAnnotation]; requested {
+		return cmp.Or(reason, migrationRequestedReason), 0
+	}
+	if w.migrateFromDrainedClusters && cordonPolicy == kueue.MultiKueueClusterCordonPolicyDrain {
+		return "Worker cluster drained", 0
+	}
+	if w.migrationPodsReadyTimeout <= 0 {
+		return "", 0
+	}
+	podsReadyCond := apimeta.FindStatusCondition(remote.Status.Conditions, kueue.WorkloadPodsReady)
+	if podsReadyCond == nil || podsReadyCond.Status == metav1.ConditionTrue {
+		return "", 0
+	}
+	notReadySince := podsReadyCond.LastTransitionTime.Time
+	if admittedCond.LastTransitionTime.After(notReadySince) {
+		notReadySince = admittedCond.LastTransitionTime.Time
+	}
+	if remainingWaitTime := w.migrationPodsReadyTimeout - w.clock.Since(notReadySince); remainingWaitTime > 0 {
+		return "", remainingWaitTime
+	}
+	return fmt.Sprintf("Pods not ready within %s", w.migrationPodsReadyTimeout), 0
+}
+
+// interruptedMigration returns the reason and the source cluster of a migration
+// which was interrupted after the remote objects were deleted, if any.
+// The kueue.x-k8s.io/multikueue-migrated-from annotation is cleared by
+// syncReservingRemoteState once the workload is dispatched again, so it only
+// matches the cluster name before the workload is requeued.
+func interruptedMigration(local *kueue.Workload) (string, string) {
+	cluster := workload.ClusterName(local)
+	if cluster == "" {
+		return "", ""
+	}
+	if reason, requested := local.Annotations[kueue.MultiKueueMigrateAnnotation]; requested {
+		return cmp.Or(reason, migrationRequestedReason), cluster
+	}
+	if local.Annotations[kueue.MultiKueueMigratedFromAnnotation] == cluster {
+		return migrationResumedReason, cluster
+	}
+	return "", ""
+}
+
+// migrate deletes the remote objects from the worker cluster and puts the workload
+// back in the queue, to be dispatched to another worker cluster.
+//
+// Each step can be repeated: the migration is recorded in the
+// kueue.x-k8s.io/multikueue-migrate annotation until the remote objects are
+// deleted, and resumed by completeMigration if it is interrupted after that.
+func (w *wlReconciler) migrate(ctx context.Context, group *wlGroup, cluster string, acs *kueue.AdmissionCheckState, reason string) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if _, requested := group.local.Annotations[kueue.MultiKueueMigrateAnnotation]; !requested {
+		if err := clientutil.Patch(ctx, w.client, group.local, func() (bool, error) {
+			metav1.SetMetaDataAnnotation(&group.local.ObjectMeta, kueue.MultiKueueMigrateAnnotation, reason)
+			return true, nil
+		}); err != nil {
+			log.Error(err, "Failed to record the migration")
+			return err
+		}
+	}
+
+	if err := client.IgnoreNotFound(group.RemoveRemoteObjects(ctx, cluster)); err != nil {
+		log.V(2).Error(err, "Deleting remote objects for migration")
+		return err
+	}
+
+	return w.completeMigration(ctx, group, cluster, acs, reason)
+}
+
+// completeMigration records the worker cluster the workload was migrated from,
+// once its remote objects are deleted, and puts the workload back in the queue.
+func (w *wlReconciler) completeMigration(ctx context.Context, group *wlGroup, cluster string, acs *kueue.AdmissionCheckState, reason string) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := clientutil.Patch(ctx, w.client, group.local, func() (bool, error) {
+		delete(group.local.Annotations, kueue.MultiKueueMigrateAnnotation)
+		metav1.SetMetaDataAnnotation(&group.local.ObjectMeta, kueue.MultiKueueMigratedFromAnnotation, cluster)
+		return true, nil
+	}); err != nil {
+		log.Error(err, "Failed to record the migration")
+		return err
+	}
+
+	message := fmt.Sprintf("%s, migrating from worker cluster %q", reason, cluster)
+	log.V(3).Info("Migrating workload", "reason", reason)
+	if err := w.updateACS(ctx, group.local, acs, kueue.CheckStateRetry, message); err != nil {
+		log.Error(err, "Failed to patch workload status")
+		return err
+	}
+	w.recorder.Eventf(group.local, corev1.EventTypeNormal, "MultiKueueMigration", message)
+	return nil
+}
+
 func (w *wlReconciler) listComponentWorkloads(ctx context.Context, wl *kueue.Workload) (*kueue.WorkloadList, error) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -711,6 +867,7 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 	}
 
 	var nominatedWorkers []string
+	var requeueAfter time.Duration
 
 	// For elastic workloads, retrieve the remote cluster where the original workload was scheduled.
 	// For now, new workload slices will continue to be assigned to the same cluster.
@@ -719,9 +876,21 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 	if clusterName := workload.ClusterName(group.local); group.IsElasticWorkload() && clusterName != "" {
 		nominatedWorkers = []string{clusterName}
 	} else if w.dispatcherName == config.MultiKueueDispatcherModeAllAtOnce {
-		for workerName := range group.remotes {
-			nominatedWorkers = append(nominatedWorkers, workerName)
+		available, preferred, err := admissioncheck.SelectClusters(ctx, w.client, group.local, sets.KeySet(group.remotes).Difference(sets.KeySet(group.cordonPolicies)))
+		if err != nil {
+			log.V(2).Error(err, "Failed to select worker clusters", "workload", klog.KObj(group.local))
+			return reconcile.Result{}, err
+		}
+		available = admissioncheck.ExcludeMigratedFromCluster(group.local, available)
+		// nominate the preferred clusters first, then all the selected ones if the
+		// workload is not admitted in any of them in time
+		if preferredAvailable := available.Intersection(preferred); preferredAvailable.Len() > 0 {
+			if remaining := preferredClustersTimeout - w.clock.Since(quotaReservationTime(group.local)); remaining > 0 {
+				available = preferredAvailable
+				requeueAfter = remaining
+			}
 		}
+		nominatedWorkers = sets.List(available)
 		if group.local.Status.ClusterName == nil && !equality.Semantic.DeepEqual(group.local.Status.NominatedClusterNames, nominatedWorkers) {
 			if err := workload.PatchAdmissionStatus(ctx, w.client, group.local, w.clock, func(wl *kueue.Workload) (bool, error) {
 				wl.Status.NominatedClusterNames = nominatedWorkers
@@ -740,15 +909,29 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 
 	var errs []error
 	for rem, remoteWl := range group.remotes {
-		if slices.Contains(nominatedWorkers, rem) {
+		cordonPolicy := group.cordonPolicies[rem]
+		if cordonPolicy == kueue.MultiKueueClusterCordonPolicyDrain {
+			// The remote workload is not admitted, remove it from the drained cluster.
+			if remoteWl != nil {
+				if err := client.IgnoreNotFound(group.RemoveRemoteObjects(ctx, rem)); err != nil {
+					log.V(2).Error(err, "removing remote object from drained cluster", "remote", rem)
+					errs = append(errs, err)
+				}
+				group.remotes[rem] = nil
+			}
+		} else if slices.Contains(nominatedWorkers, rem) {
 			if remoteWl == nil {
+				if cordonPolicy != "" {
+					log.V(3).Info("Skip creating remote object in cordoned cluster", "remote", rem)
+					continue
+				}
 				clone := cloneForCreate(group.local, group.remoteClients[rem].origin)
 				if err := group.remoteClients[rem].client.Create(ctx, clone); err != nil {
 					log.V(2).Error(err, "creating remote object", "remote", rem)
 					errs = append(errs, err)
 				}
 			}
-		} else if remoteWl != nil {
+		} else if remoteWl != nil && cordonPolicy != kueue.MultiKueueClusterCordonPolicyCordon {
 			if err := client.IgnoreNotFound(group.RemoveRemoteObjects(ctx, rem)); err != nil {
 				log.V(2).Error(err, "removing non-nominated remote object", "remote", rem)
 				errs = append(errs, err)
@@ -756,7 +939,15 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 			group.remotes[rem] = nil
 		}
 	}
-	return reconcile.Result{}, errors.Join(errs...)
+	return reconcile.Result{RequeueAfter: requeueAfter}, errors.Join(errs...)
+}
+
+// quotaReservationTime returns the time at which the workload reserved quota.
+func quotaReservationTime(wl *kueue.Workload) time.Time {
+	if cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved); cond != nil {
+		return cond.LastTransitionTime.Time
+	}
+	return time.Time{}
 }
 
 func (w *wlReconciler) Create(_ event.CreateEvent) bool {
@@ -930,6 +1121,19 @@ func (w *wlReconciler) syncReservingRemoteState(ctx context.Context, group *wlGr
 		return nil
 	}
 
+	// The workload was dispatched again after a migration, so the source cluster is
+	// no longer needed. It is cleared before the cluster name is set, otherwise a
+	// workload dispatched back to the same cluster would look like an interrupted migration.
+	if _, migrated := group.local.Annotations[kueue.MultiKueueMigratedFromAnnotation]; migrated && needsACUpdate {
+		if err := clientutil.Patch(ctx, w.client, group.local, func() (bool, error) {
+			delete(group.local.Annotations, kueue.MultiKueueMigratedFromAnnotation)
+			return true, nil
+		}); err != nil {
+			log.V(2).Error(err, "Failed to clear the migration source", "workload", klog.KObj(group.local))
+			return err
+		}
+	}
+
 	if err := workload.PatchAdmissionStatus(ctx, w.client, group.local, w.clock, func(wl *kueue.Workload) (bool, error) {
 		if needsTopologyUpdate {
 			updateDelayedTopologyRequest(wl, group.remotes[reservingRemote])
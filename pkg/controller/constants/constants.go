@@ -58,4 +58,28 @@ const (
 	// ComponentWorkloadIndexAnnotation stores the numeric index for component workloads
 	// in multi-workload jobs (e.g., LeaderWorkerSet replicas).
 	ComponentWorkloadIndexAnnotation = "kueue.x-k8s.io/component-workload-index"
+
+	// CheckpointGracePeriodAnnotation is the annotation key in the job that opts
+	// it in to the checkpoint-aware eviction. It holds the maximum duration,
+	// for example "2m", Kueue waits for the checkpoint before stopping the job.
+	CheckpointGracePeriodAnnotation = "kueue.x-k8s.io/checkpoint-grace-period"
+
+	// CheckpointRequestedAnnotation is the annotation key set by Kueue on the job,
+	// and its pods, when the workload is about to be evicted. It holds the time
+	// of the request in the RFC 3339 format.
+	CheckpointRequestedAnnotation = "kueue.x-k8s.io/checkpoint-requested"
+
+	// CheckpointCompleteAnnotation is the annotation key set on the job by the user,
+	// or the job itself, to acknowledge that the checkpoint is complete.
+	CheckpointCompleteAnnotation = "kueue.x-k8s.io/checkpoint-complete"
+
+	// CheckpointHTTPHookAnnotation is the annotation key in the job that holds the
+	// port and path, for example "8080/checkpoint", to which Kueue sends a POST
+	// request on every running pod of the job when the checkpoint is requested.
+	CheckpointHTTPHookAnnotation = "kueue.x-k8s.io/checkpoint-http-hook"
+
+	// DependenciesAnnotation is the annotation key in the job that holds the
+	// dependencies of its Workload, as a JSON list of WorkloadDependency,
+	// for example `[{"name":"prepare"}]`.
+	DependenciesAnnotation = "kueue.x-k8s.io/dependencies"
 )
@@ -98,6 +98,13 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 	if err := workloadRec.SetupWithManager(mgr, cfg); err != nil {
 		return "Workload", err
 	}
+	if features.Enabled(features.WorkloadDependencies) {
+		depRec := NewWorkloadDependencyReconciler(mgr.GetClient(),
+			mgr.GetEventRecorderFor(constants.WorkloadDependencyControllerName), roleTracker)
+		if err := depRec.SetupWithManager(mgr, cfg); err != nil {
+			return "WorkloadDependency", err
+		}
+	}
 	qManager.AddTopologyUpdateWatcher(cqRec)
 	qManager.AddWorkloadUpdateWatcher(qRec)
 	return "", nil
@@ -42,6 +42,7 @@ const (
 	OwnerReferenceUID          = "metadata.ownerReferences.uid"
 	WorkloadAdmissionCheckKey  = "status.admissionChecks"
 	WorkloadPriorityClassKey   = "spec.priorityClassRef"
+	WorkloadHasDependenciesKey = "spec.hasDependencies"
 	// WorkloadSliceNameKey is an index for pods by their workload slice name annotation.
 	// Used to find pods belonging to an elastic workload slice chain.
 	WorkloadSliceNameKey = "metadata.workloadSliceName"
@@ -212,6 +213,14 @@ func IndexWorkloadPriorityClass(obj client.Object) []string {
 	return []string{wl.Spec.PriorityClassRef.Name}
 }
 
+func IndexWorkloadHasDependencies(obj client.Object) []string {
+	wl, ok := obj.(*kueue.Workload)
+	if !ok || len(wl.Spec.Dependencies) == 0 {
+		return nil
+	}
+	return []string{"true"}
+}
+
 // Setup sets the index with the given fields for core apis.
 func Setup(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadQueueKey, IndexWorkloadQueue); err != nil {
@@ -232,6 +241,11 @@ func Setup(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadPriorityClassKey, IndexWorkloadPriorityClass); err != nil {
 		return fmt.Errorf("setting index on priorityClass for Workload: %w", err)
 	}
+	if features.Enabled(features.WorkloadDependencies) {
+		if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadHasDependenciesKey, IndexWorkloadHasDependencies); err != nil {
+			return fmt.Errorf("setting index on hasDependencies for Workload: %w", err)
+		}
+	}
 	if err := indexer.IndexField(ctx, &kueue.LocalQueue{}, QueueClusterQueueKey, IndexQueueClusterQueue); err != nil {
 		return fmt.Errorf("setting index on clusterQueue for localQueue: %w", err)
 	}
@@ -0,0 +1,207 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// WorkloadDependencyReconciler tracks the dependencies of the pending
+// Workloads. It marks the Workloads whose dependencies are satisfied, so that
+// the queue manager can consider them for admission, and finishes the
+// Workloads whose dependencies failed.
+type WorkloadDependencyReconciler struct {
+	logName     string
+	client      client.Client
+	clock       clock.Clock
+	recorder    record.EventRecorder
+	roleTracker *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*WorkloadDependencyReconciler)(nil)
+
+func NewWorkloadDependencyReconciler(
+	client client.Client,
+	recorder record.EventRecorder,
+	roleTracker *roletracker.RoleTracker,
+) *WorkloadDependencyReconciler {
+	return &WorkloadDependencyReconciler{
+		logName:     "workload-dependency-reconciler",
+		client:      client,
+		clock:       clock.RealClock{},
+		recorder:    recorder,
+		roleTracker: roleTracker,
+	}
+}
+
+func (r *WorkloadDependencyReconciler) logger() logr.Logger {
+	return roletracker.WithReplicaRole(ctrl.Log.WithName(r.logName), r.roleTracker)
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+
+func (r *WorkloadDependencyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var wl kueue.Workload
+	if err := r.client.Get(ctx, req.NamespacedName, &wl); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !workload.HasPendingDependencies(&wl) || workload.IsFinished(&wl) || !wl.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Workload dependencies")
+
+	// The webhook rejects the cycles, but it can miss the ones formed by
+	// Workloads created concurrently.
+	cycle, err := workload.FindDependencyCycle(ctx, r.client, &wl)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var state workload.DependenciesState
+	var msg string
+	if cycle != nil {
+		state, msg = workload.DependenciesStateFailed, fmt.Sprintf("The dependencies form a cycle: %s", strings.Join(cycle, " -> "))
+	} else if state, msg, err = workload.EvaluateDependencies(ctx, r.client, &wl); err != nil {
+		return ctrl.Result{}, err
+	}
+	switch state {
+	case workload.DependenciesStateFailed:
+		log.V(2).Info("Finishing the Workload due to a failed dependency", "message", msg)
+		if err := workload.Finish(ctx, r.client, &wl, kueue.WorkloadFinishedReasonDependencyFailed, msg, r.clock, r.roleTracker); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		r.recorder.Event(&wl, corev1.EventTypeWarning, kueue.WorkloadFinishedReasonDependencyFailed, msg)
+	case workload.DependenciesStateSatisfied:
+		log.V(2).Info("Workload dependencies are satisfied")
+		return ctrl.Result{}, r.setDependenciesSatisfiedCondition(ctx, &wl, metav1.ConditionTrue, kueue.WorkloadDependenciesFinished, msg)
+	default:
+		cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadDependenciesSatisfied)
+		if cond != nil && cond.Reason == kueue.WorkloadDependenciesPending && cond.Message == msg {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, r.setDependenciesSatisfiedCondition(ctx, &wl, metav1.ConditionFalse, kueue.WorkloadDependenciesPending, msg)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *WorkloadDependencyReconciler) setDependenciesSatisfiedCondition(ctx context.Context, wl *kueue.Workload, status metav1.ConditionStatus, reason, msg string) error {
+	err := workload.SetConditionAndUpdate(ctx, r.client, wl, kueue.WorkloadDependenciesSatisfied, status, reason, msg, constants.WorkloadDependencyControllerName, r.clock)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WorkloadDependencyReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("workload_dependency_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.Workload{},
+			&workloadDependencyHandler{r: r},
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.GroupVersion.WithKind("Workload").GroupKind().String()],
+			LogConstructor:          roletracker.NewLogConstructor(r.roleTracker, "workload-dependency-reconciler"),
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.Workload{}, cfg))
+}
+
+// workloadDependencyHandler enqueues the Workloads with pending dependencies,
+// and the dependents of the Workloads which finish or are deleted.
+type workloadDependencyHandler struct {
+	r *WorkloadDependencyReconciler
+}
+
+var _ handler.TypedEventHandler[*kueue.Workload, reconcile.Request] = (*workloadDependencyHandler)(nil)
+
+func (h *workloadDependencyHandler) Create(ctx context.Context, e event.TypedCreateEvent[*kueue.Workload], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.handle(ctx, e.Object, workload.IsFinished(e.Object), q)
+}
+
+func (h *workloadDependencyHandler) Update(ctx context.Context, e event.TypedUpdateEvent[*kueue.Workload], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.handle(ctx, e.ObjectNew, workload.IsFinished(e.ObjectNew) && !workload.IsFinished(e.ObjectOld), q)
+}
+
+// Delete enqueues the dependents of the deleted Workload. A missing dependency
+// is pending rather than failed, since the Workloads of a pipeline can be created
+// in any order, but the dependents of a selector are evaluated again without it.
+func (h *workloadDependencyHandler) Delete(ctx context.Context, e event.TypedDeleteEvent[*kueue.Workload], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueDependents(ctx, e.Object, q)
+}
+
+func (h *workloadDependencyHandler) Generic(context.Context, event.TypedGenericEvent[*kueue.Workload], workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+func (h *workloadDependencyHandler) handle(ctx context.Context, wl *kueue.Workload, finished bool, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if workload.HasPendingDependencies(wl) && !workload.IsFinished(wl) {
+		q.Add(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)})
+	}
+	if finished {
+		h.enqueueDependents(ctx, wl, q)
+	}
+}
+
+func (h *workloadDependencyHandler) enqueueDependents(ctx context.Context, wl *kueue.Workload, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log := h.r.logger().WithValues("workload", klog.KObj(wl))
+	var dependents kueue.WorkloadList
+	if err := h.r.client.List(ctx, &dependents, client.InNamespace(wl.Namespace),
+		client.MatchingFields{indexer.WorkloadHasDependenciesKey: "true"}); err != nil {
+		log.Error(err, "Failed to list the Workloads with dependencies")
+		return
+	}
+	for i := range dependents.Items {
+		dependent := &dependents.Items[i]
+		if workload.HasPendingDependencies(dependent) && workload.DependsOn(dependent, wl) {
+			log.V(3).Info("Queueing the dependent Workload", "dependent", klog.KObj(dependent))
+			q.Add(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(dependent)})
+		}
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestWorkloadDependencyReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	dependency := kueue.WorkloadDependency{Name: ptr.To("prepare"), Condition: kueue.WorkloadDependencySucceeded}
+	finished := func(reason string) metav1.Condition {
+		return metav1.Condition{
+			Type:   kueue.WorkloadFinished,
+			Status: metav1.ConditionTrue,
+			Reason: reason,
+		}
+	}
+	cases := map[string]struct {
+		dependency   *kueue.Workload
+		workload     *kueue.Workload
+		wantWorkload *kueue.Workload
+	}{
+		"dependency is running": {
+			dependency: utiltestingapi.MakeWorkload("prepare", "ns").Obj(),
+			workload:   utiltestingapi.MakeWorkload("train", "ns").Dependency(dependency).Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadDependenciesSatisfied,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadDependenciesPending,
+					Message: "Waiting for the dependencies to finish: prepare",
+				}).
+				Obj(),
+		},
+		"dependency succeeded": {
+			dependency: utiltestingapi.MakeWorkload("prepare", "ns").
+				Condition(finished(kueue.WorkloadFinishedReasonSucceeded)).
+				Obj(),
+			workload: utiltestingapi.MakeWorkload("train", "ns").Dependency(dependency).Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadDependenciesSatisfied,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependenciesFinished,
+					Message: "All the dependencies are satisfied",
+				}).
+				Obj(),
+		},
+		"dependency does not exist": {
+			dependency: utiltestingapi.MakeWorkload("unrelated", "ns").Obj(),
+			workload:   utiltestingapi.MakeWorkload("train", "ns").Dependency(dependency).Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadDependenciesSatisfied,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadDependenciesPending,
+					Message: "Waiting for the dependencies to finish: prepare",
+				}).
+				Obj(),
+		},
+		"dependency failed": {
+			dependency: utiltestingapi.MakeWorkload("prepare", "ns").
+				Condition(finished(kueue.WorkloadFinishedReasonFailed)).
+				Obj(),
+			workload: utiltestingapi.MakeWorkload("train", "ns").Dependency(dependency).Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadFinished,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadFinishedReasonDependencyFailed,
+					Message: `The dependency "prepare" finished without success`,
+				}).
+				Obj(),
+		},
+		"dependencies form a cycle": {
+			dependency: utiltestingapi.MakeWorkload("prepare", "ns").
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("train")}).
+				Obj(),
+			workload: utiltestingapi.MakeWorkload("train", "ns").Dependency(dependency).Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadFinished,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadFinishedReasonDependencyFailed,
+					Message: "The dependencies form a cycle: train -> prepare -> train",
+				}).
+				Obj(),
+		},
+		"satisfied dependencies are not evaluated again": {
+			dependency: utiltestingapi.MakeWorkload("prepare", "ns").
+				Condition(finished(kueue.WorkloadFinishedReasonFailed)).
+				Obj(),
+			workload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadDependenciesSatisfied,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadDependenciesFinished,
+				}).
+				Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("train", "ns").
+				Dependency(dependency).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadDependenciesSatisfied,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadDependenciesFinished,
+				}).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, true)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(tc.dependency, tc.workload).
+				WithStatusSubresource(tc.dependency, tc.workload).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			reconciler := NewWorkloadDependencyReconciler(cl, record.NewFakeRecorder(10), nil)
+			reconciler.clock = testingclock.NewFakeClock(now)
+
+			if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.workload)}); err != nil {
+				t.Fatalf("Reconcile returned error: %v", err)
+			}
+			var gotWorkload kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.workload), &gotWorkload); err != nil {
+				t.Fatalf("Could not get the Workload after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkload, &gotWorkload,
+				cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration"),
+			); diff != "" {
+				t.Errorf("Unexpected Workload after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadDependencyHandler(t *testing.T) {
+	prepare := utiltestingapi.MakeWorkload("prepare", "ns").Label("stage", "prepare").Obj()
+	finishedPrepare := utiltestingapi.MakeWorkload("prepare", "ns").Label("stage", "prepare").Finished().Obj()
+	cases := map[string]struct {
+		objects []client.Object
+		event   func(context.Context, *workloadDependencyHandler, workqueue.TypedRateLimitingInterface[reconcile.Request])
+		want    []types.NamespacedName
+	}{
+		"dependency finished": {
+			objects: []client.Object{finishedPrepare},
+			event: func(ctx context.Context, h *workloadDependencyHandler, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Update(ctx, event.TypedUpdateEvent[*kueue.Workload]{ObjectOld: prepare, ObjectNew: finishedPrepare}, q)
+			},
+			want: []types.NamespacedName{
+				{Namespace: "ns", Name: "evaluate"},
+				{Namespace: "ns", Name: "train"},
+			},
+		},
+		"dependency updated without finishing": {
+			objects: []client.Object{prepare},
+			event: func(ctx context.Context, h *workloadDependencyHandler, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Update(ctx, event.TypedUpdateEvent[*kueue.Workload]{ObjectOld: prepare, ObjectNew: prepare}, q)
+			},
+		},
+		"dependency deleted before finishing": {
+			event: func(ctx context.Context, h *workloadDependencyHandler, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Delete(ctx, event.TypedDeleteEvent[*kueue.Workload]{Object: prepare}, q)
+			},
+			want: []types.NamespacedName{
+				{Namespace: "ns", Name: "evaluate"},
+				{Namespace: "ns", Name: "train"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, true)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			objects := append(tc.objects,
+				utiltestingapi.MakeWorkload("train", "ns").
+					Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+					Obj(),
+				utiltestingapi.MakeWorkload("evaluate", "ns").
+					Dependency(kueue.WorkloadDependency{
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prepare"}},
+					}).
+					Obj(),
+				utiltestingapi.MakeWorkload("report", "ns").
+					Dependency(kueue.WorkloadDependency{Name: ptr.To("evaluate")}).
+					Obj(),
+				utiltestingapi.MakeWorkload("other", "other-ns").
+					Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+					Obj(),
+			)
+			cl := utiltesting.NewClientBuilder().
+				WithIndex(&kueue.Workload{}, indexer.WorkloadHasDependenciesKey, indexer.IndexWorkloadHasDependencies).
+				WithObjects(objects...).
+				Build()
+			h := &workloadDependencyHandler{r: NewWorkloadDependencyReconciler(cl, nil, nil)}
+			q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			t.Cleanup(q.ShutDown)
+
+			tc.event(ctx, h, q)
+
+			var got []types.NamespacedName
+			for q.Len() > 0 {
+				item, _ := q.Get()
+				got = append(got, item.NamespacedName)
+				q.Done(item)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b types.NamespacedName) bool { return a.String() < b.String() })); diff != "" {
+				t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
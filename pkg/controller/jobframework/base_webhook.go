@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -54,6 +55,9 @@ func BaseWebhookFactory[T runtime.Object](obj T, fromObject func(T) GenericJob)
 			Queues:                       options.Queues,
 			Cache:                        options.Cache,
 		}
+		if u, isUnstructured := any(obj).(*unstructured.Unstructured); isUnstructured {
+			RegisterUnstructuredType(mgr.GetScheme(), u.GroupVersionKind())
+		}
 		if options.NoopWebhook {
 			return webhook.SetupNoopWebhook(mgr, obj)
 		}
@@ -65,6 +69,15 @@ func BaseWebhookFactory[T runtime.Object](obj T, fromObject func(T) GenericJob)
 	}
 }
 
+// RegisterUnstructuredType registers the GVK of a resource handled as
+// unstructured objects in the scheme, unless the scheme already knows it.
+// The webhook builder needs the scheme to know the GVK of its API type.
+func RegisterUnstructuredType(scheme *runtime.Scheme, gvk schema.GroupVersionKind) {
+	if !scheme.Recognizes(gvk) {
+		scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+	}
+}
+
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the type
 func (w *BaseWebhook[T]) Default(ctx context.Context, obj T) error {
 	job := w.FromObject(obj)
@@ -75,6 +88,7 @@ func (w *BaseWebhook[T]) Default(ctx context.Context, obj T) error {
 		return err
 	}
 	ApplyDefaultForManagedBy(job, w.Queues, w.Cache, log)
+	ApplyDefaultForWorkloadSlice(job)
 	return nil
 }
 
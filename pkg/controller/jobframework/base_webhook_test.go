@@ -18,14 +18,19 @@ package jobframework_test
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -353,3 +358,52 @@ func TestValidateOnUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterUnstructuredType(t *testing.T) {
+	pipelineRunGVK := schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "PipelineRun"}
+	testcases := map[string]struct {
+		gvk            schema.GroupVersionKind
+		registerBefore bool
+		wantType       runtime.Object
+	}{
+		"unknown type is registered as unstructured": {
+			gvk:      pipelineRunGVK,
+			wantType: &unstructured.Unstructured{},
+		},
+		"type registered twice": {
+			gvk:            pipelineRunGVK,
+			registerBefore: true,
+			wantType:       &unstructured.Unstructured{},
+		},
+		"known type is kept": {
+			gvk:      batchv1.SchemeGroupVersion.WithKind("Job"),
+			wantType: &batchv1.Job{},
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			cl := utiltesting.NewClientBuilder().Build()
+			if tc.registerBefore {
+				jobframework.RegisterUnstructuredType(cl.Scheme(), tc.gvk)
+			}
+
+			jobframework.RegisterUnstructuredType(cl.Scheme(), tc.gvk)
+
+			got, err := cl.Scheme().New(tc.gvk)
+			if err != nil {
+				t.Fatalf("Unexpected error creating %v: %v", tc.gvk, err)
+			}
+			if gotType, wantType := reflect.TypeOf(got), reflect.TypeOf(tc.wantType); gotType != wantType {
+				t.Errorf("Unexpected type of %v: want %v, got %v", tc.gvk, wantType, gotType)
+			}
+
+			// The webhook builder resolves the GVK of its API type from the scheme.
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(tc.gvk)
+			mgr := utiltesting.NewManager(t, cl)
+			if err := ctrl.NewWebhookManagedBy(mgr, obj).Complete(); err != nil {
+				t.Errorf("Unexpected error setting up the webhook: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	checkpointHookTimeout     = 5 * time.Second
+	checkpointHookParallelism = 8
+)
+
+// checkpointHookRequest is a request to call the checkpoint HTTP hook of a pod.
+type checkpointHookRequest struct {
+	pod types.NamespacedName
+	url string
+}
+
+// checkpointHookCaller calls the checkpoint HTTP hooks of the pods in the
+// background, so that the slow or unreachable pods don't hold the
+// reconciliation of the jobs.
+type checkpointHookCaller struct {
+	queue  workqueue.TypedInterface[checkpointHookRequest]
+	client *http.Client
+}
+
+func newCheckpointHookCaller() *checkpointHookCaller {
+	return &checkpointHookCaller{
+		queue:  workqueue.NewTyped[checkpointHookRequest](),
+		client: &http.Client{Timeout: checkpointHookTimeout},
+	}
+}
+
+// Start calls the queued hooks until the context is done.
+func (c *checkpointHookCaller) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("checkpoint-hook-caller")
+	var wg sync.WaitGroup
+	for range checkpointHookParallelism {
+		wg.Go(func() {
+			for c.processNext(ctx, log) {
+			}
+		})
+	}
+	<-ctx.Done()
+	c.queue.ShutDown()
+	wg.Wait()
+	return nil
+}
+
+// NeedLeaderElection returns true, as only the leader requests the checkpoints.
+func (c *checkpointHookCaller) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *checkpointHookCaller) enqueue(req checkpointHookRequest) {
+	c.queue.Add(req)
+}
+
+// processNext calls the next queued hook. The failures are only logged, as
+// the job is stopped after the grace period anyway.
+func (c *checkpointHookCaller) processNext(ctx context.Context, log logr.Logger) bool {
+	req, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(req)
+	if err := c.call(ctx, req.url); err != nil {
+		log.V(2).Info("Failed to call the checkpoint HTTP hook", "pod", req.pod, "url", req.url, "error", err)
+	}
+	return true
+}
+
+func (c *checkpointHookCaller) call(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// checkpointGracePeriod returns the grace period for the checkpoint of the job,
+// or false when the job is not opted in to the checkpoint-aware eviction.
+func checkpointGracePeriod(obj client.Object) (time.Duration, bool, error) {
+	value, found := obj.GetAnnotations()[controllerconsts.CheckpointGracePeriodAnnotation]
+	if !found {
+		return 0, false, nil
+	}
+	gracePeriod, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, false, err
+	}
+	if gracePeriod <= 0 {
+		return 0, false, fmt.Errorf("must be positive, got %s", value)
+	}
+	return gracePeriod, true, nil
+}
+
+// parseCheckpointHTTPHook returns the port and the path of the checkpoint hook
+// given in the "<port>/<path>" format.
+func parseCheckpointHTTPHook(value string) (string, string, error) {
+	port, path, _ := strings.Cut(value, "/")
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", "", fmt.Errorf("expected the <port>/<path> format with a valid port number, got %q", value)
+	}
+	return port, "/" + path, nil
+}
+
+// waitForCheckpoint implements the checkpoint-aware eviction. When the job is
+// opted in, it signals the imminent eviction to the job on the first call, and
+// returns the time to wait for the acknowledgement of the checkpoint, or zero
+// when the job can be stopped.
+func (r *JobReconciler) waitForCheckpoint(ctx context.Context, job GenericJob, wl *kueue.Workload, evCond *metav1.Condition) (time.Duration, error) {
+	if !features.Enabled(features.CheckpointAwareEviction) || job.IsSuspended() {
+		return 0, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	object := job.Object()
+	gracePeriod, optedIn, err := checkpointGracePeriod(object)
+	if err != nil {
+		log.Error(err, "Ignoring the invalid checkpoint grace period")
+		return 0, nil
+	}
+	if !optedIn {
+		return 0, nil
+	}
+	gracePeriod = min(gracePeriod, r.checkpointMaxGracePeriod)
+
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadCheckpointRequested)
+	if cond == nil || cond.LastTransitionTime.Before(&evCond.LastTransitionTime) {
+		if err := r.requestCheckpoint(ctx, job, wl, evCond, gracePeriod); err != nil {
+			return 0, err
+		}
+		return gracePeriod, nil
+	}
+	if cond.Status != metav1.ConditionTrue {
+		return 0, nil
+	}
+
+	if _, completed := object.GetAnnotations()[controllerconsts.CheckpointCompleteAnnotation]; completed {
+		log.V(3).Info("Checkpoint completed, stopping the job")
+		return 0, r.setCheckpointRequestedCondition(ctx, wl, metav1.ConditionFalse, kueue.WorkloadCheckpointCompleted,
+			"The job acknowledged the checkpoint")
+	}
+	if remaining := gracePeriod - r.clock.Since(cond.LastTransitionTime.Time); remaining > 0 {
+		log.V(3).Info("Waiting for the checkpoint", "remaining", remaining)
+		return remaining, nil
+	}
+	msg := fmt.Sprintf("The checkpoint was not completed within %s", gracePeriod)
+	r.record.Event(object, corev1.EventTypeWarning, ReasonCheckpointTimeout, msg+", stopping the job")
+	return 0, r.setCheckpointRequestedCondition(ctx, wl, metav1.ConditionFalse, kueue.WorkloadCheckpointTimeout, msg)
+}
+
+// requestCheckpoint signals the imminent eviction by the annotation on the job
+// and its pods, and by the optional HTTP hook, and records the request in the
+// CheckpointRequested condition of the workload.
+func (r *JobReconciler) requestCheckpoint(ctx context.Context, job GenericJob, wl *kueue.Workload, evCond *metav1.Condition, gracePeriod time.Duration) error {
+	object := job.Object()
+	requestedAt := r.clock.Now().UTC().Format(time.RFC3339)
+	if err := clientutil.Patch(ctx, r.client, object, func() (bool, error) {
+		annotations := object.GetAnnotations()
+		delete(annotations, controllerconsts.CheckpointCompleteAnnotation)
+		annotations[controllerconsts.CheckpointRequestedAnnotation] = requestedAt
+		object.SetAnnotations(annotations)
+		return true, nil
+	}); err != nil {
+		return fmt.Errorf("annotating the job: %w", err)
+	}
+
+	if jobWithSelector, ok := job.(JobWithPodLabelSelector); ok {
+		if err := r.signalCheckpointToPods(ctx, object, jobWithSelector.PodLabelSelector(), requestedAt); err != nil {
+			return err
+		}
+	}
+
+	msg := fmt.Sprintf("Checkpoint requested before the eviction: %s", evCond.Message)
+	if err := r.setCheckpointRequestedCondition(ctx, wl, metav1.ConditionTrue, kueue.WorkloadCheckpointPending, msg); err != nil {
+		return err
+	}
+	r.record.Eventf(object, corev1.EventTypeNormal, ReasonCheckpointRequested,
+		"Waiting up to %s for the checkpoint before stopping the job", gracePeriod)
+	return nil
+}
+
+// signalCheckpointToPods annotates the running pods of the job and queues
+// the calls of their checkpoint HTTP hook, when configured.
+func (r *JobReconciler) signalCheckpointToPods(ctx context.Context, object client.Object, podLabelSelector, requestedAt string) error {
+	log := ctrl.LoggerFrom(ctx)
+	selector, err := labels.Parse(podLabelSelector)
+	if err != nil {
+		return fmt.Errorf("parsing the pod label selector: %w", err)
+	}
+	var pods corev1.PodList
+	if err := r.client.List(ctx, &pods, client.InNamespace(object.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("listing the pods: %w", err)
+	}
+
+	var running []*corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if utilpod.IsTerminated(pod) || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := clientutil.Patch(ctx, r.client, pod, func() (bool, error) {
+			if pod.Annotations == nil {
+				pod.Annotations = make(map[string]string, 1)
+			}
+			pod.Annotations[controllerconsts.CheckpointRequestedAnnotation] = requestedAt
+			return true, nil
+		}); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("annotating the pod %s: %w", pod.Name, err)
+		}
+		if pod.Status.PodIP != "" {
+			running = append(running, pod)
+		}
+	}
+
+	hook, found := object.GetAnnotations()[controllerconsts.CheckpointHTTPHookAnnotation]
+	if !found {
+		return nil
+	}
+	port, path, err := parseCheckpointHTTPHook(hook)
+	if err != nil {
+		log.Error(err, "Ignoring the invalid checkpoint HTTP hook")
+		return nil
+	}
+	if r.checkpointHooks == nil {
+		log.V(2).Info("Skipping the checkpoint HTTP hook, as its caller is not running")
+		return nil
+	}
+	for _, pod := range running {
+		r.checkpointHooks.enqueue(checkpointHookRequest{
+			pod: client.ObjectKeyFromObject(pod),
+			url: fmt.Sprintf("http://%s%s", net.JoinHostPort(pod.Status.PodIP, port), path),
+		})
+	}
+	return nil
+}
+
+func (r *JobReconciler) setCheckpointRequestedCondition(ctx context.Context, wl *kueue.Workload, status metav1.ConditionStatus, reason, msg string) error {
+	return workload.SetConditionAndUpdate(ctx, r.client, wl, kueue.WorkloadCheckpointRequested, status, reason, msg, constants.JobControllerName, r.clock)
+}
+
+// clearCheckpointAnnotations removes the annotations of the previous checkpoint
+// request from the job, so that they don't affect its next eviction.
+func clearCheckpointAnnotations(object client.Object) {
+	annotations := object.GetAnnotations()
+	delete(annotations, controllerconsts.CheckpointRequestedAnnotation)
+	delete(annotations, controllerconsts.CheckpointCompleteAnnotation)
+	object.SetAnnotations(annotations)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCheckpointHookCaller(t *testing.T) {
+	var mu sync.Mutex
+	var gotPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodPost {
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		gotPaths = append(gotPaths, r.URL.Path)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	ctx, cancel := context.WithCancel(ctx)
+	caller := newCheckpointHookCaller()
+	done := make(chan error)
+	go func() {
+		done <- caller.Start(ctx)
+	}()
+
+	caller.enqueue(checkpointHookRequest{pod: types.NamespacedName{Namespace: "ns", Name: "pod1"}, url: server.URL + "/fail"})
+	caller.enqueue(checkpointHookRequest{pod: types.NamespacedName{Namespace: "ns", Name: "pod2"}, url: server.URL + "/checkpoint"})
+
+	wantPaths := []string{"/checkpoint", "/fail"}
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(gotPaths) == len(wantPaths), nil
+	}); err != nil {
+		t.Fatalf("Waiting for the hooks to be called: %v", err)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Unexpected error from Start: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if diff := cmp.Diff(wantPaths, gotPaths, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Unexpected calls of the hooks (-want,+got):\n%s", diff)
+	}
+}
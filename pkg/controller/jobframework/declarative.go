@@ -0,0 +1,401 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/podset"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+var (
+	defaultSuspendPath    = fieldpath.Path{"spec", "suspend"}
+	defaultConditionsPath = fieldpath.Path{"status", "conditions"}
+
+	errTemplateNotFound   = errors.New("pod template not found")
+	errReplicasOutOfRange = errors.New("replicas out of range")
+)
+
+// declarativeFramework is a custom resource managed through the paths of its
+// fields, as declared in the Config API.
+type declarativeFramework struct {
+	gvk                    schema.GroupVersionKind
+	suspendPath            fieldpath.Path
+	podSets                []declarativePodSet
+	conditionsPath         fieldpath.Path
+	activeConditionType    string
+	succeededConditionType string
+	failedConditionType    string
+}
+
+type declarativePodSet struct {
+	name             kueue.PodSetReference
+	templatePath     fieldpath.Path
+	replicasPath     fieldpath.Path
+	nodeSelectorPath fieldpath.Path
+}
+
+func newDeclarativeFramework(cfg configapi.DeclarativeFramework) (*declarativeFramework, error) {
+	gvk, _ := schema.ParseKindArg(cfg.Name)
+	if gvk == nil {
+		return nil, fmt.Errorf("%w %q", errFrameworkNameFormat, cfg.Name)
+	}
+	f := &declarativeFramework{
+		gvk:                    *gvk,
+		suspendPath:            defaultSuspendPath,
+		conditionsPath:         defaultConditionsPath,
+		activeConditionType:    cfg.ActiveConditionType,
+		succeededConditionType: cfg.SucceededConditionType,
+		failedConditionType:    cfg.FailedConditionType,
+	}
+	var err error
+	if cfg.SuspendPath != "" {
+		if f.suspendPath, err = fieldpath.Parse(cfg.SuspendPath); err != nil {
+			return nil, fmt.Errorf("%s: suspendPath: %w", cfg.Name, err)
+		}
+	}
+	if cfg.ConditionsPath != "" {
+		if f.conditionsPath, err = fieldpath.Parse(cfg.ConditionsPath); err != nil {
+			return nil, fmt.Errorf("%s: conditionsPath: %w", cfg.Name, err)
+		}
+	}
+	for _, ps := range cfg.PodSets {
+		podSet := declarativePodSet{name: kueue.NewPodSetReference(ps.Name)}
+		if podSet.templatePath, err = fieldpath.Parse(ps.TemplatePath); err != nil {
+			return nil, fmt.Errorf("%s: podSet %q: templatePath: %w", cfg.Name, ps.Name, err)
+		}
+		if ps.ReplicasPath != "" {
+			if podSet.replicasPath, err = fieldpath.Parse(ps.ReplicasPath); err != nil {
+				return nil, fmt.Errorf("%s: podSet %q: replicasPath: %w", cfg.Name, ps.Name, err)
+			}
+		}
+		podSet.nodeSelectorPath = append(slices.Clone(podSet.templatePath), "spec", "nodeSelector")
+		if ps.NodeSelectorPath != "" {
+			if podSet.nodeSelectorPath, err = fieldpath.Parse(ps.NodeSelectorPath); err != nil {
+				return nil, fmt.Errorf("%s: podSet %q: nodeSelectorPath: %w", cfg.Name, ps.Name, err)
+			}
+		}
+		f.podSets = append(f.podSets, podSet)
+	}
+	return f, nil
+}
+
+func (f *declarativeFramework) newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(f.gvk)
+	return obj
+}
+
+func (f *declarativeFramework) newJob() GenericJob {
+	return f.fromObject(f.newObject())
+}
+
+func (f *declarativeFramework) fromObject(obj *unstructured.Unstructured) GenericJob {
+	return &declarativeJob{Unstructured: obj, framework: f}
+}
+
+func (f *declarativeFramework) integrationCallbacks() IntegrationCallbacks {
+	return IntegrationCallbacks{
+		SetupIndexes: func(ctx context.Context, indexer client.FieldIndexer) error {
+			return SetupWorkloadOwnerIndex(ctx, indexer, f.gvk)
+		},
+		NewJob:        f.newJob,
+		NewReconciler: f.newReconciler,
+		SetupWebhook:  BaseWebhookFactory(f.newObject(), f.fromObject),
+		JobType:       f.newObject(),
+	}
+}
+
+// registerDeclarative registers the integrations of the declarative frameworks
+// which are not registered yet, and returns their names.
+func (m *integrationManager) registerDeclarative(frameworks []configapi.DeclarativeFramework) (sets.Set[string], error) {
+	names := sets.New[string]()
+	for _, cfg := range frameworks {
+		names.Insert(cfg.Name)
+		if _, found := m.get(cfg.Name); found {
+			continue
+		}
+		f, err := newDeclarativeFramework(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.register(cfg.Name, f.integrationCallbacks()); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+type declarativeReconciler struct {
+	jr        *JobReconciler
+	framework *declarativeFramework
+}
+
+func (f *declarativeFramework) newReconciler(_ context.Context, client client.Client, _ client.FieldIndexer, eventRecorder record.EventRecorder, opts ...Option) (JobReconcilerInterface, error) {
+	return &declarativeReconciler{
+		jr:        NewReconciler(client, eventRecorder, opts...),
+		framework: f,
+	}, nil
+}
+
+func (r *declarativeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, r.framework.newJob())
+}
+
+func (r *declarativeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	controllerName := strings.ToLower(r.framework.gvk.Kind + "." + r.framework.gvk.Group)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.framework.newObject()).
+		Owns(&kueue.Workload{}).
+		Named(controllerName).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), controllerName),
+		}).
+		Complete(r)
+}
+
+// declarativeJob wraps an unstructured custom resource of a declarative framework.
+type declarativeJob struct {
+	*unstructured.Unstructured
+	framework *declarativeFramework
+}
+
+var _ GenericJob = (*declarativeJob)(nil)
+
+func (j *declarativeJob) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *declarativeJob) GVK() schema.GroupVersionKind {
+	return j.framework.gvk
+}
+
+func (j *declarativeJob) IsSuspended() bool {
+	suspend, _, _ := unstructured.NestedBool(j.Unstructured.Object, j.framework.suspendPath...)
+	return suspend
+}
+
+func (j *declarativeJob) Suspend() {
+	_ = unstructured.SetNestedField(j.Unstructured.Object, true, j.framework.suspendPath...)
+}
+
+// IsActive returns true if the active condition is true, that is while the
+// pods of the job are running, including the ones terminating after the job
+// is suspended.
+func (j *declarativeJob) IsActive() bool {
+	_, active := j.condition(j.framework.activeConditionType)
+	return active
+}
+
+func (j *declarativeJob) PodsReady(context.Context) bool {
+	return j.IsActive()
+}
+
+func (j *declarativeJob) Finished(context.Context) (message string, success, finished bool) {
+	if message, succeeded := j.condition(j.framework.succeededConditionType); succeeded {
+		return message, true, true
+	}
+	if message, failed := j.condition(j.framework.failedConditionType); failed {
+		return message, false, true
+	}
+	return "", false, false
+}
+
+// condition returns the message of the condition and whether it is true.
+func (j *declarativeJob) condition(conditionType string) (string, bool) {
+	if conditionType == "" {
+		return "", false
+	}
+	conditions, _, _ := unstructured.NestedFieldNoCopy(j.Unstructured.Object, j.framework.conditionsPath...)
+	list, _ := conditions.([]any)
+	for _, c := range list {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+		message, _ := condition["message"].(string)
+		return message, condition["status"] == string(metav1.ConditionTrue)
+	}
+	return "", false
+}
+
+func (j *declarativeJob) PodSets(context.Context) ([]kueue.PodSet, error) {
+	podSets := make([]kueue.PodSet, 0, len(j.framework.podSets))
+	for _, ps := range j.framework.podSets {
+		template, err := j.podTemplate(ps)
+		if err != nil {
+			return nil, fmt.Errorf("podSet %q: %w", ps.name, err)
+		}
+		count, err := j.replicas(ps)
+		if err != nil {
+			return nil, fmt.Errorf("podSet %q: %w", ps.name, err)
+		}
+		topologyRequest, err := NewPodSetTopologyRequest(&template.ObjectMeta).Build()
+		if err != nil {
+			return nil, fmt.Errorf("podSet %q: %w", ps.name, err)
+		}
+		podSets = append(podSets, kueue.PodSet{
+			Name:            ps.name,
+			Count:           count,
+			Template:        *template,
+			TopologyRequest: topologyRequest,
+		})
+	}
+	return podSets, nil
+}
+
+func (j *declarativeJob) podTemplate(ps declarativePodSet) (*corev1.PodTemplateSpec, error) {
+	templateMap, found, err := unstructured.NestedMap(j.Unstructured.Object, ps.templatePath...)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w at %s", errTemplateNotFound, ps.templatePath)
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateMap, template); err != nil {
+		return nil, err
+	}
+	nodeSelector, found, err := unstructured.NestedStringMap(j.Unstructured.Object, ps.nodeSelectorPath...)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		template.Spec.NodeSelector = nodeSelector
+	}
+	return template, nil
+}
+
+func (j *declarativeJob) replicas(ps declarativePodSet) (int32, error) {
+	if len(ps.replicasPath) == 0 {
+		return 1, nil
+	}
+	replicas, found, err := unstructured.NestedInt64(j.Unstructured.Object, ps.replicasPath...)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 1, nil
+	}
+	if replicas < 0 || replicas > math.MaxInt32 {
+		return 0, fmt.Errorf("%w: %d at %s", errReplicasOutOfRange, replicas, ps.replicasPath)
+	}
+	return int32(replicas), nil
+}
+
+func (j *declarativeJob) RunWithPodSetsInfo(_ context.Context, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != len(j.framework.podSets) {
+		return podset.BadPodSetsInfoLenError(len(j.framework.podSets), len(podSetsInfo))
+	}
+	if err := unstructured.SetNestedField(j.Unstructured.Object, false, j.framework.suspendPath...); err != nil {
+		return err
+	}
+	for i, ps := range j.framework.podSets {
+		meta, podSpec, err := j.podMeta(ps)
+		if err != nil {
+			return err
+		}
+		if err := podset.Merge(meta, podSpec, podSetsInfo[i]); err != nil {
+			return err
+		}
+		if err := j.setPodMeta(ps, meta, podSpec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *declarativeJob) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != len(j.framework.podSets) {
+		return false
+	}
+	changed := false
+	for i, ps := range j.framework.podSets {
+		meta, podSpec, err := j.podMeta(ps)
+		if err != nil {
+			continue
+		}
+		if podset.RestorePodSpec(meta, podSpec, podSetsInfo[i]) {
+			changed = j.setPodMeta(ps, meta, podSpec) == nil || changed
+		}
+	}
+	return changed
+}
+
+// podMeta returns the pod metadata and the scheduling fields of the pod
+// template, with the node selector read from its declared path.
+func (j *declarativeJob) podMeta(ps declarativePodSet) (*metav1.ObjectMeta, *corev1.PodSpec, error) {
+	template, err := j.podTemplate(ps)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := &metav1.ObjectMeta{
+		Labels:      template.Labels,
+		Annotations: template.Annotations,
+	}
+	spec := &corev1.PodSpec{
+		NodeSelector:    template.Spec.NodeSelector,
+		Tolerations:     template.Spec.Tolerations,
+		SchedulingGates: template.Spec.SchedulingGates,
+	}
+	return meta, spec, nil
+}
+
+// setPodMeta sets the pod metadata and the scheduling fields of the pod
+// template, and the node selector at its declared path.
+func (j *declarativeJob) setPodMeta(ps declarativePodSet, meta *metav1.ObjectMeta, podSpec *corev1.PodSpec) error {
+	obj := j.Unstructured.Object
+	for path, value := range map[string]map[string]string{
+		"labels":      meta.Labels,
+		"annotations": meta.Annotations,
+	} {
+		if err := SetNestedStringMap(obj, value, append(slices.Clone(ps.templatePath), "metadata", path)...); err != nil {
+			return err
+		}
+	}
+	metadataPath := append(slices.Clone(ps.templatePath), "metadata")
+	if metadata, found, _ := unstructured.NestedMap(obj, metadataPath...); found && len(metadata) == 0 {
+		unstructured.RemoveNestedField(obj, metadataPath...)
+	}
+	if err := SetNestedStringMap(obj, podSpec.NodeSelector, ps.nodeSelectorPath...); err != nil {
+		return err
+	}
+	if err := SetNestedSlice(obj, podSpec.Tolerations, append(slices.Clone(ps.templatePath), "spec", "tolerations")...); err != nil {
+		return err
+	}
+	return SetNestedSlice(obj, podSpec.SchedulingGates, append(slices.Clone(ps.templatePath), "spec", "schedulingGates")...)
+}
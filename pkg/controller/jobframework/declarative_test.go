@@ -0,0 +1,393 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/podset"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+var testDeclarativeFramework = configapi.DeclarativeFramework{
+	Name:        "TrainingRun.v1.example.com",
+	SuspendPath: ".spec.paused",
+	PodSets: []configapi.DeclarativePodSet{
+		{Name: "launcher", TemplatePath: ".spec.launcher.template"},
+		{
+			Name:             "worker",
+			TemplatePath:     ".spec.worker.template",
+			ReplicasPath:     ".spec.worker.replicas",
+			NodeSelectorPath: ".spec.worker.nodeSelector",
+		},
+	},
+	ActiveConditionType:    "Running",
+	SucceededConditionType: "Succeeded",
+	FailedConditionType:    "Failed",
+}
+
+func testTrainingRun(t *testing.T, fields map[string]any) *declarativeJob {
+	t.Helper()
+	f, err := newDeclarativeFramework(testDeclarativeFramework)
+	if err != nil {
+		t.Fatalf("Failed to parse the declarative framework: %v", err)
+	}
+	job := f.newJob().(*declarativeJob)
+	job.Unstructured.Object = map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "TrainingRun",
+		"metadata":   map[string]any{"name": "run", "namespace": "ns"},
+	}
+	for path, value := range fields {
+		fp, err := fieldpath.Parse(path)
+		if err != nil {
+			t.Fatalf("Failed to parse %q: %v", path, err)
+		}
+		if err := unstructured.SetNestedField(job.Unstructured.Object, value, fp...); err != nil {
+			t.Fatalf("Failed to set %q: %v", path, err)
+		}
+	}
+	return job
+}
+
+func testContainer() map[string]any {
+	return map[string]any{
+		"name":      "c",
+		"image":     "pause",
+		"resources": map[string]any{"requests": map[string]any{"cpu": "1"}},
+	}
+}
+
+func TestNewDeclarativeFramework(t *testing.T) {
+	cases := map[string]struct {
+		cfg     configapi.DeclarativeFramework
+		want    *declarativeFramework
+		wantErr error
+	}{
+		"defaults": {
+			cfg: configapi.DeclarativeFramework{
+				Name:                   "TrainingRun.v1.example.com",
+				PodSets:                []configapi.DeclarativePodSet{{Name: "main", TemplatePath: ".spec.template"}},
+				SucceededConditionType: "Succeeded",
+			},
+			want: &declarativeFramework{
+				gvk:         schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "TrainingRun"},
+				suspendPath: defaultSuspendPath,
+				podSets: []declarativePodSet{{
+					name:             "main",
+					templatePath:     []string{"spec", "template"},
+					nodeSelectorPath: []string{"spec", "template", "spec", "nodeSelector"},
+				}},
+				conditionsPath:         defaultConditionsPath,
+				succeededConditionType: "Succeeded",
+			},
+		},
+		"invalid name": {
+			cfg:     configapi.DeclarativeFramework{Name: "invalid"},
+			wantErr: errFrameworkNameFormat,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := newDeclarativeFramework(tc.cfg)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(declarativeFramework{}, declarativePodSet{})); diff != "" {
+				t.Errorf("Unexpected framework (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeclarativeJobPodSets(t *testing.T) {
+	job := testTrainingRun(t, map[string]any{
+		".spec.launcher.template": map[string]any{
+			"spec": map[string]any{"containers": []any{testContainer()}},
+		},
+		".spec.worker.template": map[string]any{
+			"metadata": map[string]any{"labels": map[string]any{"role": "worker"}},
+			"spec":     map[string]any{"containers": []any{testContainer()}},
+		},
+		".spec.worker.replicas":     int64(3),
+		".spec.worker.nodeSelector": map[string]any{"pool": "gpu"},
+	})
+	ctx, _ := utiltesting.ContextWithLog(t)
+	got, err := job.PodSets(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	container := corev1.Container{
+		Name:  "c",
+		Image: "pause",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+	want := []kueue.PodSet{
+		{
+			Name:  "launcher",
+			Count: 1,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{container}},
+			},
+		},
+		{
+			Name:  "worker",
+			Count: 3,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"role": "worker"}},
+				Spec: corev1.PodSpec{
+					Containers:   []corev1.Container{container},
+					NodeSelector: map[string]string{"pool": "gpu"},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected PodSets (-want,+got):\n%s", diff)
+	}
+
+	if _, err := testTrainingRun(t, nil).PodSets(ctx); err == nil {
+		t.Error("Expected an error for a missing pod template")
+	}
+
+	job = testTrainingRun(t, map[string]any{
+		".spec.launcher.template": map[string]any{
+			"spec": map[string]any{"containers": []any{testContainer()}},
+		},
+		".spec.worker.template": map[string]any{
+			"spec": map[string]any{"containers": []any{testContainer()}},
+		},
+		".spec.worker.replicas": int64(math.MaxInt32 + 1),
+	})
+	if _, err := job.PodSets(ctx); !errors.Is(err, errReplicasOutOfRange) {
+		t.Errorf("Unexpected error for replicas out of range, want: %v, got: %v", errReplicasOutOfRange, err)
+	}
+}
+
+func TestDeclarativeWebhookDefault(t *testing.T) {
+	cases := map[string]struct {
+		labels         map[string]any
+		defaultLqExist bool
+		wantLabels     map[string]any
+		wantSuspended  bool
+	}{
+		"with queue name": {
+			labels:        map[string]any{constants.QueueLabel: "queue"},
+			wantLabels:    map[string]any{constants.QueueLabel: "queue"},
+			wantSuspended: true,
+		},
+		"with default local queue": {
+			defaultLqExist: true,
+			wantLabels:     map[string]any{constants.QueueLabel: string(constants.DefaultLocalQueueName)},
+			wantSuspended:  true,
+		},
+		"without queue name": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().Build()
+			queueManager := qcache.NewManagerForUnitTests(cl, schdcache.New(cl))
+			if tc.defaultLqExist {
+				if err := queueManager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue("default", "ns").
+					ClusterQueue("cluster-queue").Obj()); err != nil {
+					t.Fatalf("Failed to create the default local queue: %v", err)
+				}
+			}
+			job := testTrainingRun(t, map[string]any{".metadata.labels": tc.labels})
+			if tc.labels == nil {
+				unstructured.RemoveNestedField(job.Unstructured.Object, "metadata", "labels")
+			}
+			w := &BaseWebhook[*unstructured.Unstructured]{
+				Client:     cl,
+				FromObject: job.framework.fromObject,
+				Queues:     queueManager,
+			}
+			if err := w.Default(ctx, job.Unstructured); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			labels, _, _ := unstructured.NestedMap(job.Unstructured.Object, "metadata", "labels")
+			if diff := cmp.Diff(tc.wantLabels, labels, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected labels (-want,+got):\n%s", diff)
+			}
+			if got := job.IsSuspended(); got != tc.wantSuspended {
+				t.Errorf("Unexpected IsSuspended, want: %v, got: %v", tc.wantSuspended, got)
+			}
+		})
+	}
+}
+
+func TestDeclarativeJobRunAndRestore(t *testing.T) {
+	job := testTrainingRun(t, map[string]any{
+		".spec.paused": true,
+		".spec.launcher.template": map[string]any{
+			"spec": map[string]any{"containers": []any{testContainer()}},
+		},
+		".spec.worker.template": map[string]any{
+			"spec": map[string]any{"containers": []any{testContainer()}},
+		},
+		".spec.worker.nodeSelector": map[string]any{"pool": "gpu"},
+	})
+	original := job.DeepCopy()
+	ctx, _ := utiltesting.ContextWithLog(t)
+
+	podSets, err := job.PodSets(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	restoreInfo := make([]podset.PodSetInfo, 0, len(podSets))
+	for i := range podSets {
+		restoreInfo = append(restoreInfo, podset.FromPodSet(&podSets[i]))
+	}
+
+	err = job.RunWithPodSetsInfo(ctx, []podset.PodSetInfo{
+		{
+			Labels:       map[string]string{"kueue.x-k8s.io/podset": "launcher"},
+			NodeSelector: map[string]string{"flavor": "on-demand"},
+		},
+		{
+			NodeSelector: map[string]string{"flavor": "spot"},
+			Tolerations: []corev1.Toleration{{
+				Key:      "spot",
+				Operator: corev1.TolerationOpExists,
+				Effect:   corev1.TaintEffectNoSchedule,
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := testTrainingRun(t, map[string]any{
+		".spec.paused": false,
+		".spec.launcher.template": map[string]any{
+			"metadata": map[string]any{"labels": map[string]any{"kueue.x-k8s.io/podset": "launcher"}},
+			"spec": map[string]any{
+				"containers":   []any{testContainer()},
+				"nodeSelector": map[string]any{"flavor": "on-demand"},
+			},
+		},
+		".spec.worker.template": map[string]any{
+			"spec": map[string]any{
+				"containers": []any{testContainer()},
+				"tolerations": []any{map[string]any{
+					"key":      "spot",
+					"operator": "Exists",
+					"effect":   "NoSchedule",
+				}},
+			},
+		},
+		".spec.worker.nodeSelector": map[string]any{"pool": "gpu", "flavor": "spot"},
+	})
+	if diff := cmp.Diff(want.Unstructured.Object, job.Unstructured.Object); diff != "" {
+		t.Errorf("Unexpected object after run (-want,+got):\n%s", diff)
+	}
+
+	job.Suspend()
+	if !job.RestorePodSetsInfo(restoreInfo) {
+		t.Error("Expected the PodSets info to be restored")
+	}
+	if diff := cmp.Diff(original.Object, job.Unstructured.Object); diff != "" {
+		t.Errorf("Unexpected object after restore (-want,+got):\n%s", diff)
+	}
+}
+
+func TestDeclarativeJobStatus(t *testing.T) {
+	cases := map[string]struct {
+		fields        map[string]any
+		wantSuspended bool
+		wantActive    bool
+		wantFinished  bool
+		wantSuccess   bool
+		wantMessage   string
+	}{
+		"suspended": {
+			fields:        map[string]any{".spec.paused": true},
+			wantSuspended: true,
+		},
+		"suspended with terminating pods": {
+			fields: map[string]any{
+				".spec.paused":       true,
+				".status.conditions": []any{map[string]any{"type": "Running", "status": "True"}},
+			},
+			wantSuspended: true,
+			wantActive:    true,
+		},
+		"not suspended without the running condition": {
+			fields: map[string]any{".spec.paused": false},
+		},
+		"running": {
+			fields: map[string]any{
+				".status.conditions": []any{map[string]any{"type": "Running", "status": "True"}},
+			},
+			wantActive: true,
+		},
+		"succeeded": {
+			fields: map[string]any{
+				".status.conditions": []any{
+					map[string]any{"type": "Running", "status": "False"},
+					map[string]any{"type": "Succeeded", "status": "True", "message": "done"},
+				},
+			},
+			wantFinished: true,
+			wantSuccess:  true,
+			wantMessage:  "done",
+		},
+		"failed": {
+			fields: map[string]any{
+				".status.conditions": []any{map[string]any{"type": "Failed", "status": "True", "message": "oom"}},
+			},
+			wantFinished: true,
+			wantMessage:  "oom",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			job := testTrainingRun(t, tc.fields)
+			if got := job.IsSuspended(); got != tc.wantSuspended {
+				t.Errorf("Unexpected IsSuspended, want: %v, got: %v", tc.wantSuspended, got)
+			}
+			if got := job.IsActive(); got != tc.wantActive {
+				t.Errorf("Unexpected IsActive, want: %v, got: %v", tc.wantActive, got)
+			}
+			message, success, finished := job.Finished(ctx)
+			if finished != tc.wantFinished || success != tc.wantSuccess || message != tc.wantMessage {
+				t.Errorf("Unexpected Finished, want: (%q, %v, %v), got: (%q, %v, %v)",
+					tc.wantMessage, tc.wantSuccess, tc.wantFinished, message, success, finished)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
 )
 
@@ -117,3 +118,16 @@ func ApplyDefaultForManagedBy(job GenericJob, queues *qcache.Manager, cache *sch
 		}
 	}
 }
+
+// ApplyDefaultForWorkloadSlice adds the ElasticJobSchedulingGate to the pod
+// templates of a job opted in for workload slicing, so that the pods created
+// after scaling the job up wait for the admission of the new workload slice.
+func ApplyDefaultForWorkloadSlice(job GenericJob) {
+	elasticJob, ok := job.(JobWithElasticPodTemplates)
+	if !ok || !WorkloadSliceEnabled(job) {
+		return
+	}
+	for _, template := range elasticJob.ElasticPodTemplates() {
+		utilpod.GateTemplate(template, kueue.ElasticJobSchedulingGate)
+	}
+}
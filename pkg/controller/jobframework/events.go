@@ -29,4 +29,7 @@ const (
 	ReasonErrWorkloadCompose    = "ErrWorkloadCompose"
 	ReasonUpdatedAdmissionCheck = "UpdatedAdmissionCheck"
 	ReasonJobNestingTooDeep     = "JobNestingTooDeep"
+	ReasonCheckpointRequested   = "CheckpointRequested"
+	ReasonCheckpointTimeout     = "CheckpointTimeout"
+	ReasonScaledTasks           = "ScaledTasks"
 )
@@ -17,9 +17,12 @@ limitations under the License.
 package jobframework
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,6 +34,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/maps"
@@ -119,6 +123,28 @@ type JobWithCustomValidation interface {
 	ValidateOnUpdate(ctx context.Context, oldJob GenericJob) (field.ErrorList, error)
 }
 
+// JobWithElasticPodTemplates optional interface that should be implemented by
+// Jobs that use BaseWebhook and support workload slicing.
+type JobWithElasticPodTemplates interface {
+	// ElasticPodTemplates returns the pod templates of the job, which are gated
+	// until the workload slice of their pods is admitted.
+	ElasticPodTemplates() []*corev1.PodTemplateSpec
+}
+
+// JobWithTasks interface should be implemented by generic jobs which run
+// a number of independent tasks, one per pod, and can be admitted as bulk
+// workloads.
+type JobWithTasks interface {
+	// Tasks returns the number of independent tasks of the job, or nil if
+	// the job doesn't run independent tasks.
+	Tasks() *int32
+	// TasksStatus returns the aggregated status of the tasks of the job.
+	TasksStatus() kueue.WorkloadTasksStatus
+	// SetAdmittedTasks sets the number of tasks the job can run at once.
+	// Returns true if the job was changed.
+	SetAdmittedTasks(count int32) bool
+}
+
 // ComposableJob interface should be implemented by generic jobs that
 // are composed out of multiple API objects.
 type ComposableJob interface {
@@ -146,6 +172,16 @@ type ComposableJob interface {
 	EquivalentToWorkload(ctx context.Context, c client.Client, wl *kueue.Workload) (bool, error)
 }
 
+// ComposableJobWithWorkloadSlices interface should be implemented by composable jobs
+// which support workload slices. As the workload slices of such jobs aren't owned by
+// a single object, the jobs find them on their own. The slices are reconciled when
+// finding the matching workloads of the job.
+type ComposableJobWithWorkloadSlices interface {
+	ComposableJob
+	// FindNotFinishedWorkloadSlices returns the not finished workload slices of the job, sorted from the oldest.
+	FindNotFinishedWorkloadSlices(ctx context.Context, c client.Client) ([]kueue.Workload, error)
+}
+
 // JobWithCustomWorkloadConditions interface should be implemented by generic jobs,
 // when custom workload conditions should be updated after ensure that the workload exists.
 type JobWithCustomWorkloadConditions interface {
@@ -204,6 +240,35 @@ func MaximumExecutionTimeSecondsForObject(object client.Object) *int32 {
 	return ptr.To(int32(v))
 }
 
+// DependenciesForObject returns the dependencies of the Workload declared by
+// the annotation of the job, or nil when the WorkloadDependencies feature is
+// disabled or the annotation is invalid.
+func DependenciesForObject(object client.Object) []kueue.WorkloadDependency {
+	if !features.Enabled(features.WorkloadDependencies) {
+		return nil
+	}
+	dependencies, err := parseDependencies(object)
+	if err != nil {
+		return nil
+	}
+	return dependencies
+}
+
+func parseDependencies(object client.Object) ([]kueue.WorkloadDependency, error) {
+	value, found := object.GetAnnotations()[constants.DependenciesAnnotation]
+	if !found {
+		return nil, nil
+	}
+	var dependencies []kueue.WorkloadDependency
+	if err := json.Unmarshal([]byte(value), &dependencies); err != nil {
+		return nil, err
+	}
+	for i := range dependencies {
+		dependencies[i].Condition = cmp.Or(dependencies[i].Condition, kueue.WorkloadDependencySucceeded)
+	}
+	return dependencies, nil
+}
+
 func WorkloadPriorityClassName(object client.Object) string {
 	if workloadPriorityClassLabel := object.GetLabels()[constants.WorkloadPriorityClassLabel]; workloadPriorityClassLabel != "" {
 		return workloadPriorityClassLabel
@@ -223,16 +288,35 @@ func NewWorkload(name string, obj client.Object, podSets []kueue.PodSet, labelKe
 			Namespace:   obj.GetNamespace(),
 			Labels:      maps.FilterKeys(obj.GetLabels(), labelKeysToCopy),
 			Finalizers:  []string{kueue.ResourceInUseFinalizerName},
-			Annotations: admissioncheck.FilterProvReqAnnotations(obj.GetAnnotations()),
+			Annotations: workloadAnnotations(obj),
 		},
 		Spec: kueue.WorkloadSpec{
 			QueueName:                   QueueNameForObject(obj),
 			PodSets:                     podSets,
 			MaximumExecutionTimeSeconds: MaximumExecutionTimeSecondsForObject(obj),
+			Dependencies:                DependenciesForObject(obj),
 		},
 	}
 }
 
+// multiKueueClusterSelectorAnnotations are the annotations selecting the MultiKueue
+// worker clusters, propagated from the job to its Workload.
+var multiKueueClusterSelectorAnnotations = []string{
+	kueue.MultiKueueRequiredClusterSelectorAnnotation,
+	kueue.MultiKueuePreferredClusterSelectorAnnotation,
+}
+
+// workloadAnnotations returns the annotations of the job propagated to its Workload.
+func workloadAnnotations(obj client.Object) map[string]string {
+	annotations := admissioncheck.FilterProvReqAnnotations(obj.GetAnnotations())
+	for _, key := range multiKueueClusterSelectorAnnotations {
+		if value, found := obj.GetAnnotations()[key]; found {
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
+
 // MultiKueueAdapter interface needed for MultiKueue job delegation.
 type MultiKueueAdapter interface {
 	// SyncJob creates the Job object in the worker cluster using remote client, if not already created.
@@ -261,6 +345,13 @@ type MultiKueueWatcher interface {
 	WorkloadKeysFor(runtime.Object) ([]types.NamespacedName, error)
 }
 
+// MultiKueuePodsSelector is an optional interface for MultiKueue adapters
+// whose job's pods can be selected by labels in the worker cluster.
+type MultiKueuePodsSelector interface {
+	// RemotePodsSelector returns the labels of the pods of the job identified by key.
+	RemotePodsSelector(key types.NamespacedName) map[string]string
+}
+
 // MultiKueueMultiWorkloadAdapter is an optional interface for MultiKueue adapters
 // whose jobs create multiple workloads (e.g., LeaderWorkerSet creates one workload per replica).
 type MultiKueueMultiWorkloadAdapter interface {
@@ -0,0 +1,75 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// ElasticJobSyncNeeded determines if the remote copy of a job opted in for workload
+// slicing requires synchronization with the local job. It returns true when the
+// pod counts differ between the local and remote jobs, or when the remote job is not
+// labeled with the current workload slice.
+//
+// During a scale-up, the MultiKueue reconciliation may observe the old workload
+// slice while the local job already has the new counts, before the GenericJobReconciler
+// creates the new workload slice. Such a stale workload slice is not propagated
+// to the remote job.
+func ElasticJobSyncNeeded(log logr.Logger, workloadName string, localJob GenericJob, remoteJob client.Object, localCounts, remoteCounts workload.PodSetsCounts) bool {
+	if !WorkloadSliceEnabled(localJob) {
+		return false
+	}
+	localObj := localJob.Object()
+	newWorkloadName := GetWorkloadNameForOwnerWithGVKAndGeneration(localObj.GetName(), localObj.GetUID(), localJob.GVK(), localObj.GetGeneration())
+	if remoteCounts.HasFewerReplicasThan(localCounts) && workloadName != newWorkloadName {
+		log.V(2).Info("Skipping stale ElasticWorkload sync",
+			"old.counts", remoteCounts,
+			"new.counts", localCounts,
+			"workloadName", workloadName,
+			"newWorkloadName", newWorkloadName)
+		return false
+	}
+	return !localCounts.EqualTo(remoteCounts) || remoteJob.GetLabels()[constants.PrebuiltWorkloadLabel] != workloadName
+}
+
+// SyncElasticJob patches the remote copy of a job opted in for workload slicing
+// with the current workload slice, and with the pod counts set by syncCounts.
+//
+// Note: this call should be gated by ElasticJobSyncNeeded.
+func SyncElasticJob(ctx context.Context, remoteClient client.Client, workloadName string, remoteJob client.Object, syncCounts func()) error {
+	if err := clientutil.Patch(ctx, remoteClient, remoteJob, func() (bool, error) {
+		labels := remoteJob.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string, 1)
+		}
+		labels[constants.PrebuiltWorkloadLabel] = workloadName
+		remoteJob.SetLabels(labels)
+		syncCounts()
+		return true, nil
+	}); err != nil {
+		return fmt.Errorf("failed to patch remote job: %w", err)
+	}
+	return nil
+}
@@ -94,6 +94,8 @@ type JobReconciler struct {
 	clock                        clock.Clock
 	workloadRetentionPolicy      WorkloadRetentionPolicy
 	roleTracker                  *roletracker.RoleTracker
+	checkpointMaxGracePeriod     time.Duration
+	checkpointHooks              *checkpointHookCaller
 }
 
 // RoleTracker returns the role tracker for HA logging.
@@ -109,6 +111,7 @@ type Options struct {
 	IntegrationOptions           map[string]any // IntegrationOptions key is "$GROUP/$VERSION, Kind=$KIND".
 	EnabledFrameworks            sets.Set[string]
 	EnabledExternalFrameworks    sets.Set[string]
+	DeclarativeFrameworks        []configapi.DeclarativeFramework
 	ManagerName                  string
 	LabelKeysToCopy              []string
 	Queues                       *qcache.Manager
@@ -117,6 +120,8 @@ type Options struct {
 	WorkloadRetentionPolicy      WorkloadRetentionPolicy
 	RoleTracker                  *roletracker.RoleTracker
 	NoopWebhook                  bool
+	CheckpointMaxGracePeriod     time.Duration
+	checkpointHooks              *checkpointHookCaller
 }
 
 // Option configures the reconciler.
@@ -191,6 +196,14 @@ func WithEnabledExternalFrameworks(exFrameworks []string) Option {
 	}
 }
 
+// WithDeclarativeFrameworks adds the custom resources managed through the
+// paths of their fields in the Config API.
+func WithDeclarativeFrameworks(frameworks []configapi.DeclarativeFramework) Option {
+	return func(o *Options) {
+		o.DeclarativeFrameworks = frameworks
+	}
+}
+
 // WithManagerName adds the kueue's manager name.
 func WithManagerName(n string) Option {
 	return func(o *Options) {
@@ -235,6 +248,23 @@ func WithObjectRetentionPolicies(value *configapi.ObjectRetentionPolicies) Optio
 	}
 }
 
+// WithCheckpointAwareEviction sets the maximum grace period for the checkpoint
+// of the evicted jobs.
+func WithCheckpointAwareEviction(cfg *configapi.CheckpointAwareEviction) Option {
+	return func(o *Options) {
+		if cfg != nil && cfg.MaxGracePeriod != nil {
+			o.CheckpointMaxGracePeriod = cfg.MaxGracePeriod.Duration
+		}
+	}
+}
+
+// withCheckpointHookCaller sets the caller of the checkpoint HTTP hooks.
+func withCheckpointHookCaller(c *checkpointHookCaller) Option {
+	return func(o *Options) {
+		o.checkpointHooks = c
+	}
+}
+
 // WithRoleTracker sets the roleTracker for HA logging.
 func WithRoleTracker(tracker *roletracker.RoleTracker) Option {
 	return func(o *Options) {
@@ -251,7 +281,8 @@ func WithNoopWebhook(noop bool) Option {
 }
 
 var defaultOptions = Options{
-	Clock: clock.RealClock{},
+	Clock:                    clock.RealClock{},
+	CheckpointMaxGracePeriod: configapi.DefaultCheckpointMaxGracePeriod,
 }
 
 func NewReconciler(
@@ -270,6 +301,8 @@ func NewReconciler(
 		clock:                        options.Clock,
 		workloadRetentionPolicy:      options.WorkloadRetentionPolicy,
 		roleTracker:                  options.RoleTracker,
+		checkpointMaxGracePeriod:     options.CheckpointMaxGracePeriod,
+		checkpointHooks:              options.checkpointHooks,
 	}
 }
 
@@ -537,6 +570,22 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		log.V(3).Info("reclaimable pods are up-to-date")
 	}
 
+	// 4.1 update the aggregated status of the tasks of the bulk workload
+	if jobWithTasks, implementsTasks := job.(JobWithTasks); implementsTasks && workload.IsBulk(wl) {
+		tasks := jobWithTasks.TasksStatus()
+		if workload.HasQuotaReservation(wl) {
+			tasks.Admitted = workload.AdmittedTasks(wl)
+		}
+		if !workload.TasksStatusAreEqual(&tasks, wl.Status.Tasks) {
+			if err := workload.UpdateTasksStatus(ctx, r.client, wl, &tasks); err != nil {
+				log.Error(err, "Updating tasks status")
+				return ctrl.Result{}, err
+			}
+			log.V(3).Info("updated tasks status")
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// 5. handle WaitForPodsReady only for a standalone job.
 	// handle a job when waitForPodsReady is enabled, and it is the main job
 	if r.waitForPodsReady {
@@ -572,6 +621,9 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 	// 6. handle eviction
 	if evCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted); evCond != nil && evCond.Status == metav1.ConditionTrue {
 		log.V(3).Info("Handling a job with evicted condition")
+		if requeueAfter, err := r.waitForCheckpoint(ctx, job, wl, evCond); err != nil || requeueAfter > 0 {
+			return ctrl.Result{RequeueAfter: requeueAfter}, err
+		}
 		if err := r.stopJob(ctx, job, wl, StopReasonWorkloadEvicted, evCond.Message); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -650,12 +702,31 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	if WorkloadSliceEnabled(job) {
+	// The composable jobs supporting workload slices start the pods of the workload slices when running.
+	if _, processesSlices := job.(ComposableJobWithWorkloadSlices); !processesSlices && WorkloadSliceEnabled(job) {
 		// Start workload-slice schedule-gated pods (if any).
 		log.V(3).Info("Job running with admitted workload slice, start pods.")
 		return ctrl.Result{}, workloadslicing.StartWorkloadSlicePods(ctx, r.client, wl)
 	}
 
+	// 9. scale the job running the tasks of the bulk workload.
+	if jobWithTasks, implementsTasks := job.(JobWithTasks); implementsTasks && workload.IsBulk(wl) {
+		admittedTasks := workload.AdmittedTasks(wl)
+		scaled := false
+		if err := clientutil.Patch(ctx, r.client, object, func() (bool, error) {
+			scaled = jobWithTasks.SetAdmittedTasks(admittedTasks)
+			return scaled, nil
+		}); err != nil {
+			log.Error(err, "Scaling the job to the admitted tasks")
+			return ctrl.Result{}, err
+		}
+		if scaled {
+			log.V(2).Info("Job scaled to the admitted tasks", "admittedTasks", admittedTasks)
+			r.record.Eventf(object, corev1.EventTypeNormal, ReasonScaledTasks, "Scaled to run %d tasks at once", admittedTasks)
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// workload is admitted and job is running, nothing to do.
 	log.V(3).Info("Job running with admitted workload, nothing to do")
 	return ctrl.Result{}, nil
@@ -878,7 +949,8 @@ func (r *JobReconciler) ensureOneWorkload(ctx context.Context, job GenericJob, o
 	}
 
 	// If workload slicing is enabled for this job, use the slice-based processing path.
-	if WorkloadSliceEnabled(job) {
+	// The composable jobs supporting workload slices process them when finding the matching workloads.
+	if _, processesSlices := job.(ComposableJobWithWorkloadSlices); !processesSlices && WorkloadSliceEnabled(job) {
 		podSets, err := JobPodSets(ctx, job)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve pod sets from job: %w", err)
@@ -1097,7 +1169,7 @@ func expectedRunningPodSets(ctx context.Context, c client.Client, wl *kueue.Work
 		if err != nil {
 			return nil
 		}
-		if canBePartiallyAdmitted && ps.MinCount != nil {
+		if canBePartiallyAdmitted && (ps.MinCount != nil || workload.IsBulk(wl)) {
 			// update the expected running count
 			ps.Count = psi.Count
 		}
@@ -1126,6 +1198,11 @@ func EquivalentToWorkload(ctx context.Context, c client.Client, job GenericJob,
 	jobPodSets := clearMinCountsIfFeatureDisabled(getPodSets)
 
 	if runningPodSets := expectedRunningPodSets(ctx, c, wl); runningPodSets != nil {
+		if workload.IsBulk(wl) && len(jobPodSets) == 1 && len(runningPodSets) == 1 {
+			// The job running the tasks of the bulk workload is scaled to
+			// the admitted tasks after the workload is updated.
+			runningPodSets[0].Count = jobPodSets[0].Count
+		}
 		if equality.ComparePodSetSlices(jobPodSets, runningPodSets, workload.IsAdmitted(wl)) {
 			return true, nil
 		}
@@ -1189,6 +1266,7 @@ func (r *JobReconciler) startJob(ctx context.Context, job GenericJob, object cli
 		}
 	} else {
 		if err := clientutil.Patch(ctx, r.client, object, func() (bool, error) {
+			clearCheckpointAnnotations(object)
 			return true, job.RunWithPodSetsInfo(ctx, info)
 		}); err != nil {
 			return err
@@ -1289,6 +1367,9 @@ func ConstructWorkload(ctx context.Context, c client.Client, job GenericJob, lab
 	}
 
 	wl := NewWorkload(newWorkloadName(job), object, podSets, labelKeysToCopy)
+	if jobWithTasks, implementsTasks := job.(JobWithTasks); implementsTasks && features.Enabled(features.BulkWorkloads) {
+		wl.Spec.Tasks = jobWithTasks.Tasks()
+	}
 	if wl.Labels == nil {
 		wl.Labels = make(map[string]string)
 	}
@@ -1320,7 +1401,7 @@ func prepareWorkloadSlice(ctx context.Context, clnt client.Client, job GenericJo
 	metav1.SetMetaDataAnnotation(&wl.ObjectMeta, workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue)
 
 	// Lookup existing slice for a given job.
-	workloadSlices, err := workloadslicing.FindNotFinishedWorkloads(ctx, clnt, job.Object(), job.GVK())
+	workloadSlices, err := findNotFinishedWorkloadSlices(ctx, clnt, job)
 	if err != nil {
 		return fmt.Errorf("failure looking up workload slices: %w", err)
 	}
@@ -1348,6 +1429,14 @@ func prepareWorkloadSlice(ctx context.Context, clnt client.Client, job GenericJo
 	}
 }
 
+// findNotFinishedWorkloadSlices returns the not finished workload slices of the job, sorted from the oldest.
+func findNotFinishedWorkloadSlices(ctx context.Context, clnt client.Client, job GenericJob) ([]kueue.Workload, error) {
+	if cj, implements := job.(ComposableJobWithWorkloadSlices); implements {
+		return cj.FindNotFinishedWorkloadSlices(ctx, clnt)
+	}
+	return workloadslicing.FindNotFinishedWorkloads(ctx, clnt, job.Object(), job.GVK())
+}
+
 func getCustomPriorityClassFuncFromJob(job GenericJob) func() string {
 	if jobWithPriorityClass, isImplemented := job.(JobWithPriorityClass); isImplemented {
 		return jobWithPriorityClass.PriorityClass
@@ -726,6 +726,9 @@ func TestProcessOptions(t *testing.T) {
 				WithKubeServerVersion(&kubeversion.ServerVersionFetcher{}),
 				WithLabelKeysToCopy([]string{"toCopyKey"}),
 				WithClock(fakeClock),
+				WithCheckpointAwareEviction(&configapi.CheckpointAwareEviction{
+					MaxGracePeriod: &metav1.Duration{Duration: time.Minute},
+				}),
 			},
 			wantOpts: Options{
 				ManageJobsWithoutQueueName: true,
@@ -734,6 +737,7 @@ func TestProcessOptions(t *testing.T) {
 				IntegrationOptions:         nil,
 				LabelKeysToCopy:            []string{"toCopyKey"},
 				Clock:                      fakeClock,
+				CheckpointMaxGracePeriod:   time.Minute,
 			},
 		},
 		"a single option is passed": {
@@ -746,6 +750,7 @@ func TestProcessOptions(t *testing.T) {
 				KubeServerVersion:          nil,
 				IntegrationOptions:         nil,
 				Clock:                      clock.RealClock{},
+				CheckpointMaxGracePeriod:   configapi.DefaultCheckpointMaxGracePeriod,
 			},
 		},
 		"no options are passed": {
@@ -756,6 +761,7 @@ func TestProcessOptions(t *testing.T) {
 				IntegrationOptions:         nil,
 				LabelKeysToCopy:            nil,
 				Clock:                      clock.RealClock{},
+				CheckpointMaxGracePeriod:   configapi.DefaultCheckpointMaxGracePeriod,
 			},
 		},
 	}
@@ -763,7 +769,7 @@ func TestProcessOptions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			gotOpts := ProcessOptions(tc.inputOpts...)
 			if diff := cmp.Diff(tc.wantOpts, gotOpts,
-				cmpopts.IgnoreUnexported(kubeversion.ServerVersionFetcher{}, testingclock.FakePassiveClock{}, testingclock.FakeClock{})); len(diff) != 0 {
+				cmpopts.IgnoreUnexported(Options{}, kubeversion.ServerVersionFetcher{}, testingclock.FakePassiveClock{}, testingclock.FakeClock{})); len(diff) != 0 {
 				t.Errorf("Unexpected error from ProcessOptions (-want,+got):\n%s", diff)
 			}
 		})
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -29,6 +30,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 const (
@@ -60,6 +63,12 @@ func (m *integrationManager) setupControllers(ctx context.Context, mgr ctrl.Mana
 	m.setImplicitlyEnabledIntegrations(implicitlyEnabledIntegrations)
 	allEnabledIntegrations := options.EnabledFrameworks.Union(implicitlyEnabledIntegrations)
 
+	declarativeIntegrations, err := m.registerDeclarative(options.DeclarativeFrameworks)
+	if err != nil {
+		return fmt.Errorf("register declarative frameworks: %w", err)
+	}
+	allEnabledIntegrations = allEnabledIntegrations.Union(declarativeIntegrations)
+
 	if err := m.checkEnabledListDependencies(allEnabledIntegrations); err != nil {
 		return fmt.Errorf("check enabled frameworks list: %w", err)
 	}
@@ -69,6 +78,14 @@ func (m *integrationManager) setupControllers(ctx context.Context, mgr ctrl.Mana
 			return err
 		}
 	}
+
+	if features.Enabled(features.CheckpointAwareEviction) {
+		checkpointHooks := newCheckpointHookCaller()
+		if err := mgr.Add(checkpointHooks); err != nil {
+			return fmt.Errorf("add the checkpoint hook caller: %w", err)
+		}
+		opts = append(slices.Clip(opts), withCheckpointHookCaller(checkpointHooks))
+	}
 	return m.forEach(func(name string, cb IntegrationCallbacks) error {
 		logger := log.WithValues("jobFrameworkName", name)
 		fwkNamePrefix := fmt.Sprintf("jobFrameworkName %q", name)
@@ -185,6 +202,11 @@ func SetupIndexes(ctx context.Context, indexer client.FieldIndexer, opts ...Opti
 	options := ProcessOptions(opts...)
 
 	allEnabledIntegrations := options.EnabledFrameworks.Union(manager.collectImplicitlyEnabledIntegrations(options.EnabledFrameworks))
+	declarativeIntegrations, err := manager.registerDeclarative(options.DeclarativeFrameworks)
+	if err != nil {
+		return fmt.Errorf("register declarative frameworks: %w", err)
+	}
+	allEnabledIntegrations = allEnabledIntegrations.Union(declarativeIntegrations)
 	return ForEachIntegration(func(name string, cb IntegrationCallbacks) error {
 		if allEnabledIntegrations.Has(name) {
 			if err := cb.SetupIndexes(ctx, indexer); err != nil {
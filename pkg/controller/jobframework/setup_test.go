@@ -39,6 +39,7 @@ import (
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/util/slices"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
@@ -102,6 +103,22 @@ func TestSetupControllers(t *testing.T) {
 			},
 			wantEnabledIntegrations: []string{"batch/job", "kubeflow.org/mpijob"},
 		},
+		"setup controllers of declarative frameworks": {
+			opts: []Option{
+				WithEnabledFrameworks([]string{"batch/job"}),
+				WithDeclarativeFrameworks([]configapi.DeclarativeFramework{{
+					Name:                   "TrainingRun.v1.example.com",
+					PodSets:                []configapi.DeclarativePodSet{{Name: "main", TemplatePath: ".spec.template"}},
+					ActiveConditionType:    "Running",
+					SucceededConditionType: "Succeeded",
+				}}),
+			},
+			mapperGVKs: []schema.GroupVersionKind{
+				batchv1.SchemeGroupVersion.WithKind("Job"),
+				{Group: "example.com", Version: "v1", Kind: "TrainingRun"},
+			},
+			wantEnabledIntegrations: []string{"TrainingRun.v1.example.com", "batch/job"},
+		},
 		"mapper doesn't have kubeflow.org/mpijob, but no error occur": {
 			opts: []Option{
 				WithEnabledFrameworks([]string{"batch/job", "kubeflow.org/mpijob"}),
@@ -17,7 +17,9 @@ limitations under the License.
 package jobframework
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -60,6 +62,8 @@ func (p *podSetTopologyRequestBuilder) Build() (*kueue.PodSetTopologyRequest, er
 
 	podSetGroupName, podSetGroupNameFound := p.meta.Annotations[kueue.PodSetGroupName]
 
+	spreadValue, spreadFound := spreadTopology(p.meta)
+
 	switch {
 	case requiredFound:
 		psTopologyReq.Required = &requiredValue
@@ -71,6 +75,18 @@ func (p *podSetTopologyRequestBuilder) Build() (*kueue.PodSetTopologyRequest, er
 			return nil, err
 		}
 		psTopologyReq.Unconstrained = &unconstrained
+	case spreadFound:
+		psTopologyReq.SpreadTopology = &spreadValue
+		minDomains, err := p.int32Annotation(kueue.PodSetSpreadMinDomainsAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		psTopologyReq.SpreadMinDomains = minDomains
+		maxPodsPerDomain, err := p.int32Annotation(kueue.PodSetSpreadMaxPodsPerDomainAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		psTopologyReq.SpreadMaxPodsPerDomain = maxPodsPerDomain
 	default:
 		if (!sliceRequiredTopologyFound || !sliceSizeFound) && (p.podIndexLabel == nil && p.subGroupIndexLabel == nil && p.subGroupCount == nil) {
 			return nil, nil
@@ -87,6 +103,12 @@ func (p *podSetTopologyRequestBuilder) Build() (*kueue.PodSetTopologyRequest, er
 		}
 	}
 
+	podSetConstraints, err := PodSetTopologyConstraints(p.meta)
+	if err != nil {
+		return nil, err
+	}
+	psTopologyReq.PodSetConstraints = podSetConstraints
+
 	psTopologyReq.PodIndexLabel = p.podIndexLabel
 	psTopologyReq.SubGroupCount = p.subGroupCount
 	psTopologyReq.SubGroupIndexLabel = p.subGroupIndexLabel
@@ -96,3 +118,60 @@ func (p *podSetTopologyRequestBuilder) Build() (*kueue.PodSetTopologyRequest, er
 
 	return &psTopologyReq, nil
 }
+
+func (p *podSetTopologyRequestBuilder) int32Annotation(key string) (*int32, error) {
+	value, found := p.meta.Annotations[key]
+	if !found {
+		return nil, nil
+	}
+	intValue, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return ptr.To(int32(intValue)), nil
+}
+
+// podSetTopologyConstraintAnnotations maps the annotations describing the
+// topology constraints relative to other PodSets to their relations.
+var podSetTopologyConstraintAnnotations = []struct {
+	key      string
+	relation kueue.PodSetTopologyRelation
+}{
+	{key: kueue.PodSetSameTopologyDomainAsAnnotation, relation: kueue.SameDomainPodSetTopologyRelation},
+	{key: kueue.PodSetDifferentTopologyDomainFromAnnotation, relation: kueue.DifferentDomainPodSetTopologyRelation},
+}
+
+// PodSetTopologyConstraints parses the topology constraints relative to other
+// PodSets from the `kueue.x-k8s.io/podset-same-topology-domain-as` and
+// `kueue.x-k8s.io/podset-different-topology-domain-from` annotations.
+func PodSetTopologyConstraints(meta *metav1.ObjectMeta) ([]kueue.PodSetTopologyConstraint, error) {
+	var result []kueue.PodSetTopologyConstraint
+	for _, annotation := range podSetTopologyConstraintAnnotations {
+		value, found := meta.Annotations[annotation.key]
+		if !found {
+			continue
+		}
+		constraints, err := parsePodSetTopologyConstraints(value, annotation.relation)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %w", annotation.key, err)
+		}
+		result = append(result, constraints...)
+	}
+	return result, nil
+}
+
+func parsePodSetTopologyConstraints(value string, relation kueue.PodSetTopologyRelation) ([]kueue.PodSetTopologyConstraint, error) {
+	var result []kueue.PodSetTopologyConstraint
+	for item := range strings.SplitSeq(value, ",") {
+		podSetName, level, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || podSetName == "" || level == "" {
+			return nil, fmt.Errorf("%q is not in the <podset-name>=<topology-level> format", item)
+		}
+		result = append(result, kueue.PodSetTopologyConstraint{
+			PodSetName: kueue.PodSetReference(podSetName),
+			Level:      level,
+			Relation:   relation,
+		})
+	}
+	return result, nil
+}
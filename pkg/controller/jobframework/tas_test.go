@@ -28,6 +28,7 @@ import (
 	jobsetapi "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 func TestPodSetTopologyRequestBuilder(t *testing.T) {
@@ -36,6 +37,7 @@ func TestPodSetTopologyRequestBuilder(t *testing.T) {
 		podIndexLabel      *string
 		subGroupIndexLabel *string
 		subGroupCount      *int32
+		disableSpread      bool
 		wantReq            *kueue.PodSetTopologyRequest
 		wantErr            error
 	}{
@@ -175,6 +177,75 @@ func TestPodSetTopologyRequestBuilder(t *testing.T) {
 				SubGroupCount:               ptr.To[int32](1),
 			},
 		},
+		"spread topology annotations": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetSpreadTopologyAnnotation:         "cloud.com/block",
+					kueue.PodSetSpreadMinDomainsAnnotation:       "2",
+					kueue.PodSetSpreadMaxPodsPerDomainAnnotation: "4",
+				},
+			},
+			podIndexLabel: ptr.To(batchv1.JobCompletionIndexAnnotation),
+			wantReq: &kueue.PodSetTopologyRequest{
+				SpreadTopology:         ptr.To("cloud.com/block"),
+				SpreadMinDomains:       ptr.To[int32](2),
+				SpreadMaxPodsPerDomain: ptr.To[int32](4),
+				PodIndexLabel:          ptr.To(batchv1.JobCompletionIndexAnnotation),
+			},
+		},
+		"spread topology annotation only": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetSpreadTopologyAnnotation: "cloud.com/block",
+				},
+			},
+			wantReq: &kueue.PodSetTopologyRequest{
+				SpreadTopology: ptr.To("cloud.com/block"),
+			},
+		},
+		"spread topology annotation ignored when the feature is disabled": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetSpreadTopologyAnnotation: "cloud.com/block",
+				},
+			},
+			disableSpread: true,
+		},
+		"invalid spread min domains annotation value": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetSpreadTopologyAnnotation:   "cloud.com/block",
+					kueue.PodSetSpreadMinDomainsAnnotation: "invalid",
+				},
+			},
+			wantErr: strconv.ErrSyntax,
+		},
+		"podset topology constraints annotations": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:            "cloud.com/block",
+					kueue.PodSetSameTopologyDomainAsAnnotation:        "worker=cloud.com/block",
+					kueue.PodSetDifferentTopologyDomainFromAnnotation: "worker=cloud.com/rack, driver=cloud.com/rack",
+				},
+			},
+			wantReq: &kueue.PodSetTopologyRequest{
+				Required: ptr.To("cloud.com/block"),
+				PodSetConstraints: []kueue.PodSetTopologyConstraint{
+					{PodSetName: "worker", Level: "cloud.com/block", Relation: kueue.SameDomainPodSetTopologyRelation},
+					{PodSetName: "worker", Level: "cloud.com/rack", Relation: kueue.DifferentDomainPodSetTopologyRelation},
+					{PodSetName: "driver", Level: "cloud.com/rack", Relation: kueue.DifferentDomainPodSetTopologyRelation},
+				},
+			},
+		},
+		"invalid podset topology constraints annotation value": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:     "cloud.com/block",
+					kueue.PodSetSameTopologyDomainAsAnnotation: "cloud.com/block",
+				},
+			},
+			wantErr: cmpopts.AnyError,
+		},
 		"invalid unconstrained topology annotation value": {
 			meta: &metav1.ObjectMeta{
 				Annotations: map[string]string{
@@ -195,6 +266,7 @@ func TestPodSetTopologyRequestBuilder(t *testing.T) {
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASSpreadTopology, !tc.disableSpread)
 			b := NewPodSetTopologyRequest(tc.meta)
 			b.PodIndexLabel(tc.podIndexLabel)
 			b.SubGroup(tc.subGroupIndexLabel, tc.subGroupCount)
@@ -22,10 +22,12 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/orderedgroups"
 )
 
@@ -36,6 +38,7 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 	_, unconstrainedFound := replicaMetadata.Annotations[kueue.PodSetUnconstrainedTopologyAnnotation]
 	sliceRequiredValue, sliceRequiredFound := replicaMetadata.Annotations[kueue.PodSetSliceRequiredTopologyAnnotation]
 	_, sliceSizeFound := replicaMetadata.Annotations[kueue.PodSetSliceSizeAnnotation]
+	spreadValue, spreadFound := spreadTopology(replicaMetadata)
 
 	// validate no more than 1 annotation
 	asInt := func(b bool) int {
@@ -65,6 +68,9 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 	if sliceRequiredFound {
 		allErrs = append(allErrs, metavalidation.ValidateLabelName(sliceRequiredValue, annotationsPath.Key(kueue.PodSetSliceRequiredTopologyAnnotation))...)
 	}
+	if spreadFound {
+		allErrs = append(allErrs, metavalidation.ValidateLabelName(spreadValue, annotationsPath.Key(kueue.PodSetSpreadTopologyAnnotation))...)
+	}
 
 	// validate PodSetGroupName annotation
 	podSetGroupNameValue, podSetGroupNameFound := replicaMetadata.Annotations[kueue.PodSetGroupName]
@@ -79,7 +85,9 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 			allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueue.PodSetGroupName), fmt.Sprintf("may not be set when '%s' is specified", kueue.PodSetSliceRequiredTopologyAnnotation)))
 		}
 
-		if !preferredFound && !requiredFound {
+		if spreadFound {
+			allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueue.PodSetGroupName), fmt.Sprintf("may not be set when '%s' is specified", kueue.PodSetSpreadTopologyAnnotation)))
+		} else if !preferredFound && !requiredFound {
 			allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueue.PodSetGroupName), fmt.Sprintf("may not be set when neither '%s' nor '%s' is specified", kueue.PodSetPreferredTopologyAnnotation, kueue.PodSetRequiredTopologyAnnotation)))
 		}
 	}
@@ -98,6 +106,92 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 		allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueue.PodSetSliceSizeAnnotation), fmt.Sprintf("may not be set when '%s' is not specified", kueue.PodSetSliceRequiredTopologyAnnotation)))
 	}
 
+	if features.Enabled(features.TASSpreadTopology) {
+		allErrs = append(allErrs, validateSpreadAnnotations(annotationsPath, replicaMetadata)...)
+	}
+
+	topologyFound := requiredFound || preferredFound || unconstrainedFound || sliceRequiredFound || spreadFound
+	allErrs = append(allErrs, validatePodSetTopologyConstraintAnnotations(annotationsPath, replicaMetadata, topologyFound)...)
+
+	return allErrs
+}
+
+func validatePodSetTopologyConstraintAnnotations(annotationsPath *field.Path, replicaMetadata *metav1.ObjectMeta, topologyFound bool) field.ErrorList {
+	var allErrs field.ErrorList
+	type podSetLevel struct {
+		podSetName kueue.PodSetReference
+		level      string
+	}
+	seen := sets.New[podSetLevel]()
+	for _, annotation := range podSetTopologyConstraintAnnotations {
+		value, found := replicaMetadata.Annotations[annotation.key]
+		if !found {
+			continue
+		}
+		annotationPath := annotationsPath.Key(annotation.key)
+		if !topologyFound {
+			allErrs = append(allErrs, field.Forbidden(annotationPath, "may not be set when no topology annotation is specified"))
+		}
+		constraints, err := parsePodSetTopologyConstraints(value, annotation.relation)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(annotationPath, value, err.Error()))
+			continue
+		}
+		for _, constraint := range constraints {
+			allErrs = append(allErrs, metavalidation.ValidateLabelName(constraint.Level, annotationPath)...)
+			key := podSetLevel{podSetName: constraint.PodSetName, level: constraint.Level}
+			if seen.Has(key) {
+				allErrs = append(allErrs, field.Invalid(annotationPath, value,
+					fmt.Sprintf("must not constrain the topology relative to PodSet %q at level %q more than once", constraint.PodSetName, constraint.Level)))
+			}
+			seen.Insert(key)
+		}
+	}
+	return allErrs
+}
+
+// spreadTopology returns the level of the spread topology requested by the
+// annotation, which is ignored unless the TASSpreadTopology feature is enabled.
+func spreadTopology(replicaMetadata *metav1.ObjectMeta) (string, bool) {
+	if !features.Enabled(features.TASSpreadTopology) {
+		return "", false
+	}
+	value, found := replicaMetadata.Annotations[kueue.PodSetSpreadTopologyAnnotation]
+	return value, found
+}
+
+func validateSpreadAnnotations(annotationsPath *field.Path, replicaMetadata *metav1.ObjectMeta) field.ErrorList {
+	var allErrs field.ErrorList
+	_, spreadFound := replicaMetadata.Annotations[kueue.PodSetSpreadTopologyAnnotation]
+	if spreadFound {
+		for _, key := range []string{
+			kueue.PodSetRequiredTopologyAnnotation,
+			kueue.PodSetPreferredTopologyAnnotation,
+			kueue.PodSetUnconstrainedTopologyAnnotation,
+			kueue.PodSetSliceRequiredTopologyAnnotation,
+		} {
+			if _, found := replicaMetadata.Annotations[key]; found {
+				allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueue.PodSetSpreadTopologyAnnotation), fmt.Sprintf("may not be set when '%s' is specified", key)))
+			}
+		}
+	}
+	for _, key := range []string{kueue.PodSetSpreadMinDomainsAnnotation, kueue.PodSetSpreadMaxPodsPerDomainAnnotation} {
+		value, found := replicaMetadata.Annotations[key]
+		if !found {
+			continue
+		}
+		if !spreadFound {
+			allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(key), fmt.Sprintf("may not be set when '%s' is not specified", kueue.PodSetSpreadTopologyAnnotation)))
+		}
+		val, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(key), value, "must be a numeric value"))
+			continue
+		}
+		if int32(val) < 1 {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(key), value, "must be greater than or equal to 1"))
+		}
+	}
 	return allErrs
 }
 
@@ -260,6 +354,35 @@ func ValidatePodSetGroupingTopology(podSets []kueue.PodSet, podSetAnnotationsByN
 	return allErrs
 }
 
+// ValidatePodSetTopologyConstraints validates that the topology constraints
+// relative to other PodSets reference existing PodSets of the workload.
+func ValidatePodSetTopologyConstraints(podSets []kueue.PodSet, podSetAnnotationsByName map[kueue.PodSetReference]*field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	podSetNames := sets.New[kueue.PodSetReference]()
+	for _, podSet := range podSets {
+		podSetNames.Insert(podSet.Name)
+	}
+	for _, podSet := range podSets {
+		if podSet.TopologyRequest == nil {
+			continue
+		}
+		for _, constraint := range podSet.TopologyRequest.PodSetConstraints {
+			annotationKey := kueue.PodSetSameTopologyDomainAsAnnotation
+			if constraint.Relation == kueue.DifferentDomainPodSetTopologyRelation {
+				annotationKey = kueue.PodSetDifferentTopologyDomainFromAnnotation
+			}
+			annotationPath := podSetAnnotationsByName[podSet.Name].Key(annotationKey)
+			switch {
+			case constraint.PodSetName == podSet.Name:
+				allErrs = append(allErrs, field.Invalid(annotationPath, constraint.PodSetName, "must not reference the PodSet itself"))
+			case !podSetNames.Has(constraint.PodSetName):
+				allErrs = append(allErrs, field.Invalid(annotationPath, constraint.PodSetName, "must reference an existing PodSet"))
+			}
+		}
+	}
+	return allErrs
+}
+
 func topologyRequestsValid(r1, r2 *kueue.PodSetTopologyRequest) bool {
 	// Check that the requests have exactly one of `Required` and `Preferred`.
 	if r1.Required == nil && r1.Preferred == nil {
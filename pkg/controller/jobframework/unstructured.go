@@ -0,0 +1,55 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The integrations of the frameworks whose Go modules Kueue doesn't depend
+// on, like the declarative frameworks, Tekton or Argo Workflows, handle
+// their resources as unstructured objects. The helpers below write the
+// fields injected at admission into such objects.
+
+// SetNestedStringMap sets the string map at the path of the fields, or
+// removes the field when the map is empty.
+func SetNestedStringMap(obj map[string]any, value map[string]string, fields ...string) error {
+	if len(value) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return nil
+	}
+	return unstructured.SetNestedStringMap(obj, value, fields...)
+}
+
+// SetNestedSlice sets the slice at the path of the fields to the items,
+// converted to unstructured, or removes the field when there are no items.
+func SetNestedSlice[T any](obj map[string]any, items []T, fields ...string) error {
+	if len(items) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return nil
+	}
+	values := make([]any, 0, len(items))
+	for i := range items {
+		value, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&items[i])
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+	return unstructured.SetNestedSlice(obj, values, fields...)
+}
@@ -31,12 +31,15 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	"sigs.k8s.io/kueue/pkg/workloadslicing"
@@ -44,8 +47,10 @@ import (
 
 var (
 	labelsPath                    = field.NewPath("metadata", "labels")
+	annotationsPath               = field.NewPath("metadata", "annotations")
 	queueNameLabelPath            = labelsPath.Key(constants.QueueLabel)
 	maxExecTimeLabelPath          = labelsPath.Key(constants.MaxExecTimeSecondsLabel)
+	dependenciesAnnotationPath    = annotationsPath.Key(constants.DependenciesAnnotation)
 	workloadPriorityClassNamePath = labelsPath.Key(constants.WorkloadPriorityClassLabel)
 	supportedPrebuiltWlJobGVKs    = sets.New(
 		batchv1.SchemeGroupVersion.WithKind("Job").String(),
@@ -68,6 +73,9 @@ func ValidateJobOnCreate(job GenericJob) field.ErrorList {
 	allErrs := ValidateQueueName(job.Object())
 	allErrs = append(allErrs, validateCreateForPrebuiltWorkload(job)...)
 	allErrs = append(allErrs, validateCreateForMaxExecTime(job)...)
+	allErrs = append(allErrs, validateClusterSelectors(job)...)
+	allErrs = append(allErrs, validateCheckpointAnnotations(job)...)
+	allErrs = append(allErrs, validateDependenciesAnnotation(job)...)
 	return allErrs
 }
 
@@ -78,6 +86,9 @@ func ValidateJobOnUpdate(oldJob, newJob GenericJob, defaultQueueExist func(strin
 	allErrs = append(allErrs, validateUpdateForMaxExecTime(oldJob, newJob)...)
 	allErrs = append(allErrs, validateJobUpdateForWorkloadPriorityClassName(oldJob, newJob)...)
 	allErrs = append(allErrs, validatedUpdateForEnabledWorkloadSlice(oldJob, newJob)...)
+	allErrs = append(allErrs, validateClusterSelectors(newJob)...)
+	allErrs = append(allErrs, validateCheckpointAnnotations(newJob)...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newJob.Object().GetAnnotations()[constants.DependenciesAnnotation], oldJob.Object().GetAnnotations()[constants.DependenciesAnnotation], dependenciesAnnotationPath)...)
 	return allErrs
 }
 
@@ -150,6 +161,15 @@ func validatedUpdateForEnabledWorkloadSlice(oldJob, newJob GenericJob) field.Err
 	return nil
 }
 
+// ValidateElasticJobSchedulingGate checks that the pod template of a job opted in
+// for workload slicing has the ElasticJobSchedulingGate.
+func ValidateElasticJobSchedulingGate(templatePath *field.Path, template *corev1.PodTemplateSpec) field.ErrorList {
+	if !slices.Contains(template.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: kueue.ElasticJobSchedulingGate}) {
+		return field.ErrorList{field.Invalid(templatePath.Child("spec", "schedulingGates"), template.Spec.SchedulingGates, "an elastic job must have the ElasticJobSchedulingGate")}
+	}
+	return nil
+}
+
 func ValidateUpdateForWorkloadPriorityClassName(isSuspended bool, oldObj, newObj client.Object) field.ErrorList {
 	// Cannot ADD a priority class to a NON-suspended (running) workload && wpc is empty
 	if !isSuspended && IsWorkloadPriorityClassNameEmpty(oldObj) {
@@ -166,6 +186,61 @@ func ValidateUpdateForWorkloadPriorityClassName(isSuspended bool, oldObj, newObj
 	return nil
 }
 
+func validateClusterSelectors(job GenericJob) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, key := range multiKueueClusterSelectorAnnotations {
+		if value, found := job.Object().GetAnnotations()[key]; found {
+			if _, err := labels.Parse(value); err != nil {
+				allErrs = append(allErrs, field.Invalid(annotationsPath.Key(key), value, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateCheckpointAnnotations(job GenericJob) field.ErrorList {
+	var allErrs field.ErrorList
+	annotations := job.Object().GetAnnotations()
+	if value, found := annotations[constants.CheckpointGracePeriodAnnotation]; found {
+		if _, _, err := checkpointGracePeriod(job.Object()); err != nil {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(constants.CheckpointGracePeriodAnnotation), value, err.Error()))
+		}
+	}
+	if value, found := annotations[constants.CheckpointHTTPHookAnnotation]; found {
+		if _, _, err := parseCheckpointHTTPHook(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(constants.CheckpointHTTPHookAnnotation), value, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateDependenciesAnnotation(job GenericJob) field.ErrorList {
+	value, found := job.Object().GetAnnotations()[constants.DependenciesAnnotation]
+	if !found {
+		return nil
+	}
+	dependencies, err := parseDependencies(job.Object())
+	if err != nil {
+		return field.ErrorList{field.Invalid(dependenciesAnnotationPath, value, fmt.Sprintf("expected a JSON list of dependencies: %v", err))}
+	}
+	var allErrs field.ErrorList
+	for i, dep := range dependencies {
+		if (dep.Name == nil) == (dep.Selector == nil) {
+			allErrs = append(allErrs, field.Invalid(dependenciesAnnotationPath, value, fmt.Sprintf("exactly one of name or selector must be set in the dependency %d", i)))
+			continue
+		}
+		if dep.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(dep.Selector); err != nil {
+				allErrs = append(allErrs, field.Invalid(dependenciesAnnotationPath, value, fmt.Sprintf("invalid selector of the dependency %d: %v", i, err)))
+			}
+		}
+		if dep.Condition != kueue.WorkloadDependencySucceeded && dep.Condition != kueue.WorkloadDependencyFinished {
+			allErrs = append(allErrs, field.NotSupported(dependenciesAnnotationPath, dep.Condition, []kueue.WorkloadDependencyCondition{kueue.WorkloadDependencySucceeded, kueue.WorkloadDependencyFinished}))
+		}
+	}
+	return allErrs
+}
+
 func validateCreateForMaxExecTime(job GenericJob) field.ErrorList {
 	if strVal, found := job.Object().GetLabels()[constants.MaxExecTimeSecondsLabel]; found {
 		v, err := strconv.Atoi(strVal)
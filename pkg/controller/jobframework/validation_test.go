@@ -29,6 +29,7 @@ import (
 	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/ptr"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	mocks "sigs.k8s.io/kueue/internal/mocks/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
@@ -317,6 +318,57 @@ func TestValidateJobOnUpdate(t *testing.T) {
 				field.Invalid(field.NewPath("metadata.labels["+workloadslicing.EnabledAnnotationKey+"]"), "false", "field is immutable"),
 			},
 		},
+		"invalid MultiKueue cluster selector": {
+			oldJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Obj(),
+			newJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).
+				SetAnnotation(kueue.MultiKueueRequiredClusterSelectorAnnotation, "region in (us-east1,us-west1)").
+				SetAnnotation(kueue.MultiKueuePreferredClusterSelectorAnnotation, "region in us-east1").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: field.NewPath("metadata", "annotations").Key(kueue.MultiKueuePreferredClusterSelectorAnnotation).String(),
+				},
+			},
+		},
+		"invalid checkpoint annotations": {
+			oldJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Obj(),
+			newJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).
+				SetAnnotation(constants.CheckpointGracePeriodAnnotation, "-1m").
+				SetAnnotation(constants.CheckpointHTTPHookAnnotation, "http/checkpoint").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: field.NewPath("metadata", "annotations").Key(constants.CheckpointGracePeriodAnnotation).String(),
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: field.NewPath("metadata", "annotations").Key(constants.CheckpointHTTPHookAnnotation).String(),
+				},
+			},
+		},
+		"dependencies annotation is immutable": {
+			oldJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).
+				SetAnnotation(constants.DependenciesAnnotation, `[{"name":"prepare"}]`).
+				Obj(),
+			newJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).
+				SetAnnotation(constants.DependenciesAnnotation, `[{"name":"download"}]`).
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: field.NewPath("metadata", "annotations").Key(constants.DependenciesAnnotation).String(),
+				},
+			},
+		},
+		"valid checkpoint annotations": {
+			oldJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Obj(),
+			newJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).
+				SetAnnotation(constants.CheckpointGracePeriodAnnotation, "90s").
+				SetAnnotation(constants.CheckpointHTTPHookAnnotation, "8080/checkpoint").
+				Obj(),
+		},
 	}
 
 	for tcName, tc := range testCases {
@@ -0,0 +1,421 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/podset"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"}
+)
+
+const (
+	FrameworkName = "argoproj.io/workflow"
+
+	// workflowLabel is set by the Argo controller on the pods of the Workflow.
+	workflowLabel = "workflows.argoproj.io/workflow"
+	// envelopeContainerName is the name of the container holding the
+	// resource envelope in the PodSet template.
+	envelopeContainerName = "main"
+)
+
+const (
+	phaseRunning   = "Running"
+	phaseSucceeded = "Succeeded"
+	phaseFailed    = "Failed"
+	phaseError     = "Error"
+	phasePending   = "Pending"
+
+	nodeTypePod = "Pod"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        newJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupWorkflowWebhook,
+		JobType:       newObject(),
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+
+type workflowReconciler struct {
+	jr *jobframework.JobReconciler
+}
+
+func newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func newJob() jobframework.GenericJob {
+	return fromObject(newObject())
+}
+
+func NewReconciler(ctx context.Context, client client.Client, indexer client.FieldIndexer, eventRecorder record.EventRecorder, opts ...jobframework.Option) (jobframework.JobReconcilerInterface, error) {
+	return &workflowReconciler{
+		jr: jobframework.NewReconciler(client, eventRecorder, opts...),
+	}, nil
+}
+
+func (r *workflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, newJob())
+}
+
+func (r *workflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	controllerName := strings.ToLower(newJob().GVK().Kind)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newJob().Object()).Owns(&kueue.Workload{}).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), controllerName),
+		}).
+		Complete(r)
+}
+
+// Workflow wraps the unstructured Argo Workflow object.
+type Workflow struct {
+	*unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*Workflow)(nil)
+
+// workflowSpec is the subset of the Workflow spec used by Kueue.
+type workflowSpec struct {
+	Entrypoint          string               `json:"entrypoint,omitempty"`
+	OnExit              string               `json:"onExit,omitempty"`
+	Templates           []template           `json:"templates,omitempty"`
+	WorkflowTemplateRef *workflowTemplateRef `json:"workflowTemplateRef,omitempty"`
+	NodeSelector        map[string]string    `json:"nodeSelector,omitempty"`
+	Tolerations         []corev1.Toleration  `json:"tolerations,omitempty"`
+	PodMetadata         *podMetadata         `json:"podMetadata,omitempty"`
+}
+
+type workflowTemplateRef struct {
+	Name string `json:"name,omitempty"`
+}
+
+type podMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// template is the subset of an Argo template used to compute its resources.
+type template struct {
+	Name           string             `json:"name,omitempty"`
+	Container      *corev1.Container  `json:"container,omitempty"`
+	Script         *corev1.Container  `json:"script,omitempty"`
+	ContainerSet   *containerSet      `json:"containerSet,omitempty"`
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	Sidecars       []corev1.Container `json:"sidecars,omitempty"`
+	Steps          [][]workflowStep   `json:"steps,omitempty"`
+	DAG            *dagTemplate       `json:"dag,omitempty"`
+}
+
+type containerSet struct {
+	Containers []corev1.Container `json:"containers,omitempty"`
+}
+
+type dagTemplate struct {
+	Tasks []dagTask `json:"tasks,omitempty"`
+}
+
+// workflowStep holds the fields of a step used to reference a template and
+// fan it out. They are shared with the DAG tasks.
+type workflowStep struct {
+	Name         string        `json:"name,omitempty"`
+	Template     string        `json:"template,omitempty"`
+	TemplateRef  *templateRef  `json:"templateRef,omitempty"`
+	Inline       *template     `json:"inline,omitempty"`
+	WithItems    []any         `json:"withItems,omitempty"`
+	WithParam    string        `json:"withParam,omitempty"`
+	WithSequence *withSequence `json:"withSequence,omitempty"`
+}
+
+type dagTask struct {
+	workflowStep `json:",inline"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	Depends      string   `json:"depends,omitempty"`
+}
+
+type templateRef struct {
+	Name     string `json:"name,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
+type withSequence struct {
+	Count *intstr.IntOrString `json:"count,omitempty"`
+	Start *intstr.IntOrString `json:"start,omitempty"`
+	End   *intstr.IntOrString `json:"end,omitempty"`
+}
+
+type workflowNode struct {
+	Type  string `json:"type,omitempty"`
+	Phase string `json:"phase,omitempty"`
+}
+
+func fromObject(obj runtime.Object) *Workflow {
+	return &Workflow{Unstructured: obj.(*unstructured.Unstructured)}
+}
+
+func (j *Workflow) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *Workflow) spec() (*workflowSpec, error) {
+	spec := &workflowSpec{}
+	specMap, _, err := unstructured.NestedMap(j.Unstructured.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// specObject returns the spec of the unstructured Workflow.
+func (j *Workflow) specObject() map[string]any {
+	if _, found := j.Unstructured.Object["spec"]; !found {
+		j.Unstructured.Object["spec"] = map[string]any{}
+	}
+	spec, _ := j.Unstructured.Object["spec"].(map[string]any)
+	return spec
+}
+
+func (j *Workflow) phase() string {
+	phase, _, _ := unstructured.NestedString(j.Unstructured.Object, "status", "phase")
+	return phase
+}
+
+func (j *Workflow) IsSuspended() bool {
+	suspend, _, _ := unstructured.NestedBool(j.Unstructured.Object, "spec", "suspend")
+	return suspend
+}
+
+// IsActive returns true if a pod of the Workflow is pending or running.
+// A suspended Workflow stays in the Running phase, so it can't be used here.
+func (j *Workflow) IsActive() bool {
+	for _, node := range j.nodes() {
+		if node.Type == nodeTypePod && (node.Phase == phasePending || node.Phase == phaseRunning) {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *Workflow) nodes() []workflowNode {
+	nodesMap, _, _ := unstructured.NestedMap(j.Unstructured.Object, "status", "nodes")
+	nodes := make([]workflowNode, 0, len(nodesMap))
+	for _, n := range nodesMap {
+		nodeMap, ok := n.(map[string]any)
+		if !ok {
+			continue
+		}
+		var node workflowNode
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(nodeMap, &node); err != nil {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func (j *Workflow) Suspend() {
+	_ = unstructured.SetNestedField(j.Unstructured.Object, true, "spec", "suspend")
+}
+
+func (j *Workflow) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *Workflow) PodLabelSelector() string {
+	return fmt.Sprintf("%s=%s", workflowLabel, j.GetName())
+}
+
+// PodSets returns a single PodSet holding the peak resource envelope of the
+// Workflow, that is an upper bound of the resources requested by the pods
+// which can run at the same time.
+func (j *Workflow) PodSets(ctx context.Context) ([]kueue.PodSet, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	requests, err := workflowRequests(spec)
+	if err != nil {
+		return nil, err
+	}
+	meta := metav1.ObjectMeta{}
+	if spec.PodMetadata != nil {
+		meta.Labels = spec.PodMetadata.Labels
+		meta.Annotations = spec.PodMetadata.Annotations
+	}
+	return []kueue.PodSet{
+		{
+			Name:  kueue.DefaultPodSetName,
+			Count: 1,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: meta,
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: envelopeContainerName,
+							Resources: corev1.ResourceRequirements{
+								Requests: requests,
+							},
+						},
+					},
+					NodeSelector: spec.NodeSelector,
+					Tolerations:  spec.Tolerations,
+				},
+			},
+		},
+	}, nil
+}
+
+func (j *Workflow) RunWithPodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	meta, podSpec, err := j.podMeta()
+	if err != nil {
+		return err
+	}
+	if err := podset.Merge(meta, podSpec, podSetsInfo[0]); err != nil {
+		return err
+	}
+	if err := setPodMeta(j.specObject(), meta, podSpec); err != nil {
+		return err
+	}
+	return unstructured.SetNestedField(j.Unstructured.Object, false, "spec", "suspend")
+}
+
+func (j *Workflow) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != 1 {
+		return false
+	}
+
+	meta, podSpec, err := j.podMeta()
+	if err != nil {
+		return false
+	}
+	if !podset.RestorePodSpec(meta, podSpec, podSetsInfo[0]) {
+		return false
+	}
+	return setPodMeta(j.specObject(), meta, podSpec) == nil
+}
+
+// podMeta returns the pod metadata and the scheduling fields applied by
+// Argo to all the pods of the Workflow.
+func (j *Workflow) podMeta() (*metav1.ObjectMeta, *corev1.PodSpec, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := &metav1.ObjectMeta{}
+	if spec.PodMetadata != nil {
+		meta.Labels = spec.PodMetadata.Labels
+		meta.Annotations = spec.PodMetadata.Annotations
+	}
+	podSpec := &corev1.PodSpec{
+		NodeSelector: spec.NodeSelector,
+		Tolerations:  spec.Tolerations,
+	}
+	return meta, podSpec, nil
+}
+
+// setPodMeta sets the pod metadata and the scheduling fields applied by
+// Argo to all the pods in the spec of the Workflow.
+func setPodMeta(spec map[string]any, meta *metav1.ObjectMeta, podSpec *corev1.PodSpec) error {
+	if err := jobframework.SetNestedStringMap(spec, meta.Labels, "podMetadata", "labels"); err != nil {
+		return err
+	}
+	if err := jobframework.SetNestedStringMap(spec, meta.Annotations, "podMetadata", "annotations"); err != nil {
+		return err
+	}
+	if podMeta, found, _ := unstructured.NestedMap(spec, "podMetadata"); found && len(podMeta) == 0 {
+		unstructured.RemoveNestedField(spec, "podMetadata")
+	}
+	if err := jobframework.SetNestedStringMap(spec, podSpec.NodeSelector, "nodeSelector"); err != nil {
+		return err
+	}
+	return jobframework.SetNestedSlice(spec, podSpec.Tolerations, "tolerations")
+}
+
+func (j *Workflow) Finished(ctx context.Context) (message string, success, finished bool) {
+	message, _, _ = unstructured.NestedString(j.Unstructured.Object, "status", "message")
+	switch j.phase() {
+	case phaseSucceeded:
+		return message, true, true
+	case phaseFailed, phaseError:
+		return message, false, true
+	}
+	return message, false, false
+}
+
+// PodsReady returns true when the Workflow is running and none of its pods
+// is pending.
+func (j *Workflow) PodsReady(ctx context.Context) bool {
+	if j.phase() != phaseRunning {
+		return false
+	}
+	for _, node := range j.nodes() {
+		if node.Type == nodeTypePod && node.Phase == phasePending {
+			return false
+		}
+	}
+	return true
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+func GetWorkloadNameForWorkflow(name string, uid types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(name, uid, gvk)
+}
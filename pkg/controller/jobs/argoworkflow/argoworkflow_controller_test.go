@@ -0,0 +1,390 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingargo "sigs.k8s.io/kueue/pkg/util/testingjobs/argoworkflow"
+)
+
+func envelopePodSets(cpu, memory string, nodeSelector map[string]string) []kueue.PodSet {
+	return []kueue.PodSet{
+		{
+			Name:  kueue.DefaultPodSetName,
+			Count: 1,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: envelopeContainerName,
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse(cpu),
+									corev1.ResourceMemory: resource.MustParse(memory),
+								},
+							},
+						},
+					},
+					NodeSelector: nodeSelector,
+				},
+			},
+		},
+	}
+}
+
+func requests(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func TestPodSets(t *testing.T) {
+	testCases := map[string]struct {
+		workflow    *unstructured.Unstructured
+		wantPodSets []kueue.PodSet
+		wantErr     error
+	}{
+		"single container": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				ContainerTemplate("main", requests("1", "1Gi")).
+				NodeSelector("pool", "batch").
+				Obj(),
+			wantPodSets: envelopePodSets("1", "1Gi", map[string]string{"pool": "batch"}),
+		},
+		"steps run in parallel within a group and sequentially across groups": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				StepsTemplate("main",
+					[]testingargo.Step{{Name: "prepare", Template: "small"}},
+					[]testingargo.Step{
+						{Name: "train-a", Template: "large"},
+						{Name: "train-b", Template: "large"},
+					},
+					[]testingargo.Step{{Name: "report", Template: "small"}},
+				).
+				ContainerTemplate("small", requests("500m", "256Mi")).
+				ContainerTemplate("large", requests("2", "4Gi")).
+				Obj(),
+			wantPodSets: envelopePodSets("4", "8Gi", nil),
+		},
+		"fan-out with items": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				StepsTemplate("main",
+					[]testingargo.Step{{Name: "process", Template: "worker", Items: 3}},
+				).
+				ContainerTemplate("worker", requests("1", "1Gi")).
+				Obj(),
+			wantPodSets: envelopePodSets("3", "3Gi", nil),
+		},
+		"dag diamond": {
+			// a runs first, b and c in parallel, and d once both completed.
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				DAGTemplate("main",
+					testingargo.Step{Name: "a", Template: "small"},
+					testingargo.Step{Name: "b", Template: "large", Depends: "a"},
+					testingargo.Step{Name: "c", Template: "large", Depends: "a.Succeeded"},
+					testingargo.Step{Name: "d", Template: "small", Depends: "b && (c.Succeeded || c.Failed)"},
+				).
+				ContainerTemplate("small", requests("500m", "256Mi")).
+				ContainerTemplate("large", requests("2", "4Gi")).
+				Obj(),
+			wantPodSets: envelopePodSets("4", "8Gi", nil),
+		},
+		"dag independent chains": {
+			// a -> b and c -> d can overlap in any way. The envelope is an
+			// upper bound, which counts b with both c and d although c and d
+			// never run at the same time.
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				DAGTemplate("main",
+					testingargo.Step{Name: "a", Template: "small"},
+					testingargo.Step{Name: "b", Template: "large", Depends: "a"},
+					testingargo.Step{Name: "c", Template: "small"},
+					testingargo.Step{Name: "d", Template: "large", Depends: "c"},
+				).
+				ContainerTemplate("small", requests("500m", "256Mi")).
+				ContainerTemplate("large", requests("2", "4Gi")).
+				Obj(),
+			wantPodSets: envelopePodSets("4500m", "8448Mi", nil),
+		},
+		"exit handler": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				OnExit("cleanup").
+				ContainerTemplate("main", requests("1", "1Gi")).
+				ContainerTemplate("cleanup", requests("500m", "2Gi")).
+				Obj(),
+			wantPodSets: envelopePodSets("1", "2Gi", nil),
+		},
+		"recursive template": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				StepsTemplate("main",
+					[]testingargo.Step{{Name: "again", Template: "main"}},
+				).
+				Obj(),
+			wantErr: cmpopts.AnyError,
+		},
+		"missing template": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				StepsTemplate("main",
+					[]testingargo.Step{{Name: "missing", Template: "missing"}},
+				).
+				Obj(),
+			wantErr: cmpopts.AnyError,
+		},
+		"fan-out with a parameter": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				StepsTemplate("main",
+					[]testingargo.Step{{Name: "process", Template: "worker", Param: "{{steps.list.outputs.result}}"}},
+				).
+				ContainerTemplate("worker", requests("1", "1Gi")).
+				Obj(),
+			wantErr: errDynamicFanOut,
+		},
+		"workflow template reference": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				WorkflowTemplateRef("template").
+				Obj(),
+			wantErr: errWorkflowTemplateRef,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			gotPodSets, err := fromObject(tc.workflow).PodSets(ctx)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Fatalf("Unexpected error (-want/+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantPodSets, gotPodSets); diff != "" {
+				t.Errorf("pod sets mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPodRequests(t *testing.T) {
+	testCases := map[string]struct {
+		template *template
+		want     corev1.ResourceList
+	}{
+		"limits are used as requests": {
+			template: &template{
+				Container: &corev1.Container{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						Limits:   requests("2", "1Gi"),
+					},
+				},
+			},
+			want: requests("1", "1Gi"),
+		},
+		"sidecars and init containers": {
+			template: &template{
+				Script:         &corev1.Container{Resources: corev1.ResourceRequirements{Requests: requests("1", "1Gi")}},
+				Sidecars:       []corev1.Container{{Resources: corev1.ResourceRequirements{Requests: requests("500m", "512Mi")}}},
+				InitContainers: []corev1.Container{{Resources: corev1.ResourceRequirements{Requests: requests("100m", "4Gi")}}},
+			},
+			want: requests("1500m", "4Gi"),
+		},
+		"container set": {
+			template: &template{
+				ContainerSet: &containerSet{Containers: []corev1.Container{
+					{Resources: corev1.ResourceRequirements{Requests: requests("1", "1Gi")}},
+					{Resources: corev1.ResourceRequirements{Requests: requests("1", "1Gi")}},
+				}},
+			},
+			want: requests("2", "2Gi"),
+		},
+		"suspend template": {
+			template: &template{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, podRequests(tc.template)); diff != "" {
+				t.Errorf("Unexpected requests (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfo(t *testing.T) {
+	baseWorkflow := testingargo.MakeWorkflow("wf", "ns").
+		ContainerTemplate("main", requests("1", "1Gi")).
+		NodeSelector("pool", "batch")
+
+	cases := map[string]struct {
+		workflow     *unstructured.Unstructured
+		runInfo      []podset.PodSetInfo
+		restoreInfo  []podset.PodSetInfo
+		wantRunError error
+		wantAfterRun *unstructured.Unstructured
+		wantFinal    *unstructured.Unstructured
+	}{
+		"valid configuration": {
+			workflow: baseWorkflow.Clone().Obj(),
+			runInfo: []podset.PodSetInfo{
+				{
+					Labels:       map[string]string{"kueue.x-k8s.io/podset": "main"},
+					NodeSelector: map[string]string{"flavor": "spot"},
+					Tolerations: []corev1.Toleration{{
+						Key:      "spot",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					}},
+				},
+			},
+			restoreInfo: []podset.PodSetInfo{
+				{
+					NodeSelector: map[string]string{"pool": "batch"},
+				},
+			},
+			wantAfterRun: baseWorkflow.Clone().
+				Suspend(false).
+				NodeSelector("flavor", "spot").
+				PodLabel("kueue.x-k8s.io/podset", "main").
+				Toleration(corev1.Toleration{
+					Key:      "spot",
+					Operator: corev1.TolerationOpExists,
+					Effect:   corev1.TaintEffectNoSchedule,
+				}).
+				Obj(),
+			wantFinal: baseWorkflow.Clone().Obj(),
+		},
+		"conflicting node selector": {
+			workflow: baseWorkflow.Clone().Obj(),
+			runInfo: []podset.PodSetInfo{
+				{
+					NodeSelector: map[string]string{"pool": "other"},
+				},
+			},
+			wantRunError: podset.ErrInvalidPodSetUpdate,
+		},
+		"invalid runInfo": {
+			workflow:     baseWorkflow.Clone().Obj(),
+			runInfo:      []podset.PodSetInfo{{}, {}},
+			wantRunError: podset.ErrInvalidPodsetInfo,
+			wantAfterRun: baseWorkflow.Clone().Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wf := fromObject(tc.workflow)
+			gotRunError := wf.RunWithPodSetsInfo(ctx, tc.runInfo)
+
+			if diff := cmp.Diff(tc.wantRunError, gotRunError, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected run error (-want/+got): %s", diff)
+			}
+			if tc.wantAfterRun != nil {
+				if diff := cmp.Diff(tc.wantAfterRun, tc.workflow); diff != "" {
+					t.Errorf("Unexpected workflow after run (-want/+got): %s", diff)
+				}
+			}
+
+			if tc.wantRunError == nil {
+				wf.Suspend()
+				if !wf.RestorePodSetsInfo(tc.restoreInfo) {
+					t.Error("Expected the workflow to be changed on restore")
+				}
+				if diff := cmp.Diff(tc.wantFinal, tc.workflow); diff != "" {
+					t.Errorf("Unexpected workflow after restore (-want/+got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	testCases := map[string]struct {
+		workflow      *testingargo.WorkflowWrapper
+		wantSuccess   bool
+		wantFinished  bool
+		wantActive    bool
+		wantPodsReady bool
+	}{
+		"not started": {
+			workflow: testingargo.MakeWorkflow("wf", "ns"),
+		},
+		"running": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Phase(phaseRunning).
+				Node("wf", "Steps", phaseRunning).
+				Node("wf-1", nodeTypePod, phaseRunning),
+			wantActive:    true,
+			wantPodsReady: true,
+		},
+		"running with a pending pod": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Phase(phaseRunning).
+				Node("wf-1", nodeTypePod, phaseSucceeded).
+				Node("wf-2", nodeTypePod, phasePending),
+			wantActive: true,
+		},
+		"suspended after its pods completed": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Phase(phaseRunning).
+				Node("wf-1", nodeTypePod, phaseSucceeded),
+			wantPodsReady: true,
+		},
+		"succeeded": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Phase(phaseSucceeded).
+				Node("wf-1", nodeTypePod, phaseSucceeded),
+			wantSuccess:  true,
+			wantFinished: true,
+		},
+		"failed": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Phase(phaseFailed),
+			wantFinished: true,
+		},
+		"error": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Phase(phaseError),
+			wantFinished: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wf := fromObject(tc.workflow.Message("child failed").Obj())
+			message, success, finished := wf.Finished(ctx)
+			if success != tc.wantSuccess || finished != tc.wantFinished {
+				t.Errorf("Unexpected result, want success: %v, finished: %v, got success: %v, finished: %v", tc.wantSuccess, tc.wantFinished, success, finished)
+			}
+			if message != "child failed" {
+				t.Errorf("Unexpected message: %q", message)
+			}
+			if active := wf.IsActive(); active != tc.wantActive {
+				t.Errorf("Unexpected active, want: %v, got: %v", tc.wantActive, active)
+			}
+			if podsReady := wf.PodsReady(ctx); podsReady != tc.wantPodsReady {
+				t.Errorf("Unexpected pods ready, want: %v, got: %v", tc.wantPodsReady, podsReady)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	resourcehelpers "k8s.io/component-helpers/resource"
+
+	utilresource "sigs.k8s.io/kueue/pkg/util/resource"
+)
+
+var (
+	errWorkflowTemplateRef = errors.New("workflowTemplateRef is not supported, the templates must be part of the Workflow")
+	errTemplateRef         = errors.New("templateRef is not supported, the templates must be part of the Workflow")
+	errDynamicFanOut       = errors.New("withParam is not supported, the number of pods must be known when the Workflow is created")
+
+	// dependsTokenRegexp matches the task names in the depends expression of
+	// a DAG task, like "A && (B.Succeeded || C.Failed)".
+	dependsTokenRegexp = regexp.MustCompile(`[^\s&|!().]+`)
+)
+
+// workflowRequests returns the peak resource envelope of the Workflow, that is
+// an upper bound of the resources requested by the pods which can run at the
+// same time.
+func workflowRequests(spec *workflowSpec) (corev1.ResourceList, error) {
+	if spec.WorkflowTemplateRef != nil {
+		return nil, errWorkflowTemplateRef
+	}
+	c := newEnvelopeCalculator(spec.Templates)
+	requests, err := c.templateRequests(spec.Entrypoint)
+	if err != nil {
+		return nil, err
+	}
+	if spec.OnExit != "" {
+		onExitRequests, err := c.templateRequests(spec.OnExit)
+		if err != nil {
+			return nil, err
+		}
+		// The exit handler runs once the entrypoint completed.
+		requests = utilresource.MergeResourceListKeepMax(requests, onExitRequests)
+	}
+	return requests, nil
+}
+
+// envelopeCalculator computes the resource envelope of the templates of a
+// Workflow.
+type envelopeCalculator struct {
+	templates map[string]*template
+	requests  map[string]corev1.ResourceList
+	visiting  sets.Set[string]
+}
+
+func newEnvelopeCalculator(templates []template) *envelopeCalculator {
+	c := &envelopeCalculator{
+		templates: make(map[string]*template, len(templates)),
+		requests:  make(map[string]corev1.ResourceList, len(templates)),
+		visiting:  sets.New[string](),
+	}
+	for i := range templates {
+		c.templates[templates[i].Name] = &templates[i]
+	}
+	return c
+}
+
+func (c *envelopeCalculator) templateRequests(name string) (corev1.ResourceList, error) {
+	if requests, found := c.requests[name]; found {
+		return requests, nil
+	}
+	t, found := c.templates[name]
+	if !found {
+		return nil, fmt.Errorf("template %q not found", name)
+	}
+	// The envelope of a recursive template depends on the number of
+	// iterations, which is only known at runtime.
+	if c.visiting.Has(name) {
+		return nil, fmt.Errorf("template %q is recursive", name)
+	}
+	c.visiting.Insert(name)
+	defer c.visiting.Delete(name)
+
+	requests, err := c.templateSpecRequests(t)
+	if err != nil {
+		return nil, err
+	}
+	c.requests[name] = requests
+	return requests, nil
+}
+
+func (c *envelopeCalculator) templateSpecRequests(t *template) (corev1.ResourceList, error) {
+	switch {
+	case len(t.Steps) > 0:
+		return c.stepsRequests(t.Steps)
+	case t.DAG != nil:
+		return c.dagRequests(t.DAG.Tasks)
+	}
+	return podRequests(t), nil
+}
+
+// stepsRequests returns the envelope of a steps template. The step groups
+// run one after the other, while the steps of a group run in parallel.
+func (c *envelopeCalculator) stepsRequests(groups [][]workflowStep) (corev1.ResourceList, error) {
+	var requests corev1.ResourceList
+	for _, group := range groups {
+		var groupRequests corev1.ResourceList
+		for i := range group {
+			stepRequests, err := c.stepRequests(&group[i])
+			if err != nil {
+				return nil, err
+			}
+			groupRequests = utilresource.MergeResourceListKeepSum(groupRequests, stepRequests)
+		}
+		requests = utilresource.MergeResourceListKeepMax(requests, groupRequests)
+	}
+	return requests, nil
+}
+
+// dagRequests returns the envelope of a DAG template. A task can run at the
+// same time as any task which is neither one of its dependencies nor one of
+// its dependents, so the envelope is the maximum, over the tasks, of the
+// requests of the task and of all the tasks independent of it.
+func (c *envelopeCalculator) dagRequests(tasks []dagTask) (corev1.ResourceList, error) {
+	index := make(map[string]int, len(tasks))
+	for i := range tasks {
+		index[tasks[i].Name] = i
+	}
+
+	taskRequests := make([]corev1.ResourceList, len(tasks))
+	dependencies := make([]sets.Set[int], len(tasks))
+	for i := range tasks {
+		requests, err := c.stepRequests(&tasks[i].workflowStep)
+		if err != nil {
+			return nil, err
+		}
+		taskRequests[i] = requests
+		dependencies[i] = sets.New[int]()
+		for _, name := range taskDependencies(&tasks[i]) {
+			if j, found := index[name]; found {
+				dependencies[i].Insert(j)
+			}
+		}
+	}
+
+	ancestors := make([]sets.Set[int], len(tasks))
+	var ancestorsOf func(i int) sets.Set[int]
+	ancestorsOf = func(i int) sets.Set[int] {
+		if ancestors[i] != nil {
+			return ancestors[i]
+		}
+		// Set before visiting the dependencies, to stop on cycles.
+		ancestors[i] = sets.New[int]()
+		for j := range dependencies[i] {
+			ancestors[i].Insert(j)
+			ancestors[i].Insert(ancestorsOf(j).UnsortedList()...)
+		}
+		return ancestors[i]
+	}
+
+	var requests corev1.ResourceList
+	for i := range tasks {
+		concurrentRequests := taskRequests[i]
+		for j := range tasks {
+			if j != i && !ancestorsOf(i).Has(j) && !ancestorsOf(j).Has(i) {
+				concurrentRequests = utilresource.MergeResourceListKeepSum(concurrentRequests, taskRequests[j])
+			}
+		}
+		requests = utilresource.MergeResourceListKeepMax(requests, concurrentRequests)
+	}
+	return requests, nil
+}
+
+// taskDependencies returns the names of the tasks a DAG task depends on.
+func taskDependencies(task *dagTask) []string {
+	dependencies := task.Dependencies
+	if task.Depends != "" {
+		dependencies = append(dependencies, dependsTokenRegexp.FindAllString(task.Depends, -1)...)
+	}
+	return dependencies
+}
+
+// stepRequests returns the envelope of a step or a DAG task, including all
+// the pods created by its fan-out.
+func (c *envelopeCalculator) stepRequests(step *workflowStep) (corev1.ResourceList, error) {
+	if step.TemplateRef != nil {
+		return nil, fmt.Errorf("step %q: %w", step.Name, errTemplateRef)
+	}
+	count, err := fanOut(step)
+	if err != nil {
+		return nil, fmt.Errorf("step %q: %w", step.Name, err)
+	}
+	var requests corev1.ResourceList
+	if step.Inline != nil {
+		requests, err = c.templateSpecRequests(step.Inline)
+	} else {
+		requests, err = c.templateRequests(step.Template)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scale(requests, count), nil
+}
+
+// fanOut returns the number of instances of a step or a DAG task.
+func fanOut(step *workflowStep) (int64, error) {
+	switch {
+	case step.WithParam != "":
+		return 0, errDynamicFanOut
+	case len(step.WithItems) > 0:
+		return int64(len(step.WithItems)), nil
+	case step.WithSequence != nil:
+		return sequenceLength(step.WithSequence)
+	}
+	return 1, nil
+}
+
+func sequenceLength(seq *withSequence) (int64, error) {
+	if seq.Count != nil {
+		return intOrStringValue("count", seq.Count)
+	}
+	var start, end int64
+	var err error
+	if seq.Start != nil {
+		if start, err = intOrStringValue("start", seq.Start); err != nil {
+			return 0, err
+		}
+	}
+	if seq.End != nil {
+		if end, err = intOrStringValue("end", seq.End); err != nil {
+			return 0, err
+		}
+	}
+	// The sequence is decreasing when the start is greater than the end.
+	if start > end {
+		return start - end + 1, nil
+	}
+	return end - start + 1, nil
+}
+
+func intOrStringValue(name string, v *intstr.IntOrString) (int64, error) {
+	if v.Type == intstr.Int {
+		return int64(v.IntVal), nil
+	}
+	value, err := strconv.ParseInt(v.StrVal, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("withSequence.%s must be a number, got %q", name, v.StrVal)
+	}
+	return value, nil
+}
+
+// templateContainers returns the user containers of the pod of a template,
+// without its sidecars.
+func templateContainers(t *template) []corev1.Container {
+	var containers []corev1.Container
+	if t.Container != nil {
+		containers = append(containers, *t.Container)
+	}
+	if t.Script != nil {
+		containers = append(containers, *t.Script)
+	}
+	if t.ContainerSet != nil {
+		containers = append(containers, t.ContainerSet.Containers...)
+	}
+	return containers
+}
+
+// podRequests returns the resources requested by the pod of a container,
+// script or container set template.
+func podRequests(t *template) corev1.ResourceList {
+	containers := templateContainers(t)
+	if len(containers) == 0 {
+		return nil
+	}
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers:     withDefaultRequests(append(containers, t.Sidecars...)),
+			InitContainers: withDefaultRequests(t.InitContainers),
+		},
+	}
+	return resourcehelpers.PodRequests(pod, resourcehelpers.PodResourcesOptions{})
+}
+
+// withDefaultRequests returns copies of the containers in which the missing
+// requests default to the limits, like done by the API server for the pods.
+func withDefaultRequests(containers []corev1.Container) []corev1.Container {
+	if len(containers) == 0 {
+		return nil
+	}
+	ret := make([]corev1.Container, len(containers))
+	for i := range containers {
+		containers[i].DeepCopyInto(&ret[i])
+		ret[i].Resources.Requests = utilresource.MergeResourceListKeepFirst(ret[i].Resources.Requests, ret[i].Resources.Limits)
+	}
+	return ret
+}
+
+// scale multiplies the resources by n.
+func scale(requests corev1.ResourceList, n int64) corev1.ResourceList {
+	if n == 1 {
+		return requests
+	}
+	ret := make(corev1.ResourceList, len(requests))
+	for name, q := range requests {
+		ret[name] = *resource.NewMilliQuantity(q.MilliValue()*n, q.Format)
+	}
+	return ret
+}
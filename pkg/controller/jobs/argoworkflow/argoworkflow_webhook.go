@@ -0,0 +1,132 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/webhook"
+)
+
+var (
+	specPath = field.NewPath("spec")
+)
+
+type WorkflowWebhook struct {
+	client                       client.Client
+	queues                       *qcache.Manager
+	manageJobsWithoutQueueName   bool
+	managedJobsNamespaceSelector labels.Selector
+}
+
+// SetupWorkflowWebhook configures the webhook for the Argo Workflow.
+func SetupWorkflowWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &WorkflowWebhook{
+		client:                       mgr.GetClient(),
+		queues:                       options.Queues,
+		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+	}
+	obj := newObject()
+	jobframework.RegisterUnstructuredType(mgr.GetScheme(), gvk)
+	if options.NoopWebhook {
+		return webhook.SetupNoopWebhook(mgr, obj)
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		WithLogConstructor(jobframework.WebhookLogConstructor(fromObject(obj).GVK(), options.RoleTracker)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-argoproj-io-v1alpha1-workflow,mutating=true,failurePolicy=fail,sideEffects=None,groups=argoproj.io,resources=workflows,verbs=create,versions=v1alpha1,name=mworkflow.kb.io,admissionReviewVersions=v1
+
+var _ admission.Defaulter[*unstructured.Unstructured] = &WorkflowWebhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *WorkflowWebhook) Default(ctx context.Context, obj *unstructured.Unstructured) error {
+	job := fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("workflow-webhook")
+	log.V(5).Info("Applying defaults")
+	jobframework.ApplyDefaultLocalQueue(job.Object(), w.queues.DefaultLocalQueueExist)
+	return jobframework.ApplyDefaultForSuspend(ctx, job, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector)
+}
+
+// +kubebuilder:webhook:path=/validate-argoproj-io-v1alpha1-workflow,mutating=false,failurePolicy=fail,sideEffects=None,groups=argoproj.io,resources=workflows,verbs=create;update,versions=v1alpha1,name=vworkflow.kb.io,admissionReviewVersions=v1
+
+var _ admission.Validator[*unstructured.Unstructured] = &WorkflowWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *WorkflowWebhook) ValidateCreate(ctx context.Context, obj *unstructured.Unstructured) (admission.Warnings, error) {
+	log := ctrl.LoggerFrom(ctx).WithName("workflow-webhook")
+	log.Info("Validating create")
+	return nil, w.validateCreate(fromObject(obj)).ToAggregate()
+}
+
+func (w *WorkflowWebhook) validateCreate(job *Workflow) field.ErrorList {
+	var allErrors field.ErrorList
+	if w.manageJobsWithoutQueueName || jobframework.QueueName(job) != "" {
+		allErrors = append(allErrors, validateSpec(job)...)
+	}
+	allErrors = append(allErrors, jobframework.ValidateJobOnCreate(job)...)
+	return allErrors
+}
+
+// validateSpec checks that the peak resource envelope of the Workflow can be
+// computed.
+func validateSpec(job *Workflow) field.ErrorList {
+	spec, err := job.spec()
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath, nil, err.Error())}
+	}
+	if spec.WorkflowTemplateRef != nil {
+		return field.ErrorList{field.Forbidden(specPath.Child("workflowTemplateRef"), errWorkflowTemplateRef.Error())}
+	}
+	if _, err := workflowRequests(spec); err != nil {
+		return field.ErrorList{field.Invalid(specPath.Child("templates"), nil, err.Error())}
+	}
+	return nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *WorkflowWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj *unstructured.Unstructured) (admission.Warnings, error) {
+	oldJob := fromObject(oldObj)
+	newJob := fromObject(newObj)
+	log := ctrl.LoggerFrom(ctx).WithName("workflow-webhook")
+	log.Info("Validating update")
+	var allErrors field.ErrorList
+	if w.manageJobsWithoutQueueName || jobframework.QueueName(newJob) != "" {
+		allErrors = append(allErrors, jobframework.ValidateJobOnUpdate(oldJob, newJob, w.queues.DefaultLocalQueueExist)...)
+		allErrors = append(allErrors, w.validateCreate(newJob)...)
+	}
+	return nil, allErrors.ToAggregate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *WorkflowWebhook) ValidateDelete(_ context.Context, _ *unstructured.Unstructured) (admission.Warnings, error) {
+	return nil, nil
+}
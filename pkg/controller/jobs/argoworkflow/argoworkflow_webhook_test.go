@@ -0,0 +1,190 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingargo "sigs.k8s.io/kueue/pkg/util/testingjobs/argoworkflow"
+)
+
+func TestDefault(t *testing.T) {
+	testcases := map[string]struct {
+		oldWorkflow    *unstructured.Unstructured
+		newWorkflow    *unstructured.Unstructured
+		manageAll      bool
+		defaultLqExist bool
+	}{
+		"unmanaged": {
+			oldWorkflow: testingargo.MakeWorkflow("wf", "ns").
+				Suspend(false).
+				Obj(),
+			newWorkflow: testingargo.MakeWorkflow("wf", "ns").
+				Suspend(false).
+				Obj(),
+		},
+		"managed - by config": {
+			oldWorkflow: testingargo.MakeWorkflow("wf", "ns").
+				Suspend(false).
+				Obj(),
+			newWorkflow: testingargo.MakeWorkflow("wf", "ns").
+				Suspend(true).
+				Obj(),
+			manageAll: true,
+		},
+		"managed - by queue": {
+			oldWorkflow: testingargo.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				Suspend(false).
+				Obj(),
+			newWorkflow: testingargo.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				Suspend(true).
+				Obj(),
+		},
+		"default lq is created, workflow doesn't have queue label": {
+			defaultLqExist: true,
+			oldWorkflow:    testingargo.MakeWorkflow("wf", "default").Obj(),
+			newWorkflow: testingargo.MakeWorkflow("wf", "default").
+				Queue("default").
+				Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().Build()
+			queueManager := qcache.NewManagerForUnitTests(cli, schdcache.New(cli))
+			if tc.defaultLqExist {
+				if err := queueManager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue("default", "default").
+					ClusterQueue("cluster-queue").Obj()); err != nil {
+					t.Fatalf("failed to create default local queue: %v", err)
+				}
+			}
+			wh := &WorkflowWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+				queues:                     queueManager,
+			}
+			result := tc.oldWorkflow.DeepCopy()
+			if err := wh.Default(ctx, result); err != nil {
+				t.Errorf("unexpected Default() error: %v", err)
+			}
+			if diff := cmp.Diff(tc.newWorkflow, result); diff != "" {
+				t.Errorf("Default() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	workflowWithParam := testingargo.MakeWorkflow("wf", "ns").
+		StepsTemplate("main",
+			[]testingargo.Step{{Name: "process", Template: "worker", Param: "{{steps.list.outputs.result}}"}},
+		).
+		ContainerTemplate("worker", nil)
+
+	testcases := map[string]struct {
+		workflow  *unstructured.Unstructured
+		manageAll bool
+		wantErr   error
+	}{
+		"valid": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				ContainerTemplate("main", nil).
+				Obj(),
+		},
+		"invalid unmanaged": {
+			workflow: workflowWithParam.Clone().Obj(),
+		},
+		"invalid managed - dynamic fan-out": {
+			workflow: workflowWithParam.Clone().
+				Queue("queue").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("templates"), nil, `step "process": `+errDynamicFanOut.Error()),
+			}.ToAggregate(),
+		},
+		"invalid managed - workflow template reference": {
+			workflow: testingargo.MakeWorkflow("wf", "ns").
+				WorkflowTemplateRef("template").
+				Obj(),
+			manageAll: true,
+			wantErr: field.ErrorList{
+				field.Forbidden(specPath.Child("workflowTemplateRef"), errWorkflowTemplateRef.Error()),
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wh := &WorkflowWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+			}
+			_, gotErr := wh.ValidateCreate(ctx, tc.workflow)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("validateCreate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testcases := map[string]struct {
+		oldWorkflow *unstructured.Unstructured
+		newWorkflow *unstructured.Unstructured
+		wantErr     error
+	}{
+		"queue name can be changed while suspended": {
+			oldWorkflow: testingargo.MakeWorkflow("wf", "ns").Queue("queue").ContainerTemplate("main", nil).Obj(),
+			newWorkflow: testingargo.MakeWorkflow("wf", "ns").Queue("queue2").ContainerTemplate("main", nil).Obj(),
+		},
+		"queue name cannot be changed while running": {
+			oldWorkflow: testingargo.MakeWorkflow("wf", "ns").Queue("queue").ContainerTemplate("main", nil).Suspend(false).Obj(),
+			newWorkflow: testingargo.MakeWorkflow("wf", "ns").Queue("queue2").ContainerTemplate("main", nil).Suspend(false).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(controllerconstants.QueueLabel), kueue.LocalQueueName("queue2"), "field is immutable"),
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().Build()
+			wh := &WorkflowWebhook{
+				queues: qcache.NewManagerForUnitTests(cli, schdcache.New(cli)),
+			}
+			_, gotErr := wh.ValidateUpdate(ctx, tc.oldWorkflow, tc.newWorkflow)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("ValidateUpdate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/serving"
 )
 
 var (
@@ -34,12 +36,14 @@ var (
 
 const (
 	FrameworkName = "deployment"
+
+	servingControllerName = "deployment_serving"
 )
 
 func init() {
 	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
 		SetupIndexes:                    SetupIndexes,
-		NewReconciler:                   jobframework.NewNoopReconcilerFactory(gvk),
+		NewReconciler:                   serving.NewReconcilerFactory(servingControllerName, FrameworkName),
 		GVK:                             gvk,
 		SetupWebhook:                    SetupWebhook,
 		JobType:                         &appsv1.Deployment{},
@@ -68,3 +72,10 @@ func (d *Deployment) GVK() schema.GroupVersionKind {
 func SetupIndexes(context.Context, client.FieldIndexer) error {
 	return nil
 }
+
+func managedByAnotherFramework(deployment *appsv1.Deployment) (string, bool) {
+	if frameworkName, ok := deployment.Spec.Template.Annotations[podconstants.SuspendedByParentAnnotation]; ok && frameworkName != FrameworkName {
+		return frameworkName, true
+	}
+	return "", false
+}
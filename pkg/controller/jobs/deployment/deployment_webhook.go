@@ -32,6 +32,7 @@ import (
 	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/serving"
 	"sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
@@ -69,6 +70,10 @@ func (wh *Webhook) Default(ctx context.Context, obj *appsv1.Deployment) error {
 	deployment := fromObject(obj)
 
 	log := ctrl.LoggerFrom(ctx).WithName("deployment-webhook")
+	if frameworkName, managed := managedByAnotherFramework(obj); managed {
+		log.V(5).Info("Skipping defaulting because the object is managed by another framework", "framework", frameworkName)
+		return nil
+	}
 	log.V(5).Info("Propagating queue-name")
 
 	jobframework.ApplyDefaultLocalQueue(deployment.Object(), wh.queues.DefaultLocalQueueExist)
@@ -92,6 +97,11 @@ func (wh *Webhook) Default(ctx context.Context, obj *appsv1.Deployment) error {
 		if priorityClass := jobframework.WorkloadPriorityClassName(deployment.Object()); priorityClass != "" {
 			deployment.Spec.Template.Labels[controllerconstants.WorkloadPriorityClassLabel] = priorityClass
 		}
+		// An invalid min replicas is reported by the validating webhook.
+		if minReplicas, err := serving.MinReplicas(deployment.Object()); serving.Enabled(deployment.Object()) && err == nil {
+			serving.ApplyToPodTemplate(deployment.Object(), serving.GroupName(deployment.Name), minReplicas,
+				deployment.Spec.Template.Labels, deployment.Spec.Template.Annotations)
+		}
 	}
 
 	return nil
@@ -108,6 +118,8 @@ func (wh *Webhook) ValidateCreate(ctx context.Context, obj *appsv1.Deployment) (
 	log.V(5).Info("Validating create")
 
 	allErrs := jobframework.ValidateQueueName(deployment.Object())
+	allErrs = append(allErrs, serving.Validate(ctx, wh.client, deployment.Object())...)
+	allErrs = append(allErrs, serving.ValidateMinReplicas(deployment.Object())...)
 
 	return nil, allErrs.ToAggregate()
 }
@@ -140,6 +152,8 @@ func (wh *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj *appsv1.De
 		oldDeployment.Object(),
 		newDeployment.Object(),
 	)...)
+	allErrs = append(allErrs, serving.ValidateUpdate(ctx, wh.client, oldDeployment.Object(), newDeployment.Object())...)
+	allErrs = append(allErrs, serving.ValidateMinReplicas(newDeployment.Object())...)
 	return warnings, allErrs.ToAggregate()
 }
 
@@ -128,6 +128,39 @@ func TestDefault(t *testing.T) {
 				PodTemplateSpecLabel(constants.WorkloadPriorityClassLabel, "new-test").
 				Obj(),
 		},
+		"deployment in scalable serving mode": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+			want: testingdeployment.MakeDeployment("test-pod", "").
+				PodTemplateSpecManagedByKueue().
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				PodTemplateSpecQueue("test-queue").
+				PodTemplateAnnotation(podconstants.SuspendedByParentAnnotation, FrameworkName).
+				PodTemplateSpecLabel(constants.WorkloadPriorityClassLabel, "burst").
+				PodTemplateSpecLabel(podconstants.ServingGroupLabel, "test-pod").
+				PodTemplateSpecLabel(podconstants.ServingTierLabel, podconstants.ServingTierBurst).
+				PodTemplateAnnotation(podconstants.ServingMinReplicasAnnotation, "2").
+				PodTemplateAnnotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				PodTemplateAnnotation(podconstants.ServingGuaranteedPriorityClassAnnotation, "guaranteed").
+				Obj(),
+		},
+		"deployment managed by another framework": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				PodTemplateAnnotation(podconstants.SuspendedByParentAnnotation, "serving.kserve.io/inferenceservice").
+				Obj(),
+			want: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				PodTemplateAnnotation(podconstants.SuspendedByParentAnnotation, "serving.kserve.io/inferenceservice").
+				Obj(),
+		},
 		"deployment without queue with pod template spec queue and priority class": {
 			deployment: testingdeployment.MakeDeployment("test-pod", "").
 				PodTemplateSpecQueue("test-queue").
@@ -195,13 +228,87 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"valid scalable serving": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+		},
+		"scalable serving without guaranteed priority class and min replicas": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "metadata.labels[kueue.x-k8s.io/priority-class]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-min-replicas]",
+				},
+			}.ToAggregate(),
+		},
+		"scalable serving with the same priority classes and invalid min replicas": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "burst").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "-1").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-burst-priority-class]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-min-replicas]",
+				},
+			}.ToAggregate(),
+		},
+		"scalable serving with a burst priority class of a higher priority": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "high").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-burst-priority-class]",
+				},
+			}.ToAggregate(),
+		},
+		"scalable serving with a missing burst priority class": {
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "missing").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotFound,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-burst-priority-class]",
+				},
+			}.ToAggregate(),
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			t.Cleanup(jobframework.EnableIntegrationsForTest(t, "pod"))
 
-			builder := utiltesting.NewClientBuilder()
+			builder := utiltesting.NewClientBuilder().WithObjects(
+				utiltestingapi.MakeWorkloadPriorityClass("high").PriorityValue(2000).Obj(),
+				utiltestingapi.MakeWorkloadPriorityClass("guaranteed").PriorityValue(1000).Obj(),
+				utiltestingapi.MakeWorkloadPriorityClass("burst").PriorityValue(100).Obj(),
+			)
 			client := builder.Build()
 
 			w := &Webhook{client: client}
@@ -379,13 +486,51 @@ func TestValidateUpdate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"scale scalable serving with a deleted priority class": {
+			oldDeployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "deleted").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+			newDeployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "deleted").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Replicas(3).
+				Obj(),
+		},
+		"change the burst priority class to a higher priority": {
+			oldDeployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+			newDeployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				Label(constants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "high").
+				Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-burst-priority-class]",
+				},
+			}.ToAggregate(),
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			t.Cleanup(jobframework.EnableIntegrationsForTest(t, "pod"))
 
-			builder := utiltesting.NewClientBuilder()
+			builder := utiltesting.NewClientBuilder().WithObjects(
+				utiltestingapi.MakeWorkloadPriorityClass("high").PriorityValue(2000).Obj(),
+				utiltestingapi.MakeWorkloadPriorityClass("guaranteed").PriorityValue(1000).Obj(),
+			)
 			client := builder.Build()
 
 			w := &Webhook{client: client}
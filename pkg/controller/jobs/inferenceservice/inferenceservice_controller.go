@@ -0,0 +1,135 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/serving"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}
+
+	// components are the components of the InferenceService running pods.
+	components = []string{"predictor", "transformer", "explainer"}
+)
+
+const (
+	FrameworkName = "serving.kserve.io/inferenceservice"
+
+	servingControllerName = "inferenceservice_serving"
+
+	// defaultMinReplicas is the min replicas of a component defaulted by KServe.
+	defaultMinReplicas = 1
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:                    SetupIndexes,
+		NewReconciler:                   serving.NewReconcilerFactory(servingControllerName, FrameworkName),
+		GVK:                             gvk,
+		SetupWebhook:                    SetupWebhook,
+		JobType:                         newObject(),
+		ImplicitlyEnabledFrameworkNames: []string{"pod"},
+	}))
+}
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch
+
+// InferenceService wraps the unstructured KServe InferenceService object.
+type InferenceService struct {
+	*unstructured.Unstructured
+}
+
+// component is the subset of an InferenceService component used by Kueue.
+type component struct {
+	MinReplicas *int32            `json:"minReplicas,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func fromObject(o runtime.Object) *InferenceService {
+	return &InferenceService{Unstructured: o.(*unstructured.Unstructured)}
+}
+
+func (isvc *InferenceService) Object() client.Object {
+	return isvc.Unstructured
+}
+
+func (isvc *InferenceService) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+// component returns the component with the given name, if it is set.
+func (isvc *InferenceService) component(name string) (*component, bool, error) {
+	componentMap, found, err := unstructured.NestedMap(isvc.Unstructured.Object, "spec", name)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	c := &component{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(componentMap, c); err != nil {
+		return nil, false, err
+	}
+	return c, true, nil
+}
+
+// setComponentMeta sets the labels and annotations that KServe propagates to
+// the pods of the component.
+func (isvc *InferenceService) setComponentMeta(name string, c *component) error {
+	if err := unstructured.SetNestedStringMap(isvc.Unstructured.Object, c.Labels, "spec", name, "labels"); err != nil {
+		return err
+	}
+	return unstructured.SetNestedStringMap(isvc.Unstructured.Object, c.Annotations, "spec", name, "annotations")
+}
+
+// isReady returns true if the InferenceService has the Ready condition.
+func (isvc *InferenceService) isReady() bool {
+	conditions, _, _ := unstructured.NestedSlice(isvc.Unstructured.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if ok && condition["type"] == "Ready" && condition["status"] == string(metav1.ConditionTrue) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *component) minReplicas() int32 {
+	if c.MinReplicas == nil {
+		return defaultMinReplicas
+	}
+	return *c.MinReplicas
+}
+
+func SetupIndexes(context.Context, client.FieldIndexer) error {
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/serving"
+	"sigs.k8s.io/kueue/pkg/util/webhook"
+)
+
+type Webhook struct {
+	client                       client.Client
+	manageJobsWithoutQueueName   bool
+	managedJobsNamespaceSelector labels.Selector
+	queues                       *qcache.Manager
+}
+
+func SetupWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &Webhook{
+		client:                       mgr.GetClient(),
+		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+		queues:                       options.Queues,
+	}
+	obj := newObject()
+	jobframework.RegisterUnstructuredType(mgr.GetScheme(), gvk)
+	if options.NoopWebhook {
+		return webhook.SetupNoopWebhook(mgr, obj)
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		WithLogConstructor(jobframework.WebhookLogConstructor(fromObject(obj).GVK(), options.RoleTracker)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-serving-kserve-io-v1beta1-inferenceservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=serving.kserve.io,resources=inferenceservices,verbs=create;update,versions=v1beta1,name=minferenceservice.kb.io,admissionReviewVersions=v1
+
+var _ admission.Defaulter[*unstructured.Unstructured] = &Webhook{}
+
+func (wh *Webhook) Default(ctx context.Context, obj *unstructured.Unstructured) error {
+	isvc := fromObject(obj)
+
+	log := ctrl.LoggerFrom(ctx).WithName("inferenceservice-webhook")
+	log.V(5).Info("Propagating queue-name")
+
+	jobframework.ApplyDefaultLocalQueue(isvc.Object(), wh.queues.DefaultLocalQueueExist)
+	suspend, err := jobframework.WorkloadShouldBeSuspended(ctx, isvc.Object(), wh.client, wh.manageJobsWithoutQueueName, wh.managedJobsNamespaceSelector)
+	if err != nil || !suspend {
+		return err
+	}
+
+	// The pods of every component are gated by the pod integration, each of
+	// them being admitted as its own Workload.
+	for _, name := range components {
+		c, found, err := isvc.component(name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if c.Annotations == nil {
+			c.Annotations = make(map[string]string, 1)
+		}
+		c.Annotations[podconstants.SuspendedByParentAnnotation] = FrameworkName
+		if c.Labels == nil {
+			c.Labels = make(map[string]string, 1)
+		}
+		c.Labels[constants.ManagedByKueueLabelKey] = constants.ManagedByKueueLabelValue
+		if queueName := jobframework.QueueNameForObject(isvc.Object()); queueName != "" {
+			c.Labels[controllerconstants.QueueLabel] = string(queueName)
+		}
+		if priorityClass := jobframework.WorkloadPriorityClassName(isvc.Object()); priorityClass != "" {
+			c.Labels[controllerconstants.WorkloadPriorityClassLabel] = priorityClass
+		}
+		if serving.Enabled(isvc.Object()) {
+			serving.ApplyToPodTemplate(isvc.Object(), serving.GroupName(isvc.GetName()+"-"+name), c.minReplicas(), c.Labels, c.Annotations)
+		}
+		if err := isvc.setComponentMeta(name, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-serving-kserve-io-v1beta1-inferenceservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=serving.kserve.io,resources=inferenceservices,verbs=create;update,versions=v1beta1,name=vinferenceservice.kb.io,admissionReviewVersions=v1
+
+var _ admission.Validator[*unstructured.Unstructured] = &Webhook{}
+
+func (wh *Webhook) ValidateCreate(ctx context.Context, obj *unstructured.Unstructured) (warnings admission.Warnings, err error) {
+	isvc := fromObject(obj)
+
+	log := ctrl.LoggerFrom(ctx).WithName("inferenceservice-webhook")
+	log.V(5).Info("Validating create")
+
+	allErrs := jobframework.ValidateQueueName(isvc.Object())
+	allErrs = append(allErrs, serving.Validate(ctx, wh.client, isvc.Object())...)
+
+	return nil, allErrs.ToAggregate()
+}
+
+var (
+	labelsPath         = field.NewPath("metadata", "labels")
+	queueNameLabelPath = labelsPath.Key(controllerconstants.QueueLabel)
+)
+
+func (wh *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj *unstructured.Unstructured) (warnings admission.Warnings, err error) {
+	oldISVC := fromObject(oldObj)
+	newISVC := fromObject(newObj)
+
+	log := ctrl.LoggerFrom(ctx).WithName("inferenceservice-webhook")
+	log.V(5).Info("Validating update")
+
+	oldQueueName := jobframework.QueueNameForObject(oldISVC.Object())
+	newQueueName := jobframework.QueueNameForObject(newISVC.Object())
+
+	allErrs := jobframework.ValidateQueueName(newISVC.Object())
+
+	// Prevents updating the queue-name if the InferenceService is ready
+	// or if the queue-name has been deleted.
+	isSuspended := !oldISVC.isReady()
+	if !isSuspended || newQueueName == "" {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newQueueName, oldQueueName, queueNameLabelPath)...)
+	}
+	allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(
+		isSuspended,
+		oldISVC.Object(),
+		newISVC.Object(),
+	)...)
+	allErrs = append(allErrs, serving.ValidateUpdate(ctx, wh.client, oldISVC.Object(), newISVC.Object())...)
+	return warnings, allErrs.ToAggregate()
+}
+
+func (wh *Webhook) ValidateDelete(context.Context, *unstructured.Unstructured) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
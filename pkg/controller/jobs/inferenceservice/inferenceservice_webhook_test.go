@@ -0,0 +1,283 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingisvc "sigs.k8s.io/kueue/pkg/util/testingjobs/inferenceservice"
+)
+
+func TestDefault(t *testing.T) {
+	testCases := map[string]struct {
+		isvc           *unstructured.Unstructured
+		defaultLqExist bool
+		want           *unstructured.Unstructured
+	}{
+		"without queue": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").Obj(),
+			want: testingisvc.MakeInferenceService("isvc", "ns").Obj(),
+		},
+		"with queue": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				ComponentLabel("predictor", "team", "ml").
+				Obj(),
+			want: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				ComponentLabel("predictor", "team", "ml").
+				ComponentLabel("predictor", constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue).
+				ComponentLabel("predictor", controllerconstants.QueueLabel, "queue").
+				ComponentAnnotation("predictor", podconstants.SuspendedByParentAnnotation, FrameworkName).
+				Obj(),
+		},
+		"default lq is created, isvc doesn't have queue label": {
+			defaultLqExist: true,
+			isvc:           testingisvc.MakeInferenceService("isvc", "default").Obj(),
+			want: testingisvc.MakeInferenceService("isvc", "default").
+				Queue("default").
+				ComponentLabel("predictor", constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue).
+				ComponentLabel("predictor", controllerconstants.QueueLabel, "default").
+				ComponentAnnotation("predictor", podconstants.SuspendedByParentAnnotation, FrameworkName).
+				Obj(),
+		},
+		"with queue, priority class and transformer": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "high").
+				Transformer().
+				Obj(),
+			want: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "high").
+				Transformer().
+				ComponentLabel("predictor", constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue).
+				ComponentLabel("predictor", controllerconstants.QueueLabel, "queue").
+				ComponentLabel("predictor", controllerconstants.WorkloadPriorityClassLabel, "high").
+				ComponentAnnotation("predictor", podconstants.SuspendedByParentAnnotation, FrameworkName).
+				ComponentLabel("transformer", constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue).
+				ComponentLabel("transformer", controllerconstants.QueueLabel, "queue").
+				ComponentLabel("transformer", controllerconstants.WorkloadPriorityClassLabel, "high").
+				ComponentAnnotation("transformer", podconstants.SuspendedByParentAnnotation, FrameworkName).
+				Obj(),
+		},
+		"scalable serving": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				MinReplicas("predictor", 2).
+				Transformer().
+				Obj(),
+			want: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				MinReplicas("predictor", 2).
+				Transformer().
+				ComponentLabel("predictor", constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue).
+				ComponentLabel("predictor", controllerconstants.QueueLabel, "queue").
+				ComponentLabel("predictor", controllerconstants.WorkloadPriorityClassLabel, "burst").
+				ComponentLabel("predictor", podconstants.ServingGroupLabel, "isvc-predictor").
+				ComponentLabel("predictor", podconstants.ServingTierLabel, podconstants.ServingTierBurst).
+				ComponentAnnotation("predictor", podconstants.SuspendedByParentAnnotation, FrameworkName).
+				ComponentAnnotation("predictor", podconstants.ServingMinReplicasAnnotation, "2").
+				ComponentAnnotation("predictor", podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				ComponentAnnotation("predictor", podconstants.ServingGuaranteedPriorityClassAnnotation, "guaranteed").
+				ComponentLabel("transformer", constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue).
+				ComponentLabel("transformer", controllerconstants.QueueLabel, "queue").
+				ComponentLabel("transformer", controllerconstants.WorkloadPriorityClassLabel, "burst").
+				ComponentLabel("transformer", podconstants.ServingGroupLabel, "isvc-transformer").
+				ComponentLabel("transformer", podconstants.ServingTierLabel, podconstants.ServingTierBurst).
+				ComponentAnnotation("transformer", podconstants.SuspendedByParentAnnotation, FrameworkName).
+				ComponentAnnotation("transformer", podconstants.ServingMinReplicasAnnotation, "1").
+				ComponentAnnotation("transformer", podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				ComponentAnnotation("transformer", podconstants.ServingGuaranteedPriorityClassAnnotation, "guaranteed").
+				Obj(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			t.Cleanup(jobframework.EnableIntegrationsForTest(t, "pod"))
+			client := utiltesting.NewClientBuilder().Build()
+			queueManager := qcache.NewManagerForUnitTests(client, schdcache.New(client))
+			if tc.defaultLqExist {
+				if err := queueManager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue("default", "default").
+					ClusterQueue("cluster-queue").
+					Obj()); err != nil {
+					t.Fatalf("failed to create default local queue: %s", err)
+				}
+			}
+			w := &Webhook{
+				client: client,
+				queues: queueManager,
+			}
+
+			if err := w.Default(ctx, tc.isvc); err != nil {
+				t.Errorf("failed to set defaults for InferenceService: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.isvc); len(diff) != 0 {
+				t.Errorf("Default() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	testCases := map[string]struct {
+		isvc    *unstructured.Unstructured
+		wantErr error
+	}{
+		"valid queue name": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Obj(),
+		},
+		"invalid queue name": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("test/queue").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/queue-name]",
+				},
+			}.ToAggregate(),
+		},
+		"valid scalable serving": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "guaranteed").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Obj(),
+		},
+		"scalable serving without guaranteed priority class": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "metadata.labels[kueue.x-k8s.io/priority-class]",
+				},
+			}.ToAggregate(),
+		},
+		"scalable serving with a burst priority class of a higher priority": {
+			isvc: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "burst").
+				Annotation(podconstants.ServingBurstPriorityClassAnnotation, "guaranteed").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/serving-burst-priority-class]",
+				},
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			client := utiltesting.NewClientBuilder().WithObjects(
+				utiltestingapi.MakeWorkloadPriorityClass("guaranteed").PriorityValue(1000).Obj(),
+				utiltestingapi.MakeWorkloadPriorityClass("burst").PriorityValue(100).Obj(),
+			).Build()
+			w := &Webhook{client: client}
+			_, err := w.ValidateCreate(ctx, tc.isvc)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		oldISVC *unstructured.Unstructured
+		newISVC *unstructured.Unstructured
+		wantErr error
+	}{
+		"update queue when not ready": {
+			oldISVC: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Obj(),
+			newISVC: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("new-queue").
+				Obj(),
+		},
+		"update queue when ready": {
+			oldISVC: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Ready().
+				Obj(),
+			newISVC: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("new-queue").
+				Ready().
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/queue-name]",
+				},
+			}.ToAggregate(),
+		},
+		"delete priority class": {
+			oldISVC: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(controllerconstants.WorkloadPriorityClassLabel, "high").
+				Obj(),
+			newISVC: testingisvc.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/priority-class]",
+				},
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			w := &Webhook{}
+			_, err := w.ValidateUpdate(ctx, tc.oldISVC, tc.newISVC)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -54,6 +55,7 @@ var (
 const (
 	JobMinParallelismAnnotation              = "kueue.x-k8s.io/job-min-parallelism"
 	JobCompletionsEqualParallelismAnnotation = "kueue.x-k8s.io/job-completions-equal-parallelism"
+	JobIndependentTasksAnnotation            = "kueue.x-k8s.io/job-independent-tasks"
 	StoppingAnnotation                       = "kueue.x-k8s.io/stopping"
 )
 
@@ -141,6 +143,7 @@ var _ jobframework.GenericJob = (*Job)(nil)
 var _ jobframework.JobWithReclaimablePods = (*Job)(nil)
 var _ jobframework.JobWithCustomStop = (*Job)(nil)
 var _ jobframework.JobWithManagedBy = (*Job)(nil)
+var _ jobframework.JobWithTasks = (*Job)(nil)
 
 func (j *Job) Object() client.Object {
 	return (*batchv1.Job)(j)
@@ -212,11 +215,13 @@ func (j *Job) PodLabelSelector() string {
 
 func (j *Job) ReclaimablePods(ctx context.Context) ([]kueue.ReclaimablePod, error) {
 	parallelism := ptr.Deref(j.Spec.Parallelism, 1)
-	if parallelism == 1 || j.Status.Succeeded == 0 {
+	// The failed indexes, when the job uses backoffLimitPerIndex, are not retried.
+	finished := j.Status.Succeeded + countIndexes(ptr.Deref(j.Status.FailedIndexes, ""))
+	if parallelism == 1 || finished == 0 {
 		return nil, nil
 	}
 
-	remaining := ptr.Deref(j.Spec.Completions, parallelism) - j.Status.Succeeded
+	remaining := max(0, ptr.Deref(j.Spec.Completions, parallelism)-finished)
 	if remaining >= parallelism {
 		return nil, nil
 	}
@@ -276,7 +281,7 @@ func (j *Job) RunWithPodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSe
 
 	info := podSetsInfo[0]
 
-	if j.minPodsCount() != nil {
+	if j.scalesParallelism() {
 		j.Spec.Parallelism = ptr.To(info.Count)
 		if j.syncCompletionWithParallelism() {
 			j.Spec.Completions = j.Spec.Parallelism
@@ -292,7 +297,7 @@ func (j *Job) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
 
 	changed := false
 	// if the job accepts partial admission
-	if j.minPodsCount() != nil && ptr.Deref(j.Spec.Parallelism, 0) != podSetsInfo[0].Count {
+	if j.scalesParallelism() && ptr.Deref(j.Spec.Parallelism, 0) != podSetsInfo[0].Count {
 		changed = true
 		j.Spec.Parallelism = ptr.To(podSetsInfo[0].Count)
 		if j.syncCompletionWithParallelism() {
@@ -328,6 +333,29 @@ func (j *Job) PodsReady(ctx context.Context) bool {
 	return j.Status.Succeeded+ready+int32(uncountedTerminatedSucceeded) >= j.podsCount()
 }
 
+func (j *Job) Tasks() *int32 {
+	if !j.hasIndependentTasks() {
+		return nil
+	}
+	return ptr.To(*j.Spec.Completions)
+}
+
+func (j *Job) TasksStatus() kueue.WorkloadTasksStatus {
+	return kueue.WorkloadTasksStatus{
+		Active:    j.Status.Active,
+		Succeeded: j.Status.Succeeded,
+		Failed:    countIndexes(ptr.Deref(j.Status.FailedIndexes, "")),
+	}
+}
+
+func (j *Job) SetAdmittedTasks(count int32) bool {
+	if ptr.Deref(j.Spec.Parallelism, 1) == count {
+		return false
+	}
+	j.Spec.Parallelism = ptr.To(count)
+	return true
+}
+
 func (j *Job) CanDefaultManagedBy() bool {
 	jobSpecManagedBy := j.Spec.ManagedBy
 	return features.Enabled(features.MultiKueue) &&
@@ -360,6 +388,52 @@ func (j *Job) minPodsCount() *int32 {
 	return nil
 }
 
+// hasIndependentTasks returns true if each index of the Indexed Job is an
+// independent task, which allows to admit the job as a bulk workload.
+func (j *Job) hasIndependentTasks() bool {
+	if j.Spec.Completions == nil || ptr.Deref(j.Spec.CompletionMode, batchv1.NonIndexedCompletion) != batchv1.IndexedCompletion {
+		return false
+	}
+	if strVal, found := j.GetAnnotations()[JobIndependentTasksAnnotation]; found {
+		if bVal, err := strconv.ParseBool(strVal); err == nil {
+			return bVal
+		}
+	}
+	return false
+}
+
+// scalesParallelism returns true if the parallelism of the job is set to the
+// count of pods admitted.
+func (j *Job) scalesParallelism() bool {
+	return j.minPodsCount() != nil || (features.Enabled(features.BulkWorkloads) && j.hasIndependentTasks())
+}
+
+// countIndexes returns the number of indexes in the text representation used
+// by the Job status, for example "1,3-5,7".
+func countIndexes(indexes string) int32 {
+	var count int32
+	for interval := range strings.SplitSeq(indexes, ",") {
+		if interval == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(interval, "-")
+		if !isRange {
+			count++
+			continue
+		}
+		firstIdx, err := strconv.Atoi(first)
+		if err != nil {
+			continue
+		}
+		lastIdx, err := strconv.Atoi(last)
+		if err != nil {
+			continue
+		}
+		count += int32(lastIdx - firstIdx + 1)
+	}
+	return count
+}
+
 func (j *Job) syncCompletionWithParallelism() bool {
 	if strVal, found := j.GetAnnotations()[JobCompletionsEqualParallelismAnnotation]; found {
 		if bVal, err := strconv.ParseBool(strVal); err == nil {
@@ -174,6 +174,96 @@ func TestPodsReady(t *testing.T) {
 	}
 }
 
+func TestTasks(t *testing.T) {
+	testcases := map[string]struct {
+		job             *batchv1.Job
+		wantTasks       *int32
+		wantTasksStatus kueue.WorkloadTasksStatus
+	}{
+		"without independent tasks": {
+			job:             utiltestingjob.MakeJob("job", "default").Parallelism(4).Completions(100).Indexed(true).Obj(),
+			wantTasksStatus: kueue.WorkloadTasksStatus{},
+		},
+		"independent tasks of a NonIndexed job": {
+			job: utiltestingjob.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(100).
+				SetAnnotation(JobIndependentTasksAnnotation, "true").
+				Obj(),
+			wantTasksStatus: kueue.WorkloadTasksStatus{},
+		},
+		"independent tasks": {
+			job: utiltestingjob.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(100).
+				SetAnnotation(JobIndependentTasksAnnotation, "true").
+				Indexed(true).
+				Active(4).
+				Succeeded(20).
+				FailedIndexes("1,3-5,10").
+				Obj(),
+			wantTasks: ptr.To[int32](100),
+			wantTasksStatus: kueue.WorkloadTasksStatus{
+				Active:    4,
+				Succeeded: 20,
+				Failed:    5,
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			job := fromObject(tc.job)
+			if diff := cmp.Diff(tc.wantTasks, job.Tasks()); diff != "" {
+				t.Errorf("Unexpected tasks (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantTasksStatus, job.TasksStatus()); diff != "" {
+				t.Errorf("Unexpected tasks status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReclaimablePods(t *testing.T) {
+	baseJob := utiltestingjob.MakeJob("job", "default").Parallelism(4).Completions(10).Indexed(true)
+	testcases := map[string]struct {
+		job  *batchv1.Job
+		want []kueue.ReclaimablePod
+	}{
+		"no finished pods": {
+			job: baseJob.Clone().Obj(),
+		},
+		"remaining completions above parallelism": {
+			job: baseJob.Clone().Succeeded(5).Obj(),
+		},
+		"remaining completions below parallelism": {
+			job:  baseJob.Clone().Succeeded(8).Obj(),
+			want: []kueue.ReclaimablePod{{Name: kueue.DefaultPodSetName, Count: 2}},
+		},
+		"failed indexes are not retried": {
+			job:  baseJob.Clone().Succeeded(5).FailedIndexes("1,3-4").Obj(),
+			want: []kueue.ReclaimablePod{{Name: kueue.DefaultPodSetName, Count: 2}},
+		},
+		"all indexes failed": {
+			job:  baseJob.Clone().FailedIndexes("0-9").Obj(),
+			want: []kueue.ReclaimablePod{{Name: kueue.DefaultPodSetName, Count: 4}},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			got, err := fromObject(tc.job).ReclaimablePods(ctx)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected reclaimable pods (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestPodSetsInfo(t *testing.T) {
 	testcases := map[string]struct {
 		job                  *Job
@@ -597,6 +687,8 @@ func TestReconciler(t *testing.T) {
 		enableTopologyAwareScheduling                     bool
 		enableManagedJobsNamespaceSelectorAlwaysRespected bool
 		disableAssignQueueLabelsForPods                   bool
+		enableCheckpointAwareEviction                     bool
+		enableWorkloadDependencies                        bool
 
 		reconcilerOptions []jobframework.Option
 		job               batchv1.Job
@@ -1050,6 +1142,41 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"when workload is created, it has its owner MultiKueue cluster selector annotations": {
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(kueue.MultiKueueRequiredClusterSelectorAnnotation, "dataset=imagenet").
+				SetAnnotation(kueue.MultiKueuePreferredClusterSelectorAnnotation, "region=us-east1").
+				UID("test-uid").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				SetAnnotation(kueue.MultiKueueRequiredClusterSelectorAnnotation, "dataset=imagenet").
+				SetAnnotation(kueue.MultiKueuePreferredClusterSelectorAnnotation, "region=us-east1").
+				UID("test-uid").
+				Suspend(true).
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("job", "ns").
+					Annotations(map[string]string{
+						kueue.MultiKueueRequiredClusterSelectorAnnotation:  "dataset=imagenet",
+						kueue.MultiKueuePreferredClusterSelectorAnnotation: "region=us-east1",
+					}).
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue(localQueueName).
+					Priority(0).
+					Labels(map[string]string{controllerconsts.JobUIDLabel: "test-uid"}).
+					Obj(),
+			},
+
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CreatedWorkload",
+					Message:   "Created Workload: ns/" + GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"),
+				},
+			},
+		},
 		"when workload is created, it has correct labels set": {
 			job: *baseJobWrapper.Clone().
 				Label("toCopyKey", "toCopyValue").
@@ -2052,6 +2179,313 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"when workload is evicted and the job is opted in to the checkpoint-aware eviction, checkpoint is requested": {
+			enableCheckpointAwareEviction: true,
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "2m").
+				Suspend(false).
+				Active(10).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "2m").
+				Suspend(false).
+				Active(10).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadCheckpointRequested,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadCheckpointPending,
+						Message: "Checkpoint requested before the eviction: Preempted",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CheckpointRequested",
+					Message:   "Waiting up to 2m0s for the checkpoint before stopping the job",
+				},
+			},
+		},
+		"when workload is evicted and the job is opted in to the checkpoint-aware eviction, the grace period is capped to the configured maximum": {
+			enableCheckpointAwareEviction: true,
+			reconcilerOptions: []jobframework.Option{
+				jobframework.WithCheckpointAwareEviction(&configapi.CheckpointAwareEviction{
+					MaxGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				}),
+			},
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "1h").
+				Suspend(false).
+				Active(10).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "1h").
+				Suspend(false).
+				Active(10).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadCheckpointRequested,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadCheckpointPending,
+						Message: "Checkpoint requested before the eviction: Preempted",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CheckpointRequested",
+					Message:   "Waiting up to 5m0s for the checkpoint before stopping the job",
+				},
+			},
+		},
+		"when workload is evicted and the checkpoint is pending within the grace period, job keeps running": {
+			enableCheckpointAwareEviction: true,
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "2m").
+				SetAnnotation(controllerconsts.CheckpointRequestedAnnotation, now.UTC().Format(time.RFC3339)).
+				Suspend(false).
+				Active(10).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(false).
+				Active(10).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadCheckpointRequested,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadCheckpointPending,
+						Message:            "Checkpoint requested before the eviction: Preempted",
+						LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadCheckpointRequested,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadCheckpointPending,
+						Message: "Checkpoint requested before the eviction: Preempted",
+					}).
+					Obj(),
+			},
+		},
+		"when workload is evicted and the checkpoint is completed, job gets suspended": {
+			enableCheckpointAwareEviction: true,
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "2m").
+				SetAnnotation(controllerconsts.CheckpointRequestedAnnotation, now.UTC().Format(time.RFC3339)).
+				SetAnnotation(controllerconsts.CheckpointCompleteAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(true).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadCheckpointRequested,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadCheckpointPending,
+						Message:            "Checkpoint requested before the eviction: Preempted",
+						LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					PastAdmittedTime(60).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadAdmitted,
+						Status:  metav1.ConditionFalse,
+						Reason:  "NoReservation",
+						Message: "The workload has no reservation",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadQuotaReserved,
+						Status:  metav1.ConditionFalse,
+						Reason:  "Pending",
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadRequeued,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadCheckpointRequested,
+						Status:  metav1.ConditionFalse,
+						Reason:  kueue.WorkloadCheckpointCompleted,
+						Message: "The job acknowledged the checkpoint",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "Stopped",
+					Message:   "Preempted",
+				},
+			},
+		},
+		"when workload is evicted and the checkpoint grace period expired, job gets suspended": {
+			enableCheckpointAwareEviction: true,
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.CheckpointGracePeriodAnnotation, "2m").
+				SetAnnotation(controllerconsts.CheckpointRequestedAnnotation, now.Add(-3*time.Minute).UTC().Format(time.RFC3339)).
+				Suspend(false).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(true).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, now.Add(-5*time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(now.Add(-3 * time.Minute)),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadCheckpointRequested,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadCheckpointPending,
+						Message:            "Checkpoint requested before the eviction: Preempted",
+						LastTransitionTime: metav1.NewTime(now.Add(-3 * time.Minute)),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					PastAdmittedTime(300).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadAdmitted,
+						Status:  metav1.ConditionFalse,
+						Reason:  "NoReservation",
+						Message: "The workload has no reservation",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadQuotaReserved,
+						Status:  metav1.ConditionFalse,
+						Reason:  "Pending",
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadRequeued,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadCheckpointRequested,
+						Status:  metav1.ConditionFalse,
+						Reason:  kueue.WorkloadCheckpointTimeout,
+						Message: "The checkpoint was not completed within 2m0s",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Warning",
+					Reason:    "CheckpointTimeout",
+					Message:   "The checkpoint was not completed within 2m0s, stopping the job",
+				},
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "Stopped",
+					Message:   "Preempted",
+				},
+			},
+		},
 		"when job is initially suspended, the Workload has active=false and it's not admitted, " +
 			"it should not get an evicted condition, but the job should remain suspended": {
 			job: *baseJobWrapper.Clone().
@@ -3865,6 +4299,33 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"the dependencies are passed to the created workload": {
+			enableWorkloadDependencies: true,
+			job: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.DependenciesAnnotation, `[{"name":"prepare"}]`).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				SetAnnotation(controllerconsts.DependenciesAnnotation, `[{"name":"prepare"}]`).
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("job", "ns").
+					Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare"), Condition: kueue.WorkloadDependencySucceeded}).
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue(localQueueName).
+					Priority(0).
+					Labels(map[string]string{controllerconsts.JobUIDLabel: string(baseJobWrapper.GetUID())}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CreatedWorkload",
+					Message:   "Created Workload: ns/" + GetWorkloadNameForJob(baseJobWrapper.Name, baseJobWrapper.GetUID()),
+				},
+			},
+		},
 		"the maximum execution time is updated in the workload": {
 			job: *baseJobWrapper.Clone().
 				Label(controllerconsts.MaxExecTimeSecondsLabel, "10").
@@ -3974,6 +4435,8 @@ func TestReconciler(t *testing.T) {
 				features.SetFeatureGateDuringTest(t, features.ManagedJobsNamespaceSelectorAlwaysRespected, tc.enableManagedJobsNamespaceSelectorAlwaysRespected)
 				features.SetFeatureGateDuringTest(t, features.WorkloadRequestUseMergePatch, enabled)
 				features.SetFeatureGateDuringTest(t, features.AssignQueueLabelsForPods, !tc.disableAssignQueueLabelsForPods)
+				features.SetFeatureGateDuringTest(t, features.CheckpointAwareEviction, tc.enableCheckpointAwareEviction)
+				features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, tc.enableWorkloadDependencies)
 
 				ctx, _ := utiltesting.ContextWithLog(t)
 				clientBuilder := utiltesting.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge})
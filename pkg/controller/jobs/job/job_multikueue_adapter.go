@@ -217,3 +217,9 @@ func syncElasticJob(ctx context.Context, remoteClient client.Client, log logr.Lo
 
 	return nil
 }
+
+var _ jobframework.MultiKueuePodsSelector = (*multiKueueAdapter)(nil)
+
+func (*multiKueueAdapter) RemotePodsSelector(key types.NamespacedName) map[string]string {
+	return map[string]string{batchv1.JobNameLabel: key.Name}
+}
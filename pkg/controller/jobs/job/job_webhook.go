@@ -40,9 +40,10 @@ import (
 )
 
 var (
-	minPodsCountAnnotationsPath   = field.NewPath("metadata", "annotations").Key(JobMinParallelismAnnotation)
-	syncCompletionAnnotationsPath = field.NewPath("metadata", "annotations").Key(JobCompletionsEqualParallelismAnnotation)
-	replicaMetaPath               = field.NewPath("spec", "template", "metadata")
+	minPodsCountAnnotationsPath    = field.NewPath("metadata", "annotations").Key(JobMinParallelismAnnotation)
+	syncCompletionAnnotationsPath  = field.NewPath("metadata", "annotations").Key(JobCompletionsEqualParallelismAnnotation)
+	independentTasksAnnotationPath = field.NewPath("metadata", "annotations").Key(JobIndependentTasksAnnotation)
+	replicaMetaPath                = field.NewPath("spec", "template", "metadata")
 )
 
 // applyWorkloadSliceSchedulingGate ensures that the workload slice-specific
@@ -132,6 +133,7 @@ func (w *JobWebhook) validateCreate(ctx context.Context, job *Job) (field.ErrorL
 	allErrs = append(allErrs, jobframework.ValidateJobOnCreate(job)...)
 	allErrs = append(allErrs, w.validatePartialAdmissionCreate(job)...)
 	allErrs = append(allErrs, w.validateSyncCompletionCreate(job)...)
+	allErrs = append(allErrs, w.validateIndependentTasksCreate(job)...)
 	if features.Enabled(features.TopologyAwareScheduling) {
 		validationErrs, err := w.validateTopologyRequest(ctx, job)
 		if err != nil {
@@ -174,6 +176,36 @@ func (w *JobWebhook) validateSyncCompletionCreate(job *Job) field.ErrorList {
 	return allErrs
 }
 
+func (w *JobWebhook) validateIndependentTasksCreate(job *Job) field.ErrorList {
+	var allErrs field.ErrorList
+	if strVal, found := job.Annotations[JobIndependentTasksAnnotation]; found {
+		enabled, err := strconv.ParseBool(strVal)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(independentTasksAnnotationPath, strVal, err.Error()))
+		}
+		if enabled {
+			if ptr.Deref(job.Spec.CompletionMode, batchv1.NonIndexedCompletion) != batchv1.IndexedCompletion {
+				allErrs = append(allErrs, field.Invalid(independentTasksAnnotationPath, strVal, "should not be enabled for NonIndexed jobs"))
+			}
+			if job.Spec.Completions == nil {
+				allErrs = append(allErrs, field.Required(field.NewPath("spec", "completions"), fmt.Sprintf("when %s annotation is true", JobIndependentTasksAnnotation)))
+			}
+			if _, found := job.Annotations[JobMinParallelismAnnotation]; found {
+				allErrs = append(allErrs, field.Invalid(independentTasksAnnotationPath, strVal, fmt.Sprintf("should not be enabled together with %s", JobMinParallelismAnnotation)))
+			}
+			if job.syncCompletionWithParallelism() {
+				allErrs = append(allErrs, field.Invalid(independentTasksAnnotationPath, strVal, fmt.Sprintf("should not be enabled together with %s", JobCompletionsEqualParallelismAnnotation)))
+			}
+			for _, key := range []string{kueue.PodSetRequiredTopologyAnnotation, kueue.PodSetPreferredTopologyAnnotation, kueue.PodSetUnconstrainedTopologyAnnotation} {
+				if _, found := job.Spec.Template.Annotations[key]; found {
+					allErrs = append(allErrs, field.Forbidden(replicaMetaPath.Child("annotations").Key(key), fmt.Sprintf("topology-aware scheduling is not supported when %s annotation is true", JobIndependentTasksAnnotation)))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (w *JobWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj *batchv1.Job) (admission.Warnings, error) {
 	oldJob := fromObject(oldObj)
@@ -194,6 +226,7 @@ func (w *JobWebhook) validateUpdate(ctx context.Context, oldJob, newJob *Job) (f
 		allErrs = append(allErrs, w.validatePartialAdmissionCreate(newJob)...)
 	}
 	allErrs = append(allErrs, w.validateSyncCompletionCreate(newJob)...)
+	allErrs = append(allErrs, w.validateIndependentTasksCreate(newJob)...)
 	allErrs = append(allErrs, jobframework.ValidateJobOnUpdate(oldJob, newJob, w.queues.DefaultLocalQueueExist)...)
 	allErrs = append(allErrs, validatePartialAdmissionUpdate(oldJob, newJob)...)
 	if features.Enabled(features.TopologyAwareScheduling) {
@@ -216,6 +249,9 @@ func validatePartialAdmissionUpdate(oldJob, newJob *Job) field.ErrorList {
 	if oldJob.IsSuspended() == newJob.IsSuspended() && !newJob.IsSuspended() && oldJob.syncCompletionWithParallelism() != newJob.syncCompletionWithParallelism() {
 		allErrs = append(allErrs, field.Forbidden(syncCompletionAnnotationsPath, fmt.Sprintf("%s while the job is not suspended", apivalidation.FieldImmutableErrorMsg)))
 	}
+	if oldJob.IsSuspended() == newJob.IsSuspended() && !newJob.IsSuspended() && oldJob.hasIndependentTasks() != newJob.hasIndependentTasks() {
+		allErrs = append(allErrs, field.Forbidden(independentTasksAnnotationPath, fmt.Sprintf("%s while the job is not suspended", apivalidation.FieldImmutableErrorMsg)))
+	}
 	return allErrs
 }
 
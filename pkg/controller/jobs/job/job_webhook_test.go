@@ -59,6 +59,7 @@ var (
 	queueNameLabelPath            = labelsPath.Key(constants.QueueLabel)
 	prebuiltWlNameLabelPath       = labelsPath.Key(constants.PrebuiltWorkloadLabel)
 	maxExecTimeLabelPath          = labelsPath.Key(constants.MaxExecTimeSecondsLabel)
+	dependenciesAnnotationPath    = field.NewPath("metadata", "annotations").Key(constants.DependenciesAnnotation)
 	workloadPriorityClassNamePath = labelsPath.Key(constants.WorkloadPriorityClassLabel)
 )
 
@@ -70,6 +71,7 @@ func TestValidateCreate(t *testing.T) {
 		wantErr                      error
 		topologyAwareScheduling      bool
 		elasticJobsViaWorkloadSlices bool
+		spreadTopology               bool
 	}{
 		{
 			name:               "simple",
@@ -169,6 +171,54 @@ func TestValidateCreate(t *testing.T) {
 				Obj(),
 			wantValidationErrs: nil,
 		},
+		{
+			name: "valid independent tasks annotation",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(100).
+				SetAnnotation(JobIndependentTasksAnnotation, "true").
+				Indexed(true).
+				Obj(),
+			wantValidationErrs: nil,
+		},
+		{
+			name: "independent tasks annotation, wrong job completions type",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(100).
+				SetAnnotation(JobIndependentTasksAnnotation, "true").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(independentTasksAnnotationPath, "true", "should not be enabled for NonIndexed jobs"),
+			},
+		},
+		{
+			name: "independent tasks annotation together with min parallelism",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(100).
+				SetAnnotation(JobIndependentTasksAnnotation, "true").
+				SetAnnotation(JobMinParallelismAnnotation, "2").
+				Indexed(true).
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(independentTasksAnnotationPath, "true", fmt.Sprintf("should not be enabled together with %s", JobMinParallelismAnnotation)),
+			},
+		},
+		{
+			name: "independent tasks annotation together with a topology request",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(100).
+				SetAnnotation(JobIndependentTasksAnnotation, "true").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				Indexed(true).
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key(kueue.PodSetRequiredTopologyAnnotation),
+					fmt.Sprintf("topology-aware scheduling is not supported when %s annotation is true", JobIndependentTasksAnnotation)),
+			},
+		},
 		{
 			name: "invalid prebuilt workload",
 			job: testingutil.MakeJob("job", "default").
@@ -236,6 +286,34 @@ func TestValidateCreate(t *testing.T) {
 				Indexed(true).
 				Obj(),
 		},
+		{
+			name: "invalid dependencies",
+			job: testingutil.MakeJob("job", "default").
+				SetAnnotation(constants.DependenciesAnnotation, `[{"name":"prepare","selector":{}},{"name":"train","condition":"Started"}]`).
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(dependenciesAnnotationPath, `[{"name":"prepare","selector":{}},{"name":"train","condition":"Started"}]`,
+					"exactly one of name or selector must be set in the dependency 0"),
+				field.NotSupported(dependenciesAnnotationPath, kueue.WorkloadDependencyCondition("Started"),
+					[]kueue.WorkloadDependencyCondition{kueue.WorkloadDependencySucceeded, kueue.WorkloadDependencyFinished}),
+			},
+		},
+		{
+			name: "malformed dependencies",
+			job: testingutil.MakeJob("job", "default").
+				SetAnnotation(constants.DependenciesAnnotation, "prepare").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(dependenciesAnnotationPath, "prepare",
+					"expected a JSON list of dependencies: invalid character 'p' looking for beginning of value"),
+			},
+		},
+		{
+			name: "valid dependencies",
+			job: testingutil.MakeJob("job", "default").
+				SetAnnotation(constants.DependenciesAnnotation, `[{"name":"prepare"},{"selector":{"matchLabels":{"stage":"download"}},"condition":"Finished"}]`).
+				Obj(),
+		},
 		{
 			name: "valid topology request",
 			job: testingutil.MakeJob("job", "default").
@@ -357,6 +435,86 @@ func TestValidateCreate(t *testing.T) {
 			},
 			topologyAwareScheduling: true,
 		},
+		{
+			name: "valid topology request - spread topology",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetSpreadTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetSpreadMinDomainsAnnotation, "2").
+				PodAnnotation(kueue.PodSetSpreadMaxPodsPerDomainAnnotation, "4").
+				Obj(),
+			topologyAwareScheduling: true,
+			spreadTopology:          true,
+		},
+		{
+			name: "invalid topology request - spread topology with required topology",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetSpreadTopologyAnnotation, "cloud.com/rack").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-spread-topology"), "may not be set when 'kueue.x-k8s.io/podset-required-topology' is specified"),
+			},
+			topologyAwareScheduling: true,
+			spreadTopology:          true,
+		},
+		{
+			name: "invalid topology request - spread min domains is zero",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetSpreadTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetSpreadMinDomainsAnnotation, "0").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-spread-min-domains"), "0", "must be greater than or equal to 1"),
+			},
+			topologyAwareScheduling: true,
+			spreadTopology:          true,
+		},
+		{
+			name: "invalid topology request - spread max pods per domain without spread topology",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetSpreadMaxPodsPerDomainAnnotation, "not a number").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-spread-max-pods-per-domain"), "may not be set when 'kueue.x-k8s.io/podset-spread-topology' is not specified"),
+				field.Invalid(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-spread-max-pods-per-domain"), "not a number", "must be a numeric value"),
+			},
+			topologyAwareScheduling: true,
+			spreadTopology:          true,
+		},
+		{
+			name: "invalid topology request - spread topology with podset group name",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetSpreadTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetGroupName, "group").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-group-name"), "may not be set when 'kueue.x-k8s.io/podset-spread-topology' is specified"),
+			},
+			topologyAwareScheduling: true,
+			spreadTopology:          true,
+		},
+		{
+			name: "spread topology ignored when the feature is disabled",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetSpreadTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetSpreadMinDomainsAnnotation, "0").
+				Obj(),
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "invalid topology request - podset topology constraints",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetSameTopologyDomainAsAnnotation, "worker=cloud.com/block,worker=cloud.com/block").
+				PodAnnotation(kueue.PodSetDifferentTopologyDomainFromAnnotation, "worker").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-same-topology-domain-as"), "may not be set when no topology annotation is specified"),
+				field.Invalid(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-same-topology-domain-as"), "worker=cloud.com/block,worker=cloud.com/block", `must not constrain the topology relative to PodSet "worker" at level "cloud.com/block" more than once`),
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-different-topology-domain-from"), "may not be set when no topology annotation is specified"),
+				field.Invalid(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-different-topology-domain-from"), "worker", `"worker" is not in the <podset-name>=<topology-level> format`),
+			},
+			topologyAwareScheduling: true,
+		},
 		{
 			name: "valid topology request - slice-only topology",
 			job: testingutil.MakeJob("job", "default").
@@ -422,6 +580,7 @@ func TestValidateCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, tc.topologyAwareScheduling)
 			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobsViaWorkloadSlices)
+			features.SetFeatureGateDuringTest(t, features.TASSpreadTopology, tc.spreadTopology)
 
 			jw := &JobWebhook{}
 
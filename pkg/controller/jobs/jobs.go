@@ -19,16 +19,20 @@ package jobs
 // Reference the job framework integration packages to ensure linking.
 import (
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/appwrapper"
+	_ "sigs.k8s.io/kueue/pkg/controller/jobs/argoworkflow"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/deployment"
+	_ "sigs.k8s.io/kueue/pkg/controller/jobs/inferenceservice"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/job"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/jobset"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/kubeflow/jobs"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/leaderworkerset"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/mpijob"
+	_ "sigs.k8s.io/kueue/pkg/controller/jobs/pipelinerun"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/pod"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/raycluster"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/rayjob"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/rayservice"
+	_ "sigs.k8s.io/kueue/pkg/controller/jobs/sparkapplication"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/statefulset"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/trainjob"
 )
@@ -122,3 +122,9 @@ func (*multiKueueAdapter) WorkloadKeysFor(o runtime.Object) ([]types.NamespacedN
 
 	return []types.NamespacedName{{Name: prebuiltWl, Namespace: jobSet.Namespace}}, nil
 }
+
+var _ jobframework.MultiKueuePodsSelector = (*multiKueueAdapter)(nil)
+
+func (*multiKueueAdapter) RemotePodsSelector(key types.NamespacedName) map[string]string {
+	return map[string]string{jobset.JobSetNameKey: key.Name}
+}
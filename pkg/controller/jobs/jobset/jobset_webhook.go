@@ -33,10 +33,12 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/podset"
 	"sigs.k8s.io/kueue/pkg/util/webhook"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 var (
-	replicatedJobsPath = field.NewPath("spec", "replicatedJobs")
+	replicatedJobsPath       = field.NewPath("spec", "replicatedJobs")
+	elasticJobAnnotationPath = field.NewPath("metadata", "annotations").Key(workloadslicing.EnabledAnnotationKey)
 )
 
 type JobSetWebhook struct {
@@ -131,6 +133,11 @@ func (w *JobSetWebhook) validateUpdate(ctx context.Context, oldJob, newJob *JobS
 func (w *JobSetWebhook) validateCreate(ctx context.Context, jobSet *JobSet) (field.ErrorList, error) {
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, jobframework.ValidateJobOnCreate(jobSet)...)
+	// Workload slicing is deferred for JobSets until the JobSet webhook allows
+	// changing the replicated jobs of a running JobSet, which it rejects.
+	if jobframework.WorkloadSliceEnabled(jobSet) {
+		allErrs = append(allErrs, field.Forbidden(elasticJobAnnotationPath, "workload slicing is not supported for JobSets yet, the replicated jobs of a running JobSet can't be changed"))
+	}
 	if features.Enabled(features.TopologyAwareScheduling) {
 		validationErrs, err := w.validateTopologyRequest(ctx, jobSet)
 		if err != nil {
@@ -147,7 +154,9 @@ func (w *JobSetWebhook) validateTopologyRequest(ctx context.Context, jobSet *Job
 	podSets, podSetsErr := jobframework.JobPodSets(ctx, jobSet)
 
 	if podSetsErr == nil {
-		allErrs = append(allErrs, jobframework.ValidatePodSetGroupingTopology(podSets, buildPodSetAnnotationsPathByNameMap(jobSet))...)
+		podSetAnnotationsPathByName := buildPodSetAnnotationsPathByNameMap(jobSet)
+		allErrs = append(allErrs, jobframework.ValidatePodSetGroupingTopology(podSets, podSetAnnotationsPathByName)...)
+		allErrs = append(allErrs, jobframework.ValidatePodSetTopologyConstraints(podSets, podSetAnnotationsPathByName)...)
 	}
 
 	for i, rj := range jobSet.Spec.ReplicatedJobs {
@@ -35,6 +35,7 @@ import (
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	testingutil "sigs.k8s.io/kueue/pkg/util/testingjobs/jobset"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 const (
@@ -52,12 +53,33 @@ func TestValidateCreate(t *testing.T) {
 		job                     *jobset.JobSet
 		wantErr                 error
 		topologyAwareScheduling bool
+		elasticJobs             bool
 	}{
 		{
 			name:    "simple",
 			job:     testingutil.MakeJobSet("job", "default").Queue("queue").Obj(),
 			wantErr: nil,
 		},
+		{
+			name: "elastic jobset",
+			job: testingutil.MakeJobSet("job", "default").
+				Queue("queue").
+				Annotations(map[string]string{workloadslicing.EnabledAnnotationKey: workloadslicing.EnabledAnnotationValue}).
+				ReplicatedJobs(testingutil.ReplicatedJobRequirements{Name: "workers", Replicas: 2, Parallelism: 1, Completions: 1}).
+				Obj(),
+			elasticJobs: true,
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("metadata", "annotations").Key(workloadslicing.EnabledAnnotationKey), "workload slicing is not supported for JobSets yet, the replicated jobs of a running JobSet can't be changed"),
+			}.ToAggregate(),
+		},
+		{
+			name: "elastic jobset when the feature is disabled",
+			job: testingutil.MakeJobSet("job", "default").
+				Queue("queue").
+				Annotations(map[string]string{workloadslicing.EnabledAnnotationKey: workloadslicing.EnabledAnnotationValue}).
+				ReplicatedJobs(testingutil.ReplicatedJobRequirements{Name: "workers", Replicas: 2, Parallelism: 1, Completions: 1}).
+				Obj(),
+		},
 		{
 			name:    "invalid queue-name label",
 			job:     testingutil.MakeJobSet("job", "default").Queue("queue_name").Obj(),
@@ -281,6 +303,7 @@ func TestValidateCreate(t *testing.T) {
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, tc.topologyAwareScheduling)
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobs)
 
 			jsw := &JobSetWebhook{}
 			ctx, _ := utiltesting.ContextWithLog(t)
@@ -359,6 +382,7 @@ func TestDefault(t *testing.T) {
 		clusterQueues     []kueue.ClusterQueue
 		admissionCheck    *kueue.AdmissionCheck
 		multiKueueEnabled bool
+		elasticJobs       bool
 		defaultLqExist    bool
 		want              *jobset.JobSet
 		wantManagedBy     *string
@@ -565,6 +589,18 @@ func TestDefault(t *testing.T) {
 			jobSet:         testingutil.MakeJobSet("test-js", "default").Queue("queue").Obj(),
 			want:           testingutil.MakeJobSet("test-js", "default").Queue("queue").Obj(),
 		},
+		{
+			name:        "elastic jobset is not gated",
+			elasticJobs: true,
+			jobSet: testingutil.MakeJobSet("test-js", "default").
+				Annotations(map[string]string{workloadslicing.EnabledAnnotationKey: workloadslicing.EnabledAnnotationValue}).
+				ReplicatedJobs(testingutil.ReplicatedJobRequirements{Name: "workers", Replicas: 2, Parallelism: 1, Completions: 1}).
+				Obj(),
+			want: testingutil.MakeJobSet("test-js", "default").
+				Annotations(map[string]string{workloadslicing.EnabledAnnotationKey: workloadslicing.EnabledAnnotationValue}).
+				ReplicatedJobs(testingutil.ReplicatedJobRequirements{Name: "workers", Replicas: 2, Parallelism: 1, Completions: 1}).
+				Obj(),
+		},
 		{
 			name:           "default lq isn't created, job doesn't have queue label",
 			defaultLqExist: false,
@@ -576,6 +612,7 @@ func TestDefault(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.MultiKueue, tc.multiKueueEnabled)
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobs)
 
 			ctx, log := utiltesting.ContextWithLog(t)
 
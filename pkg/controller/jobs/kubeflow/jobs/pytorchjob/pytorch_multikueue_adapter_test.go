@@ -34,9 +34,11 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/controller/jobs/kubeflow/kubeflowjob"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/slices"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	kfutiltesting "sigs.k8s.io/kueue/pkg/util/testingjobs/pytorchjob"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 const (
@@ -51,6 +53,12 @@ func TestMultiKueueAdapter(t *testing.T) {
 
 	pyTorchJobBuilder := kfutiltesting.MakePyTorchJob("pytorchjob1", TestNamespace).Queue("queue").Suspend(false)
 	pyTorchJobManagedByKueueBuilder := pyTorchJobBuilder.Clone().ManagedBy(kueue.MultiKueueControllerName)
+	elasticPyTorchJobBuilder := pyTorchJobBuilder.Clone().
+		PyTorchReplicaSpecsDefault().
+		UID("uid").
+		Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue)
+	elasticPyTorchJobManagedByKueueBuilder := elasticPyTorchJobBuilder.Clone().ManagedBy(kueue.MultiKueueControllerName)
+	scaledUpWorkloadName := jobframework.GetWorkloadNameForOwnerWithGVKAndGeneration("pytorchjob1", "uid", gvk, 0)
 
 	cases := map[string]struct {
 		managersPyTorchJobs []kftraining.PyTorchJob
@@ -58,6 +66,8 @@ func TestMultiKueueAdapter(t *testing.T) {
 
 		operation func(ctx context.Context, adapter jobframework.MultiKueueAdapter, managerClient, workerClient client.Client) error
 
+		elasticJobs bool
+
 		wantError               error
 		wantManagersPyTorchJobs []kftraining.PyTorchJob
 		wantWorkerPyTorchJobs   []kftraining.PyTorchJob
@@ -80,6 +90,58 @@ func TestMultiKueueAdapter(t *testing.T) {
 					Obj(),
 			},
 		},
+		"sync scales the remote elastic pytorchjob up": {
+			managersPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobManagedByKueueBuilder.Clone().Parallelism(3).Obj(),
+			},
+			workerPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobBuilder.Clone().
+					Parallelism(2).
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+			elasticJobs: true,
+			operation: func(ctx context.Context, adapter jobframework.MultiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.SyncJob(ctx, managerClient, workerClient, types.NamespacedName{Name: "pytorchjob1", Namespace: TestNamespace}, scaledUpWorkloadName, "origin1")
+			},
+			wantManagersPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobManagedByKueueBuilder.Clone().Parallelism(3).Obj(),
+			},
+			wantWorkerPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobBuilder.Clone().
+					Parallelism(3).
+					Label(constants.PrebuiltWorkloadLabel, scaledUpWorkloadName).
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+		},
+		"sync skips the stale workload slice of the scaled up elastic pytorchjob": {
+			managersPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobManagedByKueueBuilder.Clone().Parallelism(3).Obj(),
+			},
+			workerPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobBuilder.Clone().
+					Parallelism(2).
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+			elasticJobs: true,
+			operation: func(ctx context.Context, adapter jobframework.MultiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.SyncJob(ctx, managerClient, workerClient, types.NamespacedName{Name: "pytorchjob1", Namespace: TestNamespace}, "wl1", "origin1")
+			},
+			wantManagersPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobManagedByKueueBuilder.Clone().Parallelism(3).Obj(),
+			},
+			wantWorkerPyTorchJobs: []kftraining.PyTorchJob{
+				*elasticPyTorchJobBuilder.Clone().
+					Parallelism(2).
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+		},
 		"sync status from remote pytorchjob": {
 			managersPyTorchJobs: []kftraining.PyTorchJob{
 				*pyTorchJobBuilder.Clone().Obj(),
@@ -190,6 +252,8 @@ func TestMultiKueueAdapter(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobs)
+
 			managerBuilder := utiltesting.NewClientBuilder(kftraining.AddToScheme).WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge})
 			managerBuilder = managerBuilder.WithLists(&kftraining.PyTorchJobList{Items: tc.managersPyTorchJobs})
 			managerBuilder = managerBuilder.WithStatusSubresource(slices.Map(tc.managersPyTorchJobs, func(w *kftraining.PyTorchJob) client.Object { return w })...)
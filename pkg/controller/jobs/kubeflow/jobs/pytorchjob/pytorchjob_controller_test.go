@@ -30,6 +30,7 @@ import (
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	testingpytorchjob "sigs.k8s.io/kueue/pkg/util/testingjobs/pytorchjob"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 func TestPriorityClass(t *testing.T) {
@@ -347,7 +348,52 @@ func TestValidate(t *testing.T) {
 		wantValidationErrs      field.ErrorList
 		wantErr                 error
 		topologyAwareScheduling bool
+		elasticJobs             bool
 	}{
+		"elastic job with the scheduling gate": {
+			job: testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				PyTorchReplicaSpecs(
+					testingpytorchjob.PyTorchReplicaSpecRequirement{
+						ReplicaType:  kftraining.PyTorchJobReplicaTypeMaster,
+						ReplicaCount: 1,
+					},
+					testingpytorchjob.PyTorchReplicaSpecRequirement{
+						ReplicaType:  kftraining.PyTorchJobReplicaTypeWorker,
+						ReplicaCount: 2,
+					},
+				).
+				SchedulingGates(kftraining.PyTorchJobReplicaTypeMaster, corev1.PodSchedulingGate{Name: kueue.ElasticJobSchedulingGate}).
+				SchedulingGates(kftraining.PyTorchJobReplicaTypeWorker, corev1.PodSchedulingGate{Name: kueue.ElasticJobSchedulingGate}).
+				Obj(),
+			elasticJobs: true,
+		},
+		"elastic job without the scheduling gate": {
+			job: testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				PyTorchReplicaSpecs(
+					testingpytorchjob.PyTorchReplicaSpecRequirement{
+						ReplicaType:  kftraining.PyTorchJobReplicaTypeMaster,
+						ReplicaCount: 1,
+					},
+					testingpytorchjob.PyTorchReplicaSpecRequirement{
+						ReplicaType:  kftraining.PyTorchJobReplicaTypeWorker,
+						ReplicaCount: 2,
+					},
+				).
+				SchedulingGates(kftraining.PyTorchJobReplicaTypeMaster, corev1.PodSchedulingGate{Name: kueue.ElasticJobSchedulingGate}).
+				Obj(),
+			elasticJobs: true,
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec", "pytorchReplicaSpecs").
+						Key("Worker").
+						Child("template", "spec", "schedulingGates"),
+					[]corev1.PodSchedulingGate(nil),
+					"an elastic job must have the ElasticJobSchedulingGate",
+				),
+			},
+		},
 		"no annotations": {
 			job: testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").
 				PyTorchReplicaSpecs(
@@ -467,6 +513,7 @@ func TestValidate(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, tc.topologyAwareScheduling)
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobs)
 
 			ctx, _ := utiltesting.ContextWithLog(t)
 			gotValidationErrs, gotErr := fromObject(tc.job).ValidateOnCreate(ctx)
@@ -41,6 +41,7 @@ var _ jobframework.GenericJob = (*KubeflowJob)(nil)
 var _ jobframework.JobWithPriorityClass = (*KubeflowJob)(nil)
 var _ jobframework.JobWithCustomValidation = (*KubeflowJob)(nil)
 var _ jobframework.JobWithManagedBy = (*KubeflowJob)(nil)
+var _ jobframework.JobWithElasticPodTemplates = (*KubeflowJob)(nil)
 
 func (j *KubeflowJob) Object() client.Object {
 	return j.KFJobControl.Object()
@@ -182,17 +183,37 @@ func (j *KubeflowJob) OrderedReplicaTypes() []kftraining.ReplicaType {
 	return result
 }
 
+// ElasticPodTemplates returns the pod templates of the replicas.
+func (j *KubeflowJob) ElasticPodTemplates() []*corev1.PodTemplateSpec {
+	replicaTypes := j.OrderedReplicaTypes()
+	templates := make([]*corev1.PodTemplateSpec, len(replicaTypes))
+	for index, replicaType := range replicaTypes {
+		templates[index] = &j.KFJobControl.ReplicaSpecs()[replicaType].Template
+	}
+	return templates
+}
+
 func (j *KubeflowJob) ValidateOnCreate(ctx context.Context) (field.ErrorList, error) {
+	replicaSpecsPath := field.NewPath("spec", j.KFJobControl.ReplicaSpecsFieldName())
+	replicaTypes := j.OrderedReplicaTypes()
+
+	var allErrs field.ErrorList
+	if jobframework.WorkloadSliceEnabled(j) {
+		for _, replicaType := range replicaTypes {
+			allErrs = append(allErrs, jobframework.ValidateElasticJobSchedulingGate(
+				replicaSpecsPath.Key(string(replicaType)).Child("template"),
+				&j.KFJobControl.ReplicaSpecs()[replicaType].Template,
+			)...)
+		}
+	}
+
 	if !features.Enabled(features.TopologyAwareScheduling) {
-		return nil, nil
+		return allErrs, nil
 	}
 
 	podSets, podSetsErr := jobframework.JobPodSets(ctx, j)
 
-	var allErrs field.ErrorList
-	replicaTypes := j.OrderedReplicaTypes()
 	for _, replicaType := range replicaTypes {
-		replicaSpecsPath := field.NewPath("spec", j.KFJobControl.ReplicaSpecsFieldName())
 		allErrs = append(allErrs, jobframework.ValidateTASPodSetRequest(
 			replicaSpecsPath.Key(string(replicaType)).Child("template", "metadata"),
 			&j.KFJobControl.ReplicaSpecs()[replicaType].Template.ObjectMeta,
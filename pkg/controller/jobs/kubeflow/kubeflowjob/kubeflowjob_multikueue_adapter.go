@@ -26,12 +26,14 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 type objAsPtr[T any] interface {
@@ -108,11 +110,22 @@ func (a adapter[PtrT, T]) SyncJob(
 	}
 
 	if err == nil {
-		return clientutil.PatchStatus(ctx, localClient, localJob, func() (bool, error) {
-			// if the remote exists, just copy the status
+		if err := clientutil.PatchStatus(ctx, localClient, localJob, func() (bool, error) {
+			// if the remote exists, copy the status
 			a.copyStatus(localJob, remoteJob)
 			return true, nil
-		})
+		}); err != nil {
+			return err
+		}
+
+		// Sync elastic workload if needed.
+		localKJob, remoteKJob := a.fromObject(localJob), a.fromObject(remoteJob)
+		if jobframework.ElasticJobSyncNeeded(ctrl.LoggerFrom(ctx), workloadName, localKJob, remoteJob, podSetsCounts(localKJob), podSetsCounts(remoteKJob)) {
+			return jobframework.SyncElasticJob(ctx, remoteClient, workloadName, remoteJob, func() {
+				syncReplicas(remoteKJob, localKJob)
+			})
+		}
+		return nil
 	}
 
 	remoteJob = PtrT(new(T))
@@ -133,6 +146,25 @@ func (a adapter[PtrT, T]) SyncJob(
 	return remoteClient.Create(ctx, remoteJob)
 }
 
+func podSetsCounts(kJob *KubeflowJob) workload.PodSetsCounts {
+	replicaSpecs := kJob.KFJobControl.ReplicaSpecs()
+	counts := make(workload.PodSetsCounts, len(replicaSpecs))
+	for _, replicaType := range kJob.OrderedReplicaTypes() {
+		counts[kueue.NewPodSetReference(string(replicaType))] = podsCount(replicaSpecs, replicaType)
+	}
+	return counts
+}
+
+// syncReplicas sets the replicas of dst to the ones of src.
+func syncReplicas(dst, src *KubeflowJob) {
+	srcReplicaSpecs := src.KFJobControl.ReplicaSpecs()
+	for replicaType, replicaSpec := range dst.KFJobControl.ReplicaSpecs() {
+		if srcReplicaSpec, found := srcReplicaSpecs[replicaType]; found && replicaSpec != nil && srcReplicaSpec != nil {
+			replicaSpec.Replicas = srcReplicaSpec.Replicas
+		}
+	}
+}
+
 func (a adapter[PtrT, T]) DeleteRemoteObject(ctx context.Context, remoteClient client.Client, key types.NamespacedName) error {
 	job := PtrT(new(T))
 	job.SetName(key.Name)
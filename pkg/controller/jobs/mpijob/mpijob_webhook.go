@@ -178,6 +178,7 @@ func (w *MpiJobWebhook) validateTopologyRequest(ctx context.Context, mpiJob *MPI
 
 	if podSetsErr == nil {
 		allErrs = append(allErrs, jobframework.ValidatePodSetGroupingTopology(podSets, podSetAnnotationsPathByName)...)
+		allErrs = append(allErrs, jobframework.ValidatePodSetTopologyConstraints(podSets, podSetAnnotationsPathByName)...)
 	}
 
 	for replicaType, replicaSpec := range mpiJob.Spec.MPIReplicaSpecs {
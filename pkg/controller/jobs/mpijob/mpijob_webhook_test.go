@@ -170,6 +170,54 @@ func TestValidateCreate(t *testing.T) {
 				Obj(),
 			topologyAwareScheduling: true,
 		},
+		{
+			name: "valid PodSet topology constraints request",
+			job: testingutil.MakeMPIJob("job", "default").
+				Queue("queue-name").
+				MPIJobReplicaSpecs(
+					testingutil.MPIJobReplicaSpecRequirement{
+						ReplicaType:  v2beta1.MPIReplicaTypeLauncher,
+						ReplicaCount: 1,
+					},
+					testingutil.MPIJobReplicaSpecRequirement{
+						ReplicaType:  v2beta1.MPIReplicaTypeWorker,
+						ReplicaCount: 3,
+					},
+				).
+				PodAnnotation(v2beta1.MPIReplicaTypeLauncher, kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(v2beta1.MPIReplicaTypeLauncher, kueue.PodSetSameTopologyDomainAsAnnotation, "worker=cloud.com/block").
+				PodAnnotation(v2beta1.MPIReplicaTypeLauncher, kueue.PodSetDifferentTopologyDomainFromAnnotation, "worker=cloud.com/rack").
+				PodAnnotation(v2beta1.MPIReplicaTypeWorker, kueue.PodSetRequiredTopologyAnnotation, "cloud.com/rack").
+				Obj(),
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "invalid PodSet topology constraints request - unknown and self references",
+			job: testingutil.MakeMPIJob("job", "default").
+				Queue("queue-name").
+				MPIJobReplicaSpecs(
+					testingutil.MPIJobReplicaSpecRequirement{
+						ReplicaType:  v2beta1.MPIReplicaTypeLauncher,
+						ReplicaCount: 1,
+					},
+					testingutil.MPIJobReplicaSpecRequirement{
+						ReplicaType:  v2beta1.MPIReplicaTypeWorker,
+						ReplicaCount: 3,
+					},
+				).
+				PodAnnotation(v2beta1.MPIReplicaTypeLauncher, kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(v2beta1.MPIReplicaTypeLauncher, kueue.PodSetSameTopologyDomainAsAnnotation, "driver=cloud.com/block").
+				PodAnnotation(v2beta1.MPIReplicaTypeWorker, kueue.PodSetRequiredTopologyAnnotation, "cloud.com/rack").
+				PodAnnotation(v2beta1.MPIReplicaTypeWorker, kueue.PodSetDifferentTopologyDomainFromAnnotation, "worker=cloud.com/rack").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec.mpiReplicaSpecs[Launcher].template.metadata.annotations").
+					Key("kueue.x-k8s.io/podset-same-topology-domain-as"), kueue.PodSetReference("driver"), "must reference an existing PodSet"),
+				field.Invalid(field.NewPath("spec.mpiReplicaSpecs[Worker].template.metadata.annotations").
+					Key("kueue.x-k8s.io/podset-different-topology-domain-from"), kueue.PodSetReference("worker"), "must not reference the PodSet itself"),
+			}.ToAggregate(),
+			topologyAwareScheduling: true,
+		},
 		{
 			name: "invalid PodSet grouping request - groups of size other than 2",
 			job: testingutil.MakeMPIJob("job", "default").
@@ -0,0 +1,611 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/podset"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	gvk            = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "PipelineRun"}
+	pipelineGVK    = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "Pipeline"}
+	taskGVK        = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "Task"}
+	taskRunGVK     = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "TaskRun"}
+	taskRunListGVK = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "TaskRunList"}
+)
+
+const (
+	FrameworkName = "tekton.dev/pipelinerun"
+
+	// pipelineRunLabel and pipelineTaskLabel are set by the Tekton controller
+	// on the TaskRuns and the pods of the PipelineRun.
+	pipelineRunLabel  = "tekton.dev/pipelineRun"
+	pipelineTaskLabel = "tekton.dev/pipelineTask"
+
+	// statusPending holds the creation of the TaskRuns of a PipelineRun which
+	// didn't start yet.
+	statusPending = "PipelineRunPending"
+	// statusStoppedRunFinally stops a started PipelineRun: Tekton doesn't
+	// create new TaskRuns, lets the running ones complete and runs the finally
+	// tasks. Tekton can't put a started PipelineRun back to pending.
+	statusStoppedRunFinally = "StoppedRunFinally"
+
+	// reasonStartedPipelineRunStopped is the reason of the deactivation of the
+	// Workloads of the PipelineRuns stopped once started.
+	reasonStartedPipelineRunStopped = "StartedPipelineRunStopped"
+
+	conditionSucceeded = "Succeeded"
+
+	// envelopeContainerName is the name of the container holding the
+	// requests of a chain of tasks in the PodSet template.
+	envelopeContainerName = "step"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        newJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupPipelineRunWebhook,
+		JobType:       newObject(),
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=tekton.dev,resources=pipelineruns,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=tekton.dev,resources=pipelineruns/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=tekton.dev,resources=pipelineruns/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=tekton.dev,resources=taskruns,verbs=get;list;watch
+// +kubebuilder:rbac:groups=tekton.dev,resources=pipelines;tasks,verbs=get
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+
+type pipelineRunReconciler struct {
+	jr     *jobframework.JobReconciler
+	client client.Client
+}
+
+func newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func newTaskRun() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(taskRunGVK)
+	return obj
+}
+
+func newJob() jobframework.GenericJob {
+	return fromObject(newObject())
+}
+
+func NewReconciler(ctx context.Context, client client.Client, indexer client.FieldIndexer, eventRecorder record.EventRecorder, opts ...jobframework.Option) (jobframework.JobReconcilerInterface, error) {
+	return &pipelineRunReconciler{
+		jr:     jobframework.NewReconciler(client, eventRecorder, opts...),
+		client: client,
+	}, nil
+}
+
+func (r *pipelineRunReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, &PipelineRun{Unstructured: newObject(), client: r.client})
+}
+
+func (r *pipelineRunReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	controllerName := strings.ToLower(newJob().GVK().Kind)
+	// The TaskRuns are watched to reclaim the quota of the completed tasks.
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newJob().Object()).
+		Owns(&kueue.Workload{}).
+		Owns(newTaskRun()).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), controllerName),
+		}).
+		Complete(r)
+}
+
+// PipelineRun wraps the unstructured Tekton PipelineRun object.
+type PipelineRun struct {
+	*unstructured.Unstructured
+
+	// client resolves the Pipelines and Tasks referenced by the PipelineRun,
+	// and lists its TaskRuns. It is only set by the reconciler.
+	client client.Reader
+}
+
+var _ jobframework.GenericJob = (*PipelineRun)(nil)
+var _ jobframework.JobWithReclaimablePods = (*PipelineRun)(nil)
+var _ jobframework.JobWithCustomStop = (*PipelineRun)(nil)
+var _ jobframework.JobWithCustomWorkloadConditions = (*PipelineRun)(nil)
+
+// pipelineRunSpec is the subset of the PipelineRun spec used by Kueue.
+type pipelineRunSpec struct {
+	PipelineRef     *ref             `json:"pipelineRef,omitempty"`
+	PipelineSpec    *pipelineSpec    `json:"pipelineSpec,omitempty"`
+	TaskRunTemplate *taskRunTemplate `json:"taskRunTemplate,omitempty"`
+	TaskRunSpecs    []taskRunSpec    `json:"taskRunSpecs,omitempty"`
+}
+
+// ref references a Pipeline or a Task, either by name or through a resolver.
+type ref struct {
+	Name       string `json:"name,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Resolver   string `json:"resolver,omitempty"`
+}
+
+type taskRunTemplate struct {
+	PodTemplate *podTemplate `json:"podTemplate,omitempty"`
+}
+
+type podTemplate struct {
+	NodeSelector map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// taskRunSpec holds the fields of a PipelineRun applied to the TaskRuns of
+// one of the pipeline tasks.
+type taskRunSpec struct {
+	PipelineTaskName string                       `json:"pipelineTaskName,omitempty"`
+	PodTemplate      *podTemplate                 `json:"podTemplate,omitempty"`
+	ComputeResources *corev1.ResourceRequirements `json:"computeResources,omitempty"`
+	Metadata         *taskMetadata                `json:"metadata,omitempty"`
+}
+
+type taskMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type pipelineSpec struct {
+	Tasks   []pipelineTask `json:"tasks,omitempty"`
+	Finally []pipelineTask `json:"finally,omitempty"`
+}
+
+// pipelineTask is the subset of a pipeline task used to compute its
+// resources and its dependencies.
+type pipelineTask struct {
+	Name     string    `json:"name,omitempty"`
+	TaskRef  *ref      `json:"taskRef,omitempty"`
+	TaskSpec *taskSpec `json:"taskSpec,omitempty"`
+	RunAfter []string  `json:"runAfter,omitempty"`
+	Matrix   *matrix   `json:"matrix,omitempty"`
+	// Params and When can reference the results of other tasks, which makes
+	// the task depend on them.
+	Params any `json:"params,omitempty"`
+	When   any `json:"when,omitempty"`
+}
+
+type taskSpec struct {
+	Steps        []step `json:"steps,omitempty"`
+	Sidecars     []step `json:"sidecars,omitempty"`
+	StepTemplate *step  `json:"stepTemplate,omitempty"`
+}
+
+type step struct {
+	ComputeResources corev1.ResourceRequirements `json:"computeResources,omitempty"`
+}
+
+type matrix struct {
+	Params  []matrixParam `json:"params,omitempty"`
+	Include []any         `json:"include,omitempty"`
+}
+
+type matrixParam struct {
+	Name  string `json:"name,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+type condition struct {
+	Type    string `json:"type,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func fromObject(obj runtime.Object) *PipelineRun {
+	return &PipelineRun{Unstructured: obj.(*unstructured.Unstructured)}
+}
+
+func (j *PipelineRun) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *PipelineRun) spec() (*pipelineRunSpec, error) {
+	spec := &pipelineRunSpec{}
+	specMap, _, err := unstructured.NestedMap(j.Unstructured.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (j *PipelineRun) status() string {
+	status, _, _ := unstructured.NestedString(j.Unstructured.Object, "spec", "status")
+	return status
+}
+
+func (j *PipelineRun) hasStarted() bool {
+	_, found, _ := unstructured.NestedFieldNoCopy(j.Unstructured.Object, "status", "startTime")
+	return found
+}
+
+func (j *PipelineRun) IsSuspended() bool {
+	return j.status() == statusPending
+}
+
+// IsActive returns true if the PipelineRun started and didn't complete yet.
+func (j *PipelineRun) IsActive() bool {
+	_, completed, _ := unstructured.NestedFieldNoCopy(j.Unstructured.Object, "status", "completionTime")
+	return j.hasStarted() && !completed
+}
+
+// Suspend puts the PipelineRun to pending if it didn't start yet, otherwise
+// it stops it.
+func (j *PipelineRun) Suspend() {
+	status := statusPending
+	if j.hasStarted() {
+		status = statusStoppedRunFinally
+	}
+	_ = unstructured.SetNestedField(j.Unstructured.Object, status, "spec", "status")
+}
+
+// isStoppedOnceStarted returns true if the PipelineRun was stopped once
+// started, so it can't run again.
+func (j *PipelineRun) isStoppedOnceStarted() bool {
+	return j.hasStarted() && j.status() == statusStoppedRunFinally
+}
+
+// Stop suspends the PipelineRun, unless it was already stopped once started,
+// as it is not suspended while its running TaskRuns and finally tasks complete.
+func (j *PipelineRun) Stop(ctx context.Context, c client.Client, podSetsInfo []podset.PodSetInfo, _ jobframework.StopReason, _ string) (bool, error) {
+	if j.IsSuspended() || j.isStoppedOnceStarted() {
+		return false, nil
+	}
+	if err := clientutil.Patch(ctx, c, j.Object(), func() (bool, error) {
+		j.Suspend()
+		if podSetsInfo != nil {
+			j.RestorePodSetsInfo(podSetsInfo)
+		}
+		return true, nil
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CustomWorkloadConditions marks the Workload of a PipelineRun stopped once
+// started to be deactivated, rather than requeued, since the PipelineRun
+// can't run again.
+func (j *PipelineRun) CustomWorkloadConditions(wl *kueue.Workload) ([]metav1.Condition, bool) {
+	if !j.isStoppedOnceStarted() || !workload.IsActive(wl) || workload.IsFinished(wl) ||
+		apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadDeactivationTarget) {
+		return nil, false
+	}
+	return []metav1.Condition{{
+		Type:               kueue.WorkloadDeactivationTarget,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonStartedPipelineRunStopped,
+		Message:            "the PipelineRun was stopped once started, and Tekton can't run it again",
+		ObservedGeneration: wl.Generation,
+	}}, true
+}
+
+func (j *PipelineRun) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *PipelineRun) PodLabelSelector() string {
+	return fmt.Sprintf("%s=%s", pipelineRunLabel, j.GetName())
+}
+
+// PodSets returns a PodSet for every chain of tasks of the pipeline. The
+// tasks of a chain run one after the other, so a chain requests the peak
+// requests of its tasks.
+func (j *PipelineRun) PodSets(ctx context.Context) ([]kueue.PodSet, error) {
+	spec, chains, err := j.chains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	podSpec := corev1.PodSpec{}
+	if spec.TaskRunTemplate != nil && spec.TaskRunTemplate.PodTemplate != nil {
+		podSpec.NodeSelector = spec.TaskRunTemplate.PodTemplate.NodeSelector
+		podSpec.Tolerations = spec.TaskRunTemplate.PodTemplate.Tolerations
+	}
+	podSets := make([]kueue.PodSet, 0, len(chains))
+	for _, chain := range chains {
+		ps := kueue.PodSet{
+			Name:  kueue.NewPodSetReference(chain.name),
+			Count: chain.count,
+			Template: corev1.PodTemplateSpec{
+				Spec: *podSpec.DeepCopy(),
+			},
+		}
+		ps.Template.Spec.Containers = []corev1.Container{
+			{
+				Name: envelopeContainerName,
+				Resources: corev1.ResourceRequirements{
+					Requests: chain.requests,
+				},
+			},
+		}
+		podSets = append(podSets, ps)
+	}
+	return podSets, nil
+}
+
+// chains returns the chains of tasks of the pipeline, resolving the Pipeline
+// and the Tasks referenced by the PipelineRun.
+func (j *PipelineRun) chains(ctx context.Context) (*pipelineRunSpec, []taskChain, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, nil, err
+	}
+	pipeline, err := j.resolvePipeline(ctx, j.client, spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	chains, err := taskChains(pipeline, spec.TaskRunSpecs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return spec, chains, nil
+}
+
+// RunWithPodSetsInfo injects the assignment of every PodSet in the
+// taskRunSpecs of the tasks of its chain, and starts the PipelineRun.
+func (j *PipelineRun) RunWithPodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSetInfo) error {
+	spec, chains, err := j.chains(ctx)
+	if err != nil {
+		return err
+	}
+	if len(podSetsInfo) != len(chains) {
+		return podset.BadPodSetsInfoLenError(len(chains), len(podSetsInfo))
+	}
+	if j.status() == statusPending {
+		unstructured.RemoveNestedField(j.Unstructured.Object, "spec", "status")
+	}
+
+	var defaults podTemplate
+	if spec.TaskRunTemplate != nil && spec.TaskRunTemplate.PodTemplate != nil {
+		defaults = *spec.TaskRunTemplate.PodTemplate
+	}
+	taskRunSpecs, _, err := unstructured.NestedSlice(j.Unstructured.Object, "spec", "taskRunSpecs")
+	if err != nil {
+		return err
+	}
+	for i, chain := range chains {
+		for _, task := range chain.tasks {
+			var entry map[string]any
+			taskRunSpecs, entry = taskRunSpecFor(taskRunSpecs, task)
+			if err := mergeTaskRunSpec(entry, &defaults, podSetsInfo[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return unstructured.SetNestedSlice(j.Unstructured.Object, taskRunSpecs, "spec", "taskRunSpecs")
+}
+
+// taskRunSpecFor returns the taskRunSpecs entry of the pipeline task, adding
+// it if missing.
+func taskRunSpecFor(taskRunSpecs []any, task string) ([]any, map[string]any) {
+	for _, s := range taskRunSpecs {
+		if entry, ok := s.(map[string]any); ok && entry["pipelineTaskName"] == task {
+			return taskRunSpecs, entry
+		}
+	}
+	entry := map[string]any{"pipelineTaskName": task}
+	return append(taskRunSpecs, entry), entry
+}
+
+// mergeTaskRunSpec merges the PodSet info into a taskRunSpecs entry. Tekton
+// doesn't merge the node selector and the tolerations of the entry with the
+// ones of the taskRunTemplate, so the entry starts from the defaults.
+func mergeTaskRunSpec(entry map[string]any, defaults *podTemplate, info podset.PodSetInfo) error {
+	meta := &metav1.ObjectMeta{}
+	meta.Labels, _, _ = unstructured.NestedStringMap(entry, "metadata", "labels")
+	meta.Annotations, _, _ = unstructured.NestedStringMap(entry, "metadata", "annotations")
+	podSpec := &corev1.PodSpec{
+		NodeSelector: maps.Clone(defaults.NodeSelector),
+		Tolerations:  slices.Clone(defaults.Tolerations),
+	}
+	if err := podset.Merge(meta, podSpec, info); err != nil {
+		return err
+	}
+	if err := jobframework.SetNestedStringMap(entry, meta.Labels, "metadata", "labels"); err != nil {
+		return err
+	}
+	if err := jobframework.SetNestedStringMap(entry, meta.Annotations, "metadata", "annotations"); err != nil {
+		return err
+	}
+	if err := jobframework.SetNestedStringMap(entry, podSpec.NodeSelector, "podTemplate", "nodeSelector"); err != nil {
+		return err
+	}
+	return jobframework.SetNestedSlice(entry, podSpec.Tolerations, "podTemplate", "tolerations")
+}
+
+// RestorePodSetsInfo removes the node selectors and the tolerations injected
+// in the taskRunSpecs, so that the TaskRuns fall back to the taskRunTemplate.
+// The labels and annotations are kept, as merging them again is a no-op.
+func (j *PipelineRun) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	taskRunSpecs, found, err := unstructured.NestedSlice(j.Unstructured.Object, "spec", "taskRunSpecs")
+	if err != nil || !found {
+		return false
+	}
+	changed := false
+	for _, s := range taskRunSpecs {
+		entry, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, f := range []string{"nodeSelector", "tolerations"} {
+			if _, found, _ := unstructured.NestedFieldNoCopy(entry, "podTemplate", f); found {
+				unstructured.RemoveNestedField(entry, "podTemplate", f)
+				changed = true
+			}
+		}
+		if podTemplate, found, _ := unstructured.NestedMap(entry, "podTemplate"); found && len(podTemplate) == 0 {
+			delete(entry, "podTemplate")
+		}
+	}
+	if !changed {
+		return false
+	}
+	return unstructured.SetNestedSlice(j.Unstructured.Object, taskRunSpecs, "spec", "taskRunSpecs") == nil
+}
+
+// succeededCondition returns the Succeeded condition of a PipelineRun or a
+// TaskRun.
+func succeededCondition(obj map[string]any) *condition {
+	conditions, _, _ := unstructured.NestedSlice(obj, "status", "conditions")
+	for _, c := range conditions {
+		condMap, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		var cond condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(condMap, &cond); err != nil {
+			continue
+		}
+		if cond.Type == conditionSucceeded {
+			return &cond
+		}
+	}
+	return nil
+}
+
+func isCompleted(obj map[string]any) bool {
+	cond := succeededCondition(obj)
+	return cond != nil && (cond.Status == string(metav1.ConditionTrue) || cond.Status == string(metav1.ConditionFalse))
+}
+
+func (j *PipelineRun) Finished(ctx context.Context) (message string, success, finished bool) {
+	cond := succeededCondition(j.Unstructured.Object)
+	if cond == nil {
+		return "", false, false
+	}
+	switch cond.Status {
+	case string(metav1.ConditionTrue):
+		return cond.Message, true, true
+	case string(metav1.ConditionFalse):
+		return cond.Message, false, true
+	}
+	return cond.Message, false, false
+}
+
+// PodsReady returns true while the PipelineRun is running.
+func (j *PipelineRun) PodsReady(ctx context.Context) bool {
+	return j.IsActive()
+}
+
+// ReclaimablePods returns, for every chain of tasks, the pods which are no
+// longer needed because the TaskRuns of the chain completed.
+func (j *PipelineRun) ReclaimablePods(ctx context.Context) ([]kueue.ReclaimablePod, error) {
+	if !j.hasStarted() || j.client == nil {
+		return nil, nil
+	}
+	_, chains, err := j.chains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := j.completedTaskRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ret []kueue.ReclaimablePod
+	for _, chain := range chains {
+		// The chain needs as many pods as the task with the most pending
+		// TaskRuns.
+		var needed int32
+		for _, task := range chain.tasks {
+			if pending := chain.taskCounts[task] - completed[task]; pending > 0 {
+				needed = max(needed, min(pending, chain.count))
+			}
+		}
+		if needed < chain.count {
+			ret = append(ret, kueue.ReclaimablePod{
+				Name:  kueue.NewPodSetReference(chain.name),
+				Count: chain.count - needed,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// completedTaskRuns returns the number of completed TaskRuns of every
+// pipeline task. The skipped tasks count as completed.
+func (j *PipelineRun) completedTaskRuns(ctx context.Context) (map[string]int32, error) {
+	taskRuns := &unstructured.UnstructuredList{}
+	taskRuns.SetGroupVersionKind(taskRunListGVK)
+	if err := j.client.List(ctx, taskRuns, client.InNamespace(j.GetNamespace()), client.MatchingLabels{pipelineRunLabel: j.GetName()}); err != nil {
+		return nil, err
+	}
+	completed := make(map[string]int32)
+	for i := range taskRuns.Items {
+		if isCompleted(taskRuns.Items[i].Object) {
+			completed[taskRuns.Items[i].GetLabels()[pipelineTaskLabel]]++
+		}
+	}
+	skipped, _, _ := unstructured.NestedSlice(j.Unstructured.Object, "status", "skippedTasks")
+	for _, s := range skipped {
+		if skippedMap, ok := s.(map[string]any); ok {
+			if name, ok := skippedMap["name"].(string); ok {
+				completed[name] = math.MaxInt32
+			}
+		}
+	}
+	return completed, nil
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+func GetWorkloadNameForPipelineRun(name string, uid types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(name, uid, gvk)
+}
@@ -0,0 +1,529 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingpipelinerun "sigs.k8s.io/kueue/pkg/util/testingjobs/pipelinerun"
+)
+
+func requests(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func makePodSet(name string, count int32, requests corev1.ResourceList) kueue.PodSet {
+	return kueue.PodSet{
+		Name:  kueue.NewPodSetReference(name),
+		Count: count,
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:      envelopeContainerName,
+						Resources: corev1.ResourceRequirements{Requests: requests},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPodSets(t *testing.T) {
+	testcases := map[string]struct {
+		pipelineRun *unstructured.Unstructured
+		objs        []client.Object
+		wantPodSets []kueue.PodSet
+		wantErr     error
+	}{
+		"sequential tasks are counted at their peak": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Task(testingpipelinerun.Task{Name: "clone", Requests: requests("1", "1Gi")}).
+				Task(testingpipelinerun.Task{Name: "build", Requests: requests("4", "2Gi"), RunAfter: []string{"clone"}}).
+				Task(testingpipelinerun.Task{Name: "push", Requests: requests("1", "4Gi"), RunAfter: []string{"build"}}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				makePodSet("clone", 1, requests("4", "4Gi")),
+			},
+		},
+		"parallel tasks get their own podsets": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Task(testingpipelinerun.Task{Name: "clone", Requests: requests("1", "1Gi")}).
+				Task(testingpipelinerun.Task{Name: "unit", Requests: requests("2", "1Gi"), RunAfter: []string{"clone"}}).
+				Task(testingpipelinerun.Task{Name: "lint", Requests: requests("1", "2Gi")}).
+				Task(testingpipelinerun.Task{Name: "push", Requests: requests("1", "1Gi"), RunAfter: []string{"unit", "lint"}}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				makePodSet("clone", 1, requests("2", "1Gi")),
+				makePodSet("lint", 1, requests("1", "2Gi")),
+			},
+		},
+		"result references and finally tasks": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Task(testingpipelinerun.Task{Name: "build", Requests: requests("2", "1Gi")}).
+				Task(testingpipelinerun.Task{
+					Name:     "deploy",
+					Requests: requests("1", "1Gi"),
+					Params:   map[string]string{"image": "$(tasks.build.results.image)"},
+				}).
+				Finally(testingpipelinerun.Task{Name: "notify", Requests: requests("500m", "3Gi")}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				makePodSet("build", 1, requests("2", "3Gi")),
+			},
+		},
+		"matrix": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Task(testingpipelinerun.Task{Name: "build", Requests: requests("1", "1Gi"), Matrix: 3}).
+				Task(testingpipelinerun.Task{Name: "push", Requests: requests("2", "1Gi"), RunAfter: []string{"build"}}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				makePodSet("build", 3, requests("2", "1Gi")),
+			},
+		},
+		"task compute resources override the steps": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Task(testingpipelinerun.Task{Name: "build", Requests: requests("1", "1Gi")}).
+				TaskRunSpec(map[string]any{
+					"pipelineTaskName": "build",
+					"computeResources": map[string]any{
+						"limits": map[string]any{"cpu": "3", "memory": "5Gi"},
+					},
+				}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				makePodSet("build", 1, requests("3", "5Gi")),
+			},
+		},
+		"referenced pipeline and tasks": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				PipelineRef("ci").
+				NodeSelector("pool", "ci").
+				Obj(),
+			objs: []client.Object{
+				testingpipelinerun.MakePipeline("ci", "ns",
+					testingpipelinerun.Task{Name: "build", TaskRef: "buildah"},
+					testingpipelinerun.Task{Name: "test", Requests: requests("1", "1Gi")},
+				),
+				testingpipelinerun.MakeTask("buildah", "ns", requests("2", "4Gi")),
+			},
+			wantPodSets: []kueue.PodSet{
+				func() kueue.PodSet {
+					ps := makePodSet("build", 1, requests("2", "4Gi"))
+					ps.Template.Spec.NodeSelector = map[string]string{"pool": "ci"}
+					return ps
+				}(),
+				func() kueue.PodSet {
+					ps := makePodSet("test", 1, requests("1", "1Gi"))
+					ps.Template.Spec.NodeSelector = map[string]string{"pool": "ci"}
+					return ps
+				}(),
+			},
+		},
+		"more chains than podsets": {
+			pipelineRun: func() *unstructured.Unstructured {
+				w := testingpipelinerun.MakePipelineRun("pr", "ns")
+				for i := range 10 {
+					w.Task(testingpipelinerun.Task{Name: fmt.Sprintf("t%d", i), Requests: requests("1", "1Gi")})
+				}
+				return w.Obj()
+			}(),
+			wantPodSets: []kueue.PodSet{
+				makePodSet("t0", 1, requests("1", "1Gi")),
+				makePodSet("t1", 1, requests("1", "1Gi")),
+				makePodSet("t2", 1, requests("1", "1Gi")),
+				makePodSet("t3", 1, requests("1", "1Gi")),
+				makePodSet("t4", 1, requests("1", "1Gi")),
+				makePodSet("t5", 1, requests("1", "1Gi")),
+				makePodSet("t6", 1, requests("1", "1Gi")),
+				makePodSet("t7", 1, requests("3", "3Gi")),
+			},
+		},
+		"dependency cycle": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Task(testingpipelinerun.Task{Name: "a", RunAfter: []string{"b"}}).
+				Task(testingpipelinerun.Task{Name: "b", RunAfter: []string{"a"}}).
+				Obj(),
+			wantErr: cmpopts.AnyError,
+		},
+		"missing pipeline": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Obj(),
+			wantErr:     errNoPipeline,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			job := fromObject(tc.pipelineRun)
+			job.client = utiltesting.NewClientBuilder().WithObjects(tc.objs...).Build()
+
+			got, err := job.PodSets(ctx)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("PodSets() error mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantPodSets, got); diff != "" {
+				t.Errorf("PodSets() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfo(t *testing.T) {
+	pipelineRun := testingpipelinerun.MakePipelineRun("pr", "ns").
+		Task(testingpipelinerun.Task{Name: "build", Requests: requests("1", "1Gi")}).
+		Task(testingpipelinerun.Task{Name: "push", Requests: requests("1", "1Gi"), RunAfter: []string{"build"}}).
+		Task(testingpipelinerun.Task{Name: "lint", Requests: requests("1", "1Gi")}).
+		NodeSelector("pool", "ci").
+		TaskRunSpec(map[string]any{"pipelineTaskName": "lint", "serviceAccountName": "linter"})
+	toleration := corev1.Toleration{Key: "spot", Operator: corev1.TolerationOpExists}
+
+	job := fromObject(pipelineRun.Clone().Obj())
+	podSetsInfo := []podset.PodSetInfo{
+		{
+			Labels:       map[string]string{"flavor": "on-demand"},
+			NodeSelector: map[string]string{"instance": "on-demand"},
+		},
+		{
+			NodeSelector: map[string]string{"instance": "spot"},
+			Tolerations:  []corev1.Toleration{toleration},
+		},
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	if err := job.RunWithPodSetsInfo(ctx, podSetsInfo); err != nil {
+		t.Fatalf("unexpected RunWithPodSetsInfo() error: %v", err)
+	}
+
+	onDemand := map[string]any{
+		"metadata":    map[string]any{"labels": map[string]any{"flavor": "on-demand"}},
+		"podTemplate": map[string]any{"nodeSelector": map[string]any{"pool": "ci", "instance": "on-demand"}},
+	}
+	want := pipelineRun.Clone().
+		Status("").
+		TaskRunSpec(map[string]any{"pipelineTaskName": "build", "metadata": onDemand["metadata"], "podTemplate": onDemand["podTemplate"]}).
+		TaskRunSpec(map[string]any{"pipelineTaskName": "push", "metadata": onDemand["metadata"], "podTemplate": onDemand["podTemplate"]}).
+		Obj()
+	wantTaskRunSpecs := []any{
+		map[string]any{
+			"pipelineTaskName":   "lint",
+			"serviceAccountName": "linter",
+			"podTemplate": map[string]any{
+				"nodeSelector": map[string]any{"pool": "ci", "instance": "spot"},
+				"tolerations":  []any{map[string]any{"key": "spot", "operator": "Exists"}},
+			},
+		},
+		map[string]any{"pipelineTaskName": "build", "metadata": onDemand["metadata"], "podTemplate": onDemand["podTemplate"]},
+		map[string]any{"pipelineTaskName": "push", "metadata": onDemand["metadata"], "podTemplate": onDemand["podTemplate"]},
+	}
+	if err := unstructured.SetNestedSlice(want.Object, wantTaskRunSpecs, "spec", "taskRunSpecs"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, job.Unstructured); diff != "" {
+		t.Errorf("RunWithPodSetsInfo() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := job.RunWithPodSetsInfo(ctx, podSetsInfo[:1]); err == nil {
+		t.Error("expected RunWithPodSetsInfo() error for a wrong number of podsets")
+	}
+
+	job.Suspend()
+	if !job.RestorePodSetsInfo(podSetsInfo) {
+		t.Error("RestorePodSetsInfo() expected a change")
+	}
+	wantRestored := pipelineRun.Clone().Obj()
+	if err := unstructured.SetNestedSlice(wantRestored.Object, []any{
+		map[string]any{"pipelineTaskName": "lint", "serviceAccountName": "linter"},
+		map[string]any{"pipelineTaskName": "build", "metadata": onDemand["metadata"]},
+		map[string]any{"pipelineTaskName": "push", "metadata": onDemand["metadata"]},
+	}, "spec", "taskRunSpecs"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantRestored, job.Unstructured); diff != "" {
+		t.Errorf("RestorePodSetsInfo() mismatch (-want +got):\n%s", diff)
+	}
+	if job.RestorePodSetsInfo(podSetsInfo) {
+		t.Error("RestorePodSetsInfo() expected no change")
+	}
+}
+
+func TestStatus(t *testing.T) {
+	testcases := map[string]struct {
+		pipelineRun   *unstructured.Unstructured
+		wantSuspended bool
+		wantActive    bool
+		wantFinished  bool
+		wantSuccess   bool
+		wantMessage   string
+	}{
+		"pending": {
+			pipelineRun:   testingpipelinerun.MakePipelineRun("pr", "ns").Obj(),
+			wantSuspended: true,
+		},
+		"running": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Status("").
+				StartTime().
+				Condition("Unknown", "Tasks Completed: 1 (Failed: 0, Cancelled 0), Skipped: 0").
+				Obj(),
+			wantActive:  true,
+			wantMessage: "Tasks Completed: 1 (Failed: 0, Cancelled 0), Skipped: 0",
+		},
+		"succeeded": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Status("").
+				StartTime().
+				CompletionTime().
+				Condition("True", "Tasks Completed: 2 (Failed: 0, Cancelled 0), Skipped: 0").
+				Obj(),
+			wantFinished: true,
+			wantSuccess:  true,
+			wantMessage:  "Tasks Completed: 2 (Failed: 0, Cancelled 0), Skipped: 0",
+		},
+		"failed": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Status("").
+				StartTime().
+				CompletionTime().
+				Condition("False", "Tasks Completed: 2 (Failed: 1, Cancelled 0), Skipped: 0").
+				Obj(),
+			wantFinished: true,
+			wantMessage:  "Tasks Completed: 2 (Failed: 1, Cancelled 0), Skipped: 0",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			job := fromObject(tc.pipelineRun)
+			if got := job.IsSuspended(); got != tc.wantSuspended {
+				t.Errorf("IsSuspended() = %v, want %v", got, tc.wantSuspended)
+			}
+			if got := job.IsActive(); got != tc.wantActive {
+				t.Errorf("IsActive() = %v, want %v", got, tc.wantActive)
+			}
+			message, success, finished := job.Finished(ctx)
+			if finished != tc.wantFinished || success != tc.wantSuccess || message != tc.wantMessage {
+				t.Errorf("Finished() = (%q, %v, %v), want (%q, %v, %v)", message, success, finished, tc.wantMessage, tc.wantSuccess, tc.wantFinished)
+			}
+		})
+	}
+}
+
+func TestSuspend(t *testing.T) {
+	notStarted := fromObject(testingpipelinerun.MakePipelineRun("pr", "ns").Status("").Obj())
+	notStarted.Suspend()
+	if got := notStarted.status(); got != statusPending {
+		t.Errorf("Suspend() of a PipelineRun which didn't start set the status %q, want %q", got, statusPending)
+	}
+
+	started := fromObject(testingpipelinerun.MakePipelineRun("pr", "ns").Status("").StartTime().Obj())
+	started.Suspend()
+	if got := started.status(); got != statusStoppedRunFinally {
+		t.Errorf("Suspend() of a started PipelineRun set the status %q, want %q", got, statusStoppedRunFinally)
+	}
+}
+
+func TestStop(t *testing.T) {
+	testcases := map[string]struct {
+		pipelineRun *unstructured.Unstructured
+		wantStopped bool
+		wantStatus  string
+	}{
+		"not started": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status("").Obj(),
+			wantStopped: true,
+			wantStatus:  statusPending,
+		},
+		"already suspended": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Obj(),
+			wantStatus:  statusPending,
+		},
+		"started": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status("").StartTime().Obj(),
+			wantStopped: true,
+			wantStatus:  statusStoppedRunFinally,
+		},
+		"already stopped once started": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status(statusStoppedRunFinally).StartTime().Obj(),
+			wantStatus:  statusStoppedRunFinally,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			c := utiltesting.NewClientBuilder().WithObjects(tc.pipelineRun).Build()
+			job := fromObject(tc.pipelineRun)
+
+			stopped, err := job.Stop(ctx, c, nil, jobframework.StopReasonWorkloadEvicted, "")
+			if err != nil {
+				t.Fatalf("unexpected Stop() error: %v", err)
+			}
+			if stopped != tc.wantStopped {
+				t.Errorf("Stop() = %v, want %v", stopped, tc.wantStopped)
+			}
+			got := &unstructured.Unstructured{}
+			got.SetGroupVersionKind(gvk)
+			if err := c.Get(ctx, client.ObjectKeyFromObject(tc.pipelineRun), got); err != nil {
+				t.Fatalf("unexpected Get() error: %v", err)
+			}
+			if status := fromObject(got).status(); status != tc.wantStatus {
+				t.Errorf("Stop() left the status %q, want %q", status, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestCustomWorkloadConditions(t *testing.T) {
+	deactivationTarget := metav1.Condition{
+		Type:               kueue.WorkloadDeactivationTarget,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonStartedPipelineRunStopped,
+		Message:            "the PipelineRun was stopped once started, and Tekton can't run it again",
+		ObservedGeneration: 1,
+	}
+	wl := utiltestingapi.MakeWorkload("wl", "ns").Generation(1)
+
+	testcases := map[string]struct {
+		pipelineRun    *unstructured.Unstructured
+		workload       *kueue.Workload
+		wantConditions []metav1.Condition
+		wantUpdated    bool
+	}{
+		"pending": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Obj(),
+			workload:    wl.Clone().Obj(),
+		},
+		"running": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status("").StartTime().Obj(),
+			workload:    wl.Clone().Obj(),
+		},
+		"stopped once started": {
+			pipelineRun:    testingpipelinerun.MakePipelineRun("pr", "ns").Status(statusStoppedRunFinally).StartTime().Obj(),
+			workload:       wl.Clone().Obj(),
+			wantConditions: []metav1.Condition{deactivationTarget},
+			wantUpdated:    true,
+		},
+		"stopped once started with an already deactivated workload": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status(statusStoppedRunFinally).StartTime().Obj(),
+			workload:    wl.Clone().Active(false).Obj(),
+		},
+		"stopped once started with a finished workload": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status(statusStoppedRunFinally).StartTime().Obj(),
+			workload:    wl.Clone().Finished().Obj(),
+		},
+		"stopped once started with a deactivation target": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status(statusStoppedRunFinally).StartTime().Obj(),
+			workload:    wl.Clone().Condition(deactivationTarget).Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			gotConditions, gotUpdated := fromObject(tc.pipelineRun).CustomWorkloadConditions(tc.workload)
+			if gotUpdated != tc.wantUpdated {
+				t.Errorf("CustomWorkloadConditions() updated = %v, want %v", gotUpdated, tc.wantUpdated)
+			}
+			if diff := cmp.Diff(tc.wantConditions, gotConditions); diff != "" {
+				t.Errorf("CustomWorkloadConditions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReclaimablePods(t *testing.T) {
+	pipelineRun := testingpipelinerun.MakePipelineRun("pr", "ns").
+		Status("").
+		StartTime().
+		Task(testingpipelinerun.Task{Name: "build", Requests: requests("1", "1Gi"), Matrix: 3}).
+		Task(testingpipelinerun.Task{Name: "push", Requests: requests("1", "1Gi"), RunAfter: []string{"build"}}).
+		Task(testingpipelinerun.Task{Name: "lint", Requests: requests("1", "1Gi")})
+
+	testcases := map[string]struct {
+		pipelineRun *unstructured.Unstructured
+		taskRuns    []client.Object
+		want        []kueue.ReclaimablePod
+	}{
+		"not started": {
+			pipelineRun: pipelineRun.Clone().Status("PipelineRunPending").Obj(),
+		},
+		"nothing completed": {
+			pipelineRun: pipelineRun.Clone().Obj(),
+			taskRuns: []client.Object{
+				testingpipelinerun.MakeTaskRun("pr-build-0", "ns", "pr", "build", "Unknown"),
+				testingpipelinerun.MakeTaskRun("pr-lint", "ns", "pr", "lint", "Unknown"),
+			},
+		},
+		"matrix partially completed": {
+			pipelineRun: pipelineRun.Clone().Obj(),
+			taskRuns: []client.Object{
+				testingpipelinerun.MakeTaskRun("pr-build-0", "ns", "pr", "build", "True"),
+				testingpipelinerun.MakeTaskRun("pr-build-1", "ns", "pr", "build", "True"),
+				testingpipelinerun.MakeTaskRun("pr-build-2", "ns", "pr", "build", "Unknown"),
+				testingpipelinerun.MakeTaskRun("pr-lint", "ns", "pr", "lint", "Unknown"),
+			},
+			want: []kueue.ReclaimablePod{{Name: "build", Count: 2}},
+		},
+		"chain and skipped task completed": {
+			pipelineRun: pipelineRun.Clone().SkippedTask("lint").Obj(),
+			taskRuns: []client.Object{
+				testingpipelinerun.MakeTaskRun("pr-build-0", "ns", "pr", "build", "True"),
+				testingpipelinerun.MakeTaskRun("pr-build-1", "ns", "pr", "build", "True"),
+				testingpipelinerun.MakeTaskRun("pr-build-2", "ns", "pr", "build", "False"),
+				testingpipelinerun.MakeTaskRun("pr-push", "ns", "pr", "push", "Unknown"),
+				testingpipelinerun.MakeTaskRun("other-lint", "ns", "other", "lint", "Unknown"),
+			},
+			want: []kueue.ReclaimablePod{
+				{Name: "build", Count: 2},
+				{Name: "lint", Count: 1},
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			job := fromObject(tc.pipelineRun)
+			job.client = utiltesting.NewClientBuilder().WithObjects(tc.taskRuns...).Build()
+
+			got, err := job.ReclaimablePods(ctx)
+			if err != nil {
+				t.Fatalf("unexpected ReclaimablePods() error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ReclaimablePods() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	utilresource "sigs.k8s.io/kueue/pkg/util/resource"
+)
+
+// maxPodSets is the maximum number of PodSets of a Workload.
+const maxPodSets = 8
+
+var (
+	errNoPipeline       = errors.New("either pipelineRef or pipelineSpec must be set")
+	errPipelineResolver = errors.New("remote resolution is not supported, the pipeline must be embedded or reference a Pipeline")
+	errTaskResolver     = errors.New("remote resolution is not supported, the task must be embedded or reference a Task")
+	errTaskKind         = errors.New("only Task references are supported")
+	errDynamicMatrix    = errors.New("matrix parameters must be arrays, the number of TaskRuns must be known when the PipelineRun is created")
+	errNoClient         = errors.New("the referenced Pipelines and Tasks can't be resolved")
+
+	// resultRefRegexp matches the references to the results of other tasks,
+	// like "$(tasks.build.results.digest)".
+	resultRefRegexp = regexp.MustCompile(`\$\(tasks\.([^.)\s]+)\.`)
+)
+
+// taskChain is a set of pipeline tasks which run one after the other. A
+// chain is admitted as one PodSet.
+type taskChain struct {
+	// name is the name of the first task of the chain.
+	name  string
+	tasks []string
+	// taskCounts is the number of TaskRuns of every task of the chain.
+	taskCounts map[string]int32
+	requests   corev1.ResourceList
+	count      int32
+}
+
+// resolvePipeline returns the spec of the pipeline of the PipelineRun, in
+// which the referenced Tasks are replaced by their spec.
+func (j *PipelineRun) resolvePipeline(ctx context.Context, c client.Reader, spec *pipelineRunSpec) (*pipelineSpec, error) {
+	pipeline := spec.PipelineSpec
+	if pipeline == nil {
+		if spec.PipelineRef == nil {
+			return nil, errNoPipeline
+		}
+		if spec.PipelineRef.Resolver != "" {
+			return nil, errPipelineResolver
+		}
+		if c == nil {
+			return nil, errNoClient
+		}
+		pipeline = &pipelineSpec{}
+		if err := getSpec(ctx, c, pipelineGVK, j.GetNamespace(), spec.PipelineRef.Name, pipeline); err != nil {
+			return nil, fmt.Errorf("pipeline %q: %w", spec.PipelineRef.Name, err)
+		}
+	}
+	for _, tasks := range [][]pipelineTask{pipeline.Tasks, pipeline.Finally} {
+		for i := range tasks {
+			task := &tasks[i]
+			if task.TaskSpec != nil || task.TaskRef == nil {
+				continue
+			}
+			if err := validateTaskRef(task.TaskRef); err != nil {
+				return nil, fmt.Errorf("task %q: %w", task.Name, err)
+			}
+			if c == nil {
+				return nil, errNoClient
+			}
+			task.TaskSpec = &taskSpec{}
+			if err := getSpec(ctx, c, taskGVK, j.GetNamespace(), task.TaskRef.Name, task.TaskSpec); err != nil {
+				return nil, fmt.Errorf("task %q: %w", task.Name, err)
+			}
+		}
+	}
+	return pipeline, nil
+}
+
+func validateTaskRef(r *ref) error {
+	if r.Resolver != "" {
+		return errTaskResolver
+	}
+	if (r.Kind != "" && r.Kind != taskGVK.Kind) || r.APIVersion != "" {
+		return errTaskKind
+	}
+	return nil
+}
+
+// getSpec reads the spec of the named object into spec.
+func getSpec(ctx context.Context, c client.Reader, gvk schema.GroupVersionKind, namespace, name string, spec any) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		return err
+	}
+	specMap, _, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, spec)
+}
+
+// taskChains splits the tasks of the pipeline into chains of tasks which run
+// one after the other. The chains are built greedily in topological order,
+// appending every task to the first chain ending with one of its ancestors.
+// The sum of the requests of the chains is an upper bound of the requests of
+// the tasks which can run at the same time.
+func taskChains(pipeline *pipelineSpec, taskRunSpecs []taskRunSpec) ([]taskChain, error) {
+	tasks := slices.Concat(pipeline.Tasks, pipeline.Finally)
+	index := make(map[string]int, len(tasks))
+	for i := range tasks {
+		if _, found := index[tasks[i].Name]; found {
+			return nil, fmt.Errorf("task %q is defined more than once", tasks[i].Name)
+		}
+		index[tasks[i].Name] = i
+	}
+	overrides := make(map[string]*corev1.ResourceRequirements, len(taskRunSpecs))
+	for i := range taskRunSpecs {
+		overrides[taskRunSpecs[i].PipelineTaskName] = taskRunSpecs[i].ComputeResources
+	}
+
+	counts := make([]int32, len(tasks))
+	requests := make([]corev1.ResourceList, len(tasks))
+	dependencies := make([]sets.Set[int], len(tasks))
+	for i := range tasks {
+		count, err := matrixCount(tasks[i].Matrix)
+		if err != nil {
+			return nil, fmt.Errorf("task %q: %w", tasks[i].Name, err)
+		}
+		counts[i] = count
+		requests[i] = taskRequests(tasks[i].TaskSpec, overrides[tasks[i].Name])
+		dependencies[i] = sets.New[int]()
+		if i >= len(pipeline.Tasks) {
+			// The finally tasks run once all the other tasks completed.
+			for j := range pipeline.Tasks {
+				dependencies[i].Insert(j)
+			}
+			continue
+		}
+		names, err := taskDependencies(&tasks[i])
+		if err != nil {
+			return nil, fmt.Errorf("task %q: %w", tasks[i].Name, err)
+		}
+		for _, name := range names {
+			if j, found := index[name]; found && j < len(pipeline.Tasks) {
+				dependencies[i].Insert(j)
+			}
+		}
+	}
+
+	order, err := topologicalOrder(tasks, dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors := make([]sets.Set[int], len(tasks))
+	for _, i := range order {
+		ancestors[i] = sets.New[int]()
+		for j := range dependencies[i] {
+			ancestors[i].Insert(j)
+			ancestors[i] = ancestors[i].Union(ancestors[j])
+		}
+	}
+
+	var chains []taskChain
+	var chainEnds []int
+	for _, i := range order {
+		c := slices.IndexFunc(chainEnds, func(end int) bool { return ancestors[i].Has(end) })
+		if c < 0 {
+			chains = append(chains, taskChain{name: tasks[i].Name, taskCounts: make(map[string]int32)})
+			chainEnds = append(chainEnds, i)
+			c = len(chains) - 1
+		}
+		chain := &chains[c]
+		chain.tasks = append(chain.tasks, tasks[i].Name)
+		chain.taskCounts[tasks[i].Name] = counts[i]
+		chain.requests = utilresource.MergeResourceListKeepMax(chain.requests, requests[i])
+		chain.count = max(chain.count, counts[i])
+		chainEnds[c] = i
+	}
+	return mergeChains(chains), nil
+}
+
+// mergeChains merges the chains exceeding the maximum number of PodSets into
+// the last one, as a single pod requesting the sum of their requests.
+func mergeChains(chains []taskChain) []taskChain {
+	if len(chains) <= maxPodSets {
+		return chains
+	}
+	merged := taskChain{
+		name:       chains[maxPodSets-1].name,
+		taskCounts: make(map[string]int32),
+		count:      1,
+	}
+	for _, chain := range chains[maxPodSets-1:] {
+		merged.tasks = append(merged.tasks, chain.tasks...)
+		for _, task := range chain.tasks {
+			merged.taskCounts[task] = 1
+		}
+		merged.requests = utilresource.MergeResourceListKeepSum(merged.requests, scale(chain.requests, int64(chain.count)))
+	}
+	return append(chains[:maxPodSets-1], merged)
+}
+
+// topologicalOrder returns the indexes of the tasks such that every task
+// comes after its dependencies. The order of the tasks in the pipeline is
+// kept when possible.
+func topologicalOrder(tasks []pipelineTask, dependencies []sets.Set[int]) ([]int, error) {
+	order := make([]int, 0, len(tasks))
+	done := sets.New[int]()
+	for len(order) < len(tasks) {
+		progress := false
+		for i := range tasks {
+			if !done.Has(i) && done.IsSuperset(dependencies[i]) {
+				order = append(order, i)
+				done.Insert(i)
+				progress = true
+			}
+		}
+		if !progress {
+			return nil, errors.New("the pipeline tasks have a dependency cycle")
+		}
+	}
+	return order, nil
+}
+
+// taskDependencies returns the names of the tasks a pipeline task runs after,
+// either explicitly or because it uses their results.
+func taskDependencies(task *pipelineTask) ([]string, error) {
+	dependencies := slices.Clone(task.RunAfter)
+	for _, field := range []any{task.Params, task.When, task.Matrix} {
+		if field == nil {
+			continue
+		}
+		data, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range resultRefRegexp.FindAllStringSubmatch(string(data), -1) {
+			dependencies = append(dependencies, match[1])
+		}
+	}
+	return dependencies, nil
+}
+
+// matrixCount returns the number of TaskRuns of a pipeline task, that is the
+// number of combinations of its matrix parameters plus the explicitly
+// included combinations.
+func matrixCount(m *matrix) (int32, error) {
+	if m == nil {
+		return 1, nil
+	}
+	var count int32
+	if len(m.Params) > 0 {
+		count = 1
+		for _, p := range m.Params {
+			values, ok := p.Value.([]any)
+			if !ok {
+				return 0, errDynamicMatrix
+			}
+			count *= int32(len(values))
+		}
+	}
+	count += int32(len(m.Include))
+	return max(count, 1), nil
+}
+
+// taskRequests returns the resources requested by the pod of a task. The
+// steps run one after the other but Tekton requests the sum of their
+// resources, like for the sidecars. When the PipelineRun sets the compute
+// resources of the task, they replace the ones of the steps.
+func taskRequests(spec *taskSpec, override *corev1.ResourceRequirements) corev1.ResourceList {
+	if spec == nil {
+		return nil
+	}
+	var requests corev1.ResourceList
+	if override != nil {
+		requests = withDefaultRequests(*override)
+	} else {
+		for i := range spec.Steps {
+			stepResources := spec.Steps[i].ComputeResources
+			if spec.StepTemplate != nil {
+				stepResources.Requests = utilresource.MergeResourceListKeepFirst(stepResources.Requests, spec.StepTemplate.ComputeResources.Requests)
+				stepResources.Limits = utilresource.MergeResourceListKeepFirst(stepResources.Limits, spec.StepTemplate.ComputeResources.Limits)
+			}
+			requests = utilresource.MergeResourceListKeepSum(requests, withDefaultRequests(stepResources))
+		}
+	}
+	for i := range spec.Sidecars {
+		requests = utilresource.MergeResourceListKeepSum(requests, withDefaultRequests(spec.Sidecars[i].ComputeResources))
+	}
+	return requests
+}
+
+// withDefaultRequests returns the requests, in which the missing ones default
+// to the limits, like done by the API server for the pods.
+func withDefaultRequests(resources corev1.ResourceRequirements) corev1.ResourceList {
+	return utilresource.MergeResourceListKeepFirst(resources.Requests, resources.Limits)
+}
+
+// scale multiplies the resources by n.
+func scale(requests corev1.ResourceList, n int64) corev1.ResourceList {
+	if n == 1 {
+		return requests
+	}
+	ret := make(corev1.ResourceList, len(requests))
+	for name, q := range requests {
+		ret[name] = *resource.NewMilliQuantity(q.MilliValue()*n, q.Format)
+	}
+	return ret
+}
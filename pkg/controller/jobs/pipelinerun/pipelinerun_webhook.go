@@ -0,0 +1,184 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/webhook"
+)
+
+var (
+	specPath         = field.NewPath("spec")
+	pipelineRefPath  = specPath.Child("pipelineRef")
+	pipelineSpecPath = specPath.Child("pipelineSpec")
+	taskRunSpecsPath = specPath.Child("taskRunSpecs")
+)
+
+type PipelineRunWebhook struct {
+	client                       client.Client
+	queues                       *qcache.Manager
+	manageJobsWithoutQueueName   bool
+	managedJobsNamespaceSelector labels.Selector
+}
+
+// SetupPipelineRunWebhook configures the webhook for the Tekton PipelineRun.
+func SetupPipelineRunWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &PipelineRunWebhook{
+		client:                       mgr.GetClient(),
+		queues:                       options.Queues,
+		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+	}
+	obj := newObject()
+	jobframework.RegisterUnstructuredType(mgr.GetScheme(), gvk)
+	if options.NoopWebhook {
+		return webhook.SetupNoopWebhook(mgr, obj)
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		WithLogConstructor(jobframework.WebhookLogConstructor(fromObject(obj).GVK(), options.RoleTracker)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-tekton-dev-v1-pipelinerun,mutating=true,failurePolicy=fail,sideEffects=None,groups=tekton.dev,resources=pipelineruns,verbs=create,versions=v1,name=mpipelinerun.kb.io,admissionReviewVersions=v1
+
+var _ admission.Defaulter[*unstructured.Unstructured] = &PipelineRunWebhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *PipelineRunWebhook) Default(ctx context.Context, obj *unstructured.Unstructured) error {
+	job := fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("pipelinerun-webhook")
+	log.V(5).Info("Applying defaults")
+	jobframework.ApplyDefaultLocalQueue(job.Object(), w.queues.DefaultLocalQueueExist)
+	return jobframework.ApplyDefaultForSuspend(ctx, job, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector)
+}
+
+// +kubebuilder:webhook:path=/validate-tekton-dev-v1-pipelinerun,mutating=false,failurePolicy=fail,sideEffects=None,groups=tekton.dev,resources=pipelineruns,verbs=create;update,versions=v1,name=vpipelinerun.kb.io,admissionReviewVersions=v1
+
+var _ admission.Validator[*unstructured.Unstructured] = &PipelineRunWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *PipelineRunWebhook) ValidateCreate(ctx context.Context, obj *unstructured.Unstructured) (admission.Warnings, error) {
+	log := ctrl.LoggerFrom(ctx).WithName("pipelinerun-webhook")
+	log.Info("Validating create")
+	return nil, w.validateCreate(fromObject(obj)).ToAggregate()
+}
+
+func (w *PipelineRunWebhook) validateCreate(job *PipelineRun) field.ErrorList {
+	var allErrors field.ErrorList
+	if w.manageJobsWithoutQueueName || jobframework.QueueName(job) != "" {
+		allErrors = append(allErrors, validateSpec(job)...)
+	}
+	allErrors = append(allErrors, jobframework.ValidateJobOnCreate(job)...)
+	return allErrors
+}
+
+// validateSpec checks that the tasks of the pipeline can be resolved by
+// Kueue, and that Kueue can inject the assigned flavors in their TaskRuns.
+// The tasks of a referenced Pipeline are checked when it is resolved.
+func validateSpec(job *PipelineRun) field.ErrorList {
+	spec, err := job.spec()
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath, nil, err.Error())}
+	}
+
+	var allErrors field.ErrorList
+	if spec.PipelineRef != nil && spec.PipelineRef.Resolver != "" {
+		allErrors = append(allErrors, field.Forbidden(pipelineRefPath.Child("resolver"), errPipelineResolver.Error()))
+	}
+	if spec.PipelineSpec != nil {
+		allErrors = append(allErrors, validatePipelineSpec(spec.PipelineSpec, spec.TaskRunSpecs)...)
+	}
+	// Kueue sets the node selectors and the tolerations of the taskRunSpecs
+	// when it starts the PipelineRun.
+	if !job.IsSuspended() {
+		return allErrors
+	}
+	for i := range spec.TaskRunSpecs {
+		podTemplate := spec.TaskRunSpecs[i].PodTemplate
+		if podTemplate == nil {
+			continue
+		}
+		podTemplatePath := taskRunSpecsPath.Index(i).Child("podTemplate")
+		if len(podTemplate.NodeSelector) > 0 {
+			allErrors = append(allErrors, field.Forbidden(podTemplatePath.Child("nodeSelector"), "must be set in spec.taskRunTemplate.podTemplate for a kueue managed PipelineRun"))
+		}
+		if len(podTemplate.Tolerations) > 0 {
+			allErrors = append(allErrors, field.Forbidden(podTemplatePath.Child("tolerations"), "must be set in spec.taskRunTemplate.podTemplate for a kueue managed PipelineRun"))
+		}
+	}
+	return allErrors
+}
+
+func validatePipelineSpec(pipeline *pipelineSpec, taskRunSpecs []taskRunSpec) field.ErrorList {
+	var allErrors field.ErrorList
+	for _, tasks := range []struct {
+		path  *field.Path
+		tasks []pipelineTask
+	}{
+		{path: pipelineSpecPath.Child("tasks"), tasks: pipeline.Tasks},
+		{path: pipelineSpecPath.Child("finally"), tasks: pipeline.Finally},
+	} {
+		for i := range tasks.tasks {
+			task := &tasks.tasks[i]
+			if task.TaskSpec == nil && task.TaskRef != nil {
+				if err := validateTaskRef(task.TaskRef); err != nil {
+					allErrors = append(allErrors, field.Forbidden(tasks.path.Index(i).Child("taskRef"), err.Error()))
+				}
+			}
+		}
+	}
+	if len(allErrors) > 0 {
+		return allErrors
+	}
+	// The referenced Tasks only change the requests, not the chains.
+	if _, err := taskChains(pipeline, taskRunSpecs); err != nil {
+		return field.ErrorList{field.Invalid(pipelineSpecPath, nil, err.Error())}
+	}
+	return nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *PipelineRunWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj *unstructured.Unstructured) (admission.Warnings, error) {
+	oldJob := fromObject(oldObj)
+	newJob := fromObject(newObj)
+	log := ctrl.LoggerFrom(ctx).WithName("pipelinerun-webhook")
+	if w.manageJobsWithoutQueueName || jobframework.QueueName(newJob) != "" {
+		log.Info("Validating update")
+		allErrors := jobframework.ValidateJobOnUpdate(oldJob, newJob, w.queues.DefaultLocalQueueExist)
+		allErrors = append(allErrors, w.validateCreate(newJob)...)
+		return nil, allErrors.ToAggregate()
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *PipelineRunWebhook) ValidateDelete(_ context.Context, _ *unstructured.Unstructured) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,203 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingpipelinerun "sigs.k8s.io/kueue/pkg/util/testingjobs/pipelinerun"
+)
+
+func TestDefault(t *testing.T) {
+	testcases := map[string]struct {
+		oldPipelineRun *unstructured.Unstructured
+		newPipelineRun *unstructured.Unstructured
+		manageAll      bool
+		defaultLqExist bool
+	}{
+		"unmanaged": {
+			oldPipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status("").Obj(),
+			newPipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status("").Obj(),
+		},
+		"managed - by config": {
+			oldPipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Status("").Obj(),
+			newPipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Obj(),
+			manageAll:      true,
+		},
+		"managed - by queue": {
+			oldPipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Queue("queue").Status("").Obj(),
+			newPipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").Queue("queue").Obj(),
+		},
+		"default lq is created, pipelinerun doesn't have queue label": {
+			defaultLqExist: true,
+			oldPipelineRun: testingpipelinerun.MakePipelineRun("pr", "default").Obj(),
+			newPipelineRun: testingpipelinerun.MakePipelineRun("pr", "default").Queue("default").Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().Build()
+			queueManager := qcache.NewManagerForUnitTests(cli, schdcache.New(cli))
+			if tc.defaultLqExist {
+				if err := queueManager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue("default", "default").
+					ClusterQueue("cluster-queue").Obj()); err != nil {
+					t.Fatalf("failed to create default local queue: %v", err)
+				}
+			}
+			wh := &PipelineRunWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+				queues:                     queueManager,
+			}
+			result := tc.oldPipelineRun.DeepCopy()
+			if err := wh.Default(ctx, result); err != nil {
+				t.Errorf("unexpected Default() error: %v", err)
+			}
+			if diff := cmp.Diff(tc.newPipelineRun, result); diff != "" {
+				t.Errorf("Default() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	dynamicMatrix := testingpipelinerun.MakePipelineRun("pr", "ns").
+		TaskRunSpec(map[string]any{
+			"pipelineTaskName": "build",
+			"podTemplate":      map[string]any{"nodeSelector": map[string]any{"pool": "ci"}},
+		})
+	if err := unstructured.SetNestedSlice(dynamicMatrix.Object, []any{
+		map[string]any{
+			"name": "build",
+			"matrix": map[string]any{
+				"params": []any{map[string]any{"name": "platform", "value": "$(params.platforms[*])"}},
+			},
+		},
+	}, "spec", "pipelineSpec", "tasks"); err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		pipelineRun *unstructured.Unstructured
+		manageAll   bool
+		wantErr     error
+	}{
+		"valid": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Queue("queue").
+				Task(testingpipelinerun.Task{Name: "build"}).
+				Obj(),
+		},
+		"valid pipeline reference": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				Queue("queue").
+				PipelineRef("ci").
+				Obj(),
+		},
+		"invalid unmanaged": {
+			pipelineRun: dynamicMatrix.Clone().Obj(),
+		},
+		"invalid managed - dynamic matrix and task node selector": {
+			pipelineRun: dynamicMatrix.Clone().Queue("queue").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(pipelineSpecPath, nil, `task "build": `+errDynamicMatrix.Error()),
+				field.Forbidden(taskRunSpecsPath.Index(0).Child("podTemplate", "nodeSelector"), "must be set in spec.taskRunTemplate.podTemplate for a kueue managed PipelineRun"),
+			}.ToAggregate(),
+		},
+		"invalid managed - pipeline resolver": {
+			pipelineRun: testingpipelinerun.MakePipelineRun("pr", "ns").
+				PipelineRefResolver("git").
+				Obj(),
+			manageAll: true,
+			wantErr: field.ErrorList{
+				field.Forbidden(pipelineRefPath.Child("resolver"), errPipelineResolver.Error()),
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wh := &PipelineRunWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+			}
+			_, gotErr := wh.ValidateCreate(ctx, tc.pipelineRun)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("validateCreate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	pipelineRun := testingpipelinerun.MakePipelineRun("pr", "ns").
+		Task(testingpipelinerun.Task{Name: "build"})
+
+	testcases := map[string]struct {
+		oldPipelineRun *unstructured.Unstructured
+		newPipelineRun *unstructured.Unstructured
+		wantErr        error
+	}{
+		"queue name can be changed while pending": {
+			oldPipelineRun: pipelineRun.Clone().Queue("queue").Obj(),
+			newPipelineRun: pipelineRun.Clone().Queue("queue2").Obj(),
+		},
+		"queue name cannot be changed while running": {
+			oldPipelineRun: pipelineRun.Clone().Queue("queue").Status("").Obj(),
+			newPipelineRun: pipelineRun.Clone().Queue("queue2").Status("").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(controllerconstants.QueueLabel), kueue.LocalQueueName("queue2"), "field is immutable"),
+			}.ToAggregate(),
+		},
+		"task node selector can be set when started": {
+			oldPipelineRun: pipelineRun.Clone().Queue("queue").Obj(),
+			newPipelineRun: pipelineRun.Clone().
+				Queue("queue").
+				Status("").
+				TaskRunSpec(map[string]any{
+					"pipelineTaskName": "build",
+					"podTemplate":      map[string]any{"nodeSelector": map[string]any{"instance": "spot"}},
+				}).
+				Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().Build()
+			wh := &PipelineRunWebhook{
+				queues: qcache.NewManagerForUnitTests(cli, schdcache.New(cli)),
+			}
+			_, gotErr := wh.ValidateUpdate(ctx, tc.oldPipelineRun, tc.newPipelineRun)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("ValidateUpdate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
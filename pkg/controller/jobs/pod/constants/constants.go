@@ -36,3 +36,23 @@ const (
 	IsGroupWorkloadAnnotationKey      = "kueue.x-k8s.io/is-group-workload"
 	IsGroupWorkloadAnnotationValue    = "true"
 )
+
+const (
+	// ServingBurstPriorityClassAnnotation enables the scalable serving mode on
+	// a serving object. It holds the name of the WorkloadPriorityClass used by
+	// the pods above the guaranteed minimum replicas.
+	ServingBurstPriorityClassAnnotation = "kueue.x-k8s.io/serving-burst-priority-class"
+	// ServingMinReplicasAnnotation holds the number of replicas of a serving
+	// object that are admitted with the guaranteed priority class.
+	ServingMinReplicasAnnotation = "kueue.x-k8s.io/serving-min-replicas"
+	// ServingGuaranteedPriorityClassAnnotation holds, on the pods, the name of
+	// the WorkloadPriorityClass used by the guaranteed replicas.
+	ServingGuaranteedPriorityClassAnnotation = "kueue.x-k8s.io/serving-guaranteed-priority-class"
+	// ServingGroupLabel groups the pods of a serving object in scalable serving mode.
+	ServingGroupLabel = "kueue.x-k8s.io/serving-group"
+	// ServingTierLabel holds the tier of a pod in scalable serving mode.
+	ServingTierLabel = "kueue.x-k8s.io/serving-tier"
+
+	ServingTierGuaranteed = "Guaranteed"
+	ServingTierBurst      = "Burst"
+)
@@ -147,10 +147,15 @@ func (h *workloadHandler) queueReconcileForChildPod(ctx context.Context, object
 
 	// Compose request for a pod group if workload has an "is-group-workload" annotation
 	if w.Annotations[podconstants.IsGroupWorkloadAnnotationKey] == podconstants.IsGroupWorkloadAnnotationValue {
-		log.V(5).Info("Queueing reconcile for the pod group", "groupName", w.Name, "namespace", w.Namespace)
+		// The workload slices of the elastic pod group are labeled with the group name.
+		groupName := w.Name
+		if name, found := w.Labels[podconstants.GroupNameLabel]; found {
+			groupName = name
+		}
+		log.V(5).Info("Queueing reconcile for the pod group", "groupName", groupName, "namespace", w.Namespace)
 		q.Add(reconcile.Request{
 			NamespacedName: types.NamespacedName{
-				Name:      w.Name,
+				Name:      groupName,
 				Namespace: fmt.Sprintf("group/%s", w.Namespace),
 			},
 		})
@@ -58,6 +58,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
 	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 const (
@@ -166,6 +167,7 @@ var (
 	_ jobframework.ComposableJob                   = (*Pod)(nil)
 	_ jobframework.JobWithCustomWorkloadConditions = (*Pod)(nil)
 	_ jobframework.TopLevelJob                     = (*Pod)(nil)
+	_ jobframework.ComposableJobWithWorkloadSlices = (*Pod)(nil)
 )
 
 // PodOption is a function type that modifies a Pod. It allows customization of a Pod's
@@ -356,16 +358,13 @@ func (p *Pod) Finished(ctx context.Context) (message string, success, finished b
 	}
 	isActive := false
 	succeededCount := 0
-
-	groupTotalCount, err := p.groupTotalCount()
-	if err != nil {
-		log.V(2).Error(err, "failed to check if pod group is finished")
-		message = "failed to check if pod group is finished"
-		return message, success, false
-	}
+	failedCount := 0
 	for _, pod := range p.list.Items {
-		if pod.Status.Phase == corev1.PodSucceeded {
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
 			succeededCount++
+		case corev1.PodFailed:
+			failedCount++
 		}
 
 		if !utilpod.IsTerminated(&pod) {
@@ -375,6 +374,32 @@ func (p *Pod) Finished(ctx context.Context) (message string, success, finished b
 
 	unretriableGroup := p.isUnretriableGroup()
 
+	// The elastic pod group doesn't have a fixed size, the failed pods shrink the group.
+	// Unless the group is unretriable, it's finished once all the remaining pods succeeded,
+	// so that new pods can still join the group while none of its pods are active.
+	if p.isElasticGroup() {
+		if isActive {
+			return message, success, false
+		}
+		if unretriableGroup {
+			message = fmt.Sprintf("Pods succeeded: %d/%d.", succeededCount, len(p.list.Items))
+			return message, success, finished
+		}
+		groupSize := len(p.list.Items) - failedCount
+		if succeededCount == 0 || succeededCount != groupSize {
+			return message, success, false
+		}
+		message = fmt.Sprintf("Pods succeeded: %d/%d.", succeededCount, groupSize)
+		return message, success, finished
+	}
+
+	groupTotalCount, err := p.groupTotalCount()
+	if err != nil {
+		log.V(2).Error(err, "failed to check if pod group is finished")
+		message = "failed to check if pod group is finished"
+		return message, success, false
+	}
+
 	if succeededCount == groupTotalCount || (!isActive && unretriableGroup) {
 		message = fmt.Sprintf("Pods succeeded: %d/%d.", succeededCount, groupTotalCount)
 	} else {
@@ -767,22 +792,27 @@ func constructGroupPodSets(pods []corev1.Pod) ([]kueue.PodSet, error) {
 	return resultPodSets, nil
 }
 
-// validatePodGroupMetadata validates metadata of all members of the pod group
-func (p *Pod) validatePodGroupMetadata(r record.EventRecorder, activePods []corev1.Pod) error {
+// validatePodGroupMetadata validates metadata of all members of the pod group.
+// The group total count is only checked against the number of active pods when
+// checkGroupSize is true, as the elastic pod groups can shrink once admitted.
+func (p *Pod) validatePodGroupMetadata(r record.EventRecorder, activePods []corev1.Pod, checkGroupSize bool) error {
 	groupTotalCount, err := p.groupTotalCount()
 	if err != nil {
 		return err
 	}
 
-	_, err = utilpod.ReadUIntFromLabelBelowBound(p.Object(), kueue.PodGroupPodIndexLabel, groupTotalCount)
-	if utilpod.IgnoreLabelNotFoundError(err) != nil {
-		return err
+	elasticGroup := p.isElasticGroup()
+	if !elasticGroup {
+		_, err = utilpod.ReadUIntFromLabelBelowBound(p.Object(), kueue.PodGroupPodIndexLabel, groupTotalCount)
+		if utilpod.IgnoreLabelNotFoundError(err) != nil {
+			return err
+		}
 	}
 
 	originalQueue := jobframework.QueueName(p)
 	_, useFastAdmission := p.pod.GetAnnotations()[podconstants.GroupFastAdmissionAnnotationKey]
 
-	if !useFastAdmission && len(activePods) < groupTotalCount {
+	if checkGroupSize && !useFastAdmission && len(activePods) < groupTotalCount {
 		errMsg := fmt.Sprintf("'%s' group has fewer runnable pods than expected", podGroupName(p.pod))
 		r.Eventf(p.Object(), corev1.EventTypeWarning, jobframework.ReasonErrWorkloadCompose, errMsg)
 		return jobframework.UnretryableError(errMsg)
@@ -800,6 +830,17 @@ func (p *Pod) validatePodGroupMetadata(r record.EventRecorder, activePods []core
 				originalQueue, podInGroupQueue))
 		}
 
+		if podInGroupElastic := workloadslicing.Enabled(&podInGroup); podInGroupElastic != elasticGroup {
+			return jobframework.UnretryableError(fmt.Sprintf("pods '%s' and '%s' has different '%s' values",
+				p.pod.GetName(), podInGroup.GetName(),
+				workloadslicing.EnabledAnnotationKey))
+		}
+
+		// The pods added to the elastic pod group don't need to follow its initial size.
+		if elasticGroup {
+			continue
+		}
+
 		tc, err := strconv.Atoi(podInGroup.GetAnnotations()[podconstants.GroupTotalCountAnnotation])
 		if err != nil {
 			return fmt.Errorf("failed to extract '%s' annotation from the pod '%s': %w",
@@ -1053,13 +1094,17 @@ func (p *Pod) ConstructComposableWorkload(ctx context.Context, c client.Client,
 		return jobframework.ConstructWorkload(ctx, c, p, labelKeysToCopy)
 	}
 
+	if p.isElasticGroup() {
+		return p.constructWorkloadSlice(ctx, c, r, labelKeysToCopy)
+	}
+
 	activePods, inactivePods := p.partitionPods()
 
 	if err := p.finalizePods(ctx, c, inactivePods); err != nil {
 		return nil, err
 	}
 
-	err := p.validatePodGroupMetadata(r, activePods)
+	err := p.validatePodGroupMetadata(r, activePods, true)
 	if err != nil {
 		return nil, err
 	}
@@ -1105,6 +1150,77 @@ func (p *Pod) ConstructComposableWorkload(ctx context.Context, c client.Client,
 	return wl, nil
 }
 
+// constructWorkloadSlice returns a new workload slice for the elastic pod group.
+// The first workload slice is named after the group and waits for the initial size
+// of the group, while the next ones admit the pods added to the running group.
+func (p *Pod) constructWorkloadSlice(ctx context.Context, c client.Client, r record.EventRecorder, labelKeysToCopy []string) (*kueue.Workload, error) {
+	activePods, inactivePods := p.partitionPods()
+
+	if err := p.finalizePods(ctx, c, inactivePods); err != nil {
+		return nil, err
+	}
+
+	workloadSlices, err := p.FindNotFinishedWorkloadSlices(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.validatePodGroupMetadata(r, activePods, len(workloadSlices) == 0); err != nil {
+		return nil, err
+	}
+
+	p.list.Items = activePods
+	podSets, err := jobframework.JobPodSets(ctx, p)
+	if err != nil {
+		if jobframework.IsUnretryableError(err) {
+			r.Eventf(p.Object(), corev1.EventTypeWarning, jobframework.ReasonErrWorkloadCompose, err.Error())
+		}
+		return nil, err
+	}
+
+	name := p.workloadName()
+	if len(workloadSlices) > 0 {
+		latestSlice := &workloadSlices[len(workloadSlices)-1]
+		podSets = withRemovedRoles(podSets, latestSlice)
+		name = jobframework.GetWorkloadNameForOwnerWithGVKAndGeneration(podGroupName(p.pod), latestSlice.UID, gvk, int64(len(activePods)))
+	}
+	if len(podSets) > 8 {
+		return nil, jobframework.UnretryableError(errMsgIncorrectGroupRoleCount)
+	}
+
+	wl := NewGroupWorkload(name, p.Object(), podSets, nil)
+
+	for _, pod := range p.list.Items {
+		if err := controllerutil.SetOwnerReference(&pod, wl, c.Scheme()); err != nil {
+			return nil, err
+		}
+	}
+	labelsToCopy, err := p.getWorkloadLabels(labelKeysToCopy)
+	if err != nil {
+		return nil, err
+	}
+	utilmaps.Copy(&wl.Labels, labelsToCopy)
+	utilmaps.Copy(&wl.Labels, map[string]string{podconstants.GroupNameLabel: podGroupName(p.pod)})
+	return wl, nil
+}
+
+// withRemovedRoles adds the roles of the workload slice which don't have any pods
+// in the group anymore, with zero count, so that the pod sets are compatible with
+// the workload slice.
+func withRemovedRoles(podSets []kueue.PodSet, wl *kueue.Workload) []kueue.PodSet {
+	for _, ps := range wl.Spec.PodSets {
+		if !slices.ContainsFunc(podSets, func(jobPodSet kueue.PodSet) bool { return jobPodSet.Name == ps.Name }) {
+			removedRole := *ps.DeepCopy()
+			removedRole.Count = 0
+			podSets = append(podSets, removedRole)
+		}
+	}
+	slices.SortFunc(podSets, func(a, b kueue.PodSet) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return podSets
+}
+
 func (p *Pod) workloadName() string {
 	if prebuiltWorkloadName, usePrebuiltWorkload := jobframework.PrebuiltWorkloadFor(p); usePrebuiltWorkload {
 		return prebuiltWorkloadName
@@ -1124,6 +1240,16 @@ func (p *Pod) ListChildWorkloads(ctx context.Context, c client.Client, key types
 
 	// Get related workloads for the pod group
 	if p.isGroup {
+		// The workload slices of the elastic pod group are labeled with the group name.
+		if err := c.List(ctx, workloads, client.InNamespace(key.Namespace),
+			client.MatchingLabels{podconstants.GroupNameLabel: key.Name}); err != nil {
+			log.Error(err, "Unable to list related workload slices for the pod group")
+			return nil, err
+		}
+		if slices.ContainsFunc(workloads.Items, func(wl kueue.Workload) bool { return wl.Name == key.Name }) {
+			return workloads, nil
+		}
+
 		workload := &kueue.Workload{}
 		if err := c.Get(ctx, types.NamespacedName{Name: key.Name, Namespace: key.Namespace}, workload); err != nil {
 			if apierrors.IsNotFound(err) {
@@ -1133,7 +1259,7 @@ func (p *Pod) ListChildWorkloads(ctx context.Context, c client.Client, key types
 			return nil, err
 		}
 
-		workloads.Items = []kueue.Workload{*workload}
+		workloads.Items = append(workloads.Items, *workload)
 		return workloads, nil
 	}
 
@@ -1155,6 +1281,10 @@ func (p *Pod) FindMatchingWorkloads(ctx context.Context, c client.Client, r reco
 		return jobframework.FindMatchingWorkloads(ctx, c, p)
 	}
 
+	if p.isElasticGroup() {
+		return p.findMatchingWorkloadSlice(ctx, c, r)
+	}
+
 	// Find a matching workload first if there is one.
 	workload := &kueue.Workload{}
 	if err := c.Get(ctx, types.NamespacedName{Name: groupName, Namespace: p.pod.GetNamespace()}, workload); err != nil {
@@ -1246,6 +1376,77 @@ func (p *Pod) FindMatchingWorkloads(ctx context.Context, c client.Client, r reco
 	return workload, []*kueue.Workload{}, nil
 }
 
+// findMatchingWorkloadSlice returns the workload slice matching the elastic pod group.
+// The removed pods are finalized and the workload slice is shrunk, while the pods
+// added to the admitted group require a new workload slice, so none is returned.
+func (p *Pod) findMatchingWorkloadSlice(ctx context.Context, c client.Client, r record.EventRecorder) (*kueue.Workload, []*kueue.Workload, error) {
+	workloadSlices, err := p.FindNotFinishedWorkloadSlices(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(workloadSlices) == 0 {
+		return nil, nil, nil
+	}
+
+	// Do not clean up more pods until observing previous operations
+	if !p.satisfiedExcessPods {
+		return nil, nil, errPendingOps
+	}
+
+	activePods, inactivePods := p.partitionPods()
+	if err := p.finalizePods(ctx, c, inactivePods); err != nil {
+		return nil, nil, err
+	}
+
+	latestSlice := &workloadSlices[len(workloadSlices)-1]
+	// Keep the workload slice of the group without active pods, so that the group is finished.
+	if len(activePods) == 0 {
+		return latestSlice, nil, nil
+	}
+
+	jobPodSets, err := constructGroupPodSets(activePods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wl, compatible, err := workloadslicing.EnsureWorkloadSlicesOf(ctx, c, p.clock, withRemovedRoles(jobPodSets, latestSlice), workloadSlices, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !compatible {
+		toDelete := make([]*kueue.Workload, len(workloadSlices))
+		for i := range workloadSlices {
+			toDelete[i] = &workloadSlices[i]
+		}
+		return nil, toDelete, nil
+	}
+
+	p.list.Items = activePods
+	if wl == nil {
+		return nil, []*kueue.Workload{}, nil
+	}
+	if err := p.EnsureWorkloadOwnedByAllMembers(ctx, c, r, wl); err != nil {
+		return nil, nil, err
+	}
+	return wl, []*kueue.Workload{}, nil
+}
+
+// FindNotFinishedWorkloadSlices returns the not finished workload slices of the pod
+// or the pod group, sorted from the oldest. The workload slices of the pod group are
+// found by the pod group name label.
+func (p *Pod) FindNotFinishedWorkloadSlices(ctx context.Context, c client.Client) ([]kueue.Workload, error) {
+	if !p.isGroup {
+		return workloadslicing.FindNotFinishedWorkloads(ctx, c, &p.pod, gvk)
+	}
+
+	workloads := &kueue.WorkloadList{}
+	if err := c.List(ctx, workloads, client.InNamespace(p.pod.GetNamespace()),
+		client.MatchingLabels{podconstants.GroupNameLabel: podGroupName(p.pod)}); err != nil {
+		return nil, err
+	}
+	return workloadslicing.NotFinishedWorkloads(workloads.Items), nil
+}
+
 func (p *Pod) equivalentToWorkload(wl *kueue.Workload, jobPodSets []kueue.PodSet) bool {
 	workloadFinished := workload.IsFinished(wl)
 
@@ -1284,6 +1485,12 @@ func (p *Pod) isServing() bool {
 	return p.isGroup && p.pod.Annotations[podconstants.GroupServingAnnotationKey] == podconstants.GroupServingAnnotationValue
 }
 
+// isElasticGroup returns true if the pod group opted in for workload slicing,
+// which allows it to grow and shrink once admitted.
+func (p *Pod) isElasticGroup() bool {
+	return p.isGroup && workloadslicing.Enabled(&p.pod)
+}
+
 func (p *Pod) isReclaimable() bool {
 	return p.isGroup && !p.isServing()
 }
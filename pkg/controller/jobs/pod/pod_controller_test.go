@@ -19,6 +19,7 @@ package pod
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"syscall"
 	"testing"
@@ -51,6 +52,7 @@ import (
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
 	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/job"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/raycluster"
@@ -5709,6 +5711,448 @@ func TestReconciler(t *testing.T) {
 	}
 }
 
+func TestReconciler_ElasticPodGroup(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	const (
+		localUserQueueName = "user-queue"
+		clusterQueueName   = "cq"
+		podUID             = "dc85db45"
+	)
+
+	basePodWrapper := testingpod.MakePod("pod", "ns").
+		UID("test-uid").
+		Queue(localUserQueueName).
+		Request(corev1.ResourceCPU, "1").
+		Image("", nil).
+		ManagedByKueueLabel().
+		Group("test-group").
+		GroupTotalCount("2").
+		Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue)
+
+	baseSliceWrapper := utiltestingapi.MakeWorkload("test-group", "ns").
+		UID("test-group-uid").
+		Finalizers(kueue.ResourceInUseFinalizerName).
+		Queue(localUserQueueName).
+		Label(podconstants.GroupNameLabel, "test-group").
+		Annotations(map[string]string{
+			podconstants.IsGroupWorkloadAnnotationKey: podconstants.IsGroupWorkloadAnnotationValue,
+			workloadslicing.EnabledAnnotationKey:      workloadslicing.EnabledAnnotationValue,
+			kueue.WorkloadSliceNameAnnotation:         "test-group",
+		})
+
+	admission := func(count int32) *kueue.Admission {
+		return utiltestingapi.MakeAdmission(clusterQueueName).
+			PodSets(utiltestingapi.MakePodSetAssignment(kueue.NewPodSetReference(podUID)).
+				Assignment(corev1.ResourceCPU, "unit-test-flavor", strconv.Itoa(int(count))).
+				Count(count).
+				Obj()).
+			Obj()
+	}
+
+	scaledUpSliceName := jobframework.GetWorkloadNameForOwnerWithGVKAndGeneration("test-group", "test-group-uid", gvk, 3)
+
+	testCases := map[string]struct {
+		pods          []corev1.Pod
+		workloads     []kueue.Workload
+		wantPods      []corev1.Pod
+		wantWorkloads []kueue.Workload
+		wantErr       error
+		wantEvents    []utiltesting.EventRecord
+	}{
+		"first workload slice is created for the initial size of the group": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().KueueSchedulingGate().Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").KueueSchedulingGate().Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().KueueSchedulingGate().Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").KueueSchedulingGate().Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					UID("").
+					PodSets(
+						*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).
+							Request(corev1.ResourceCPU, "1").
+							SchedulingGates(corev1.PodSchedulingGate{Name: podconstants.SchedulingGateName}).
+							PodIndexLabel(ptr.To(kueue.PodGroupPodIndexLabel)).
+							Obj(),
+					).
+					Priority(0).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "pod", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CreatedWorkload",
+					Message:   "Created Workload: ns/test-group",
+				},
+			},
+		},
+		"first workload slice waits for the initial size of the group": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().KueueSchedulingGate().Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().KueueSchedulingGate().Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "pod", Namespace: "ns"},
+					EventType: "Warning",
+					Reason:    "ErrWorkloadCompose",
+					Message:   "'test-group' group has fewer runnable pods than expected",
+				},
+			},
+		},
+		"workload slice is shrunk when a pod of the admitted group fails": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod3").StatusPhase(corev1.PodFailed).Obj(),
+			},
+			workloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 3).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod3", "test-uid").
+					ReserveQuotaAt(admission(3), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().Name("pod3").StatusPhase(corev1.PodFailed).Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod3", "test-uid").
+					ReserveQuotaAt(admission(3), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+		},
+		"new workload slice is created for the pod added to the admitted group": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod3").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod3").KueueSchedulingGate().Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Obj(),
+				*baseSliceWrapper.Clone().
+					Name(scaledUpSliceName).
+					UID("").
+					Annotation(workloadslicing.WorkloadSliceReplacementFor, "ns/test-group").
+					PodSets(
+						*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 3).
+							Request(corev1.ResourceCPU, "1").
+							PodIndexLabel(ptr.To(kueue.PodGroupPodIndexLabel)).
+							Obj(),
+					).
+					Priority(0).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod3", "test-uid").
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "pod", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CreatedWorkload",
+					Message:   "Created Workload: ns/" + scaledUpSliceName,
+				},
+			},
+		},
+		"pod added to the group is started once the new workload slice is admitted": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod3").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					FinishedAt(now).
+					Obj(),
+				*baseSliceWrapper.Clone().
+					Name(scaledUpSliceName).
+					UID("scaled-up-uid").
+					Annotation(workloadslicing.WorkloadSliceReplacementFor, "ns/test-group").
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 3).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod3", "test-uid").
+					ReserveQuotaAt(admission(3), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodRunning).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().
+					Name("pod3").
+					Label(constants.PodSetLabel, podUID).
+					Label(constants.LocalQueueLabel, localUserQueueName).
+					Label(constants.ClusterQueueLabel, clusterQueueName).
+					Annotation(kueue.WorkloadAnnotation, scaledUpSliceName).
+					Annotation(kueue.WorkloadSliceNameAnnotation, "test-group").
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					FinishedAt(now).
+					Obj(),
+				*baseSliceWrapper.Clone().
+					Name(scaledUpSliceName).
+					UID("scaled-up-uid").
+					Annotation(workloadslicing.WorkloadSliceReplacementFor, "ns/test-group").
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 3).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod3", "test-uid").
+					ReserveQuotaAt(admission(3), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "pod3", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "Started",
+					Message:   "Admitted by clusterQueue cq",
+				},
+			},
+		},
+		"elastic group is finished when all the pods are terminated": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodSucceeded).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodFailed).Obj(),
+			},
+			workloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().StatusPhase(corev1.PodSucceeded).Obj(),
+				*basePodWrapper.Clone().Name("pod2").StatusPhase(corev1.PodFailed).Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 1).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadFinished,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadFinishedReasonSucceeded,
+						Message: "Pods succeeded: 1/1.",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "pod", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "FinishedWorkload",
+					Message:   "Workload 'ns/test-group' is declared finished",
+				},
+			},
+		},
+		"elastic group isn't finished when all its pods failed": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().KueueFinalizer().StatusPhase(corev1.PodFailed).Obj(),
+				*basePodWrapper.Clone().KueueFinalizer().Name("pod2").StatusPhase(corev1.PodFailed).Obj(),
+			},
+			workloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().StatusPhase(corev1.PodFailed).Obj(),
+				*basePodWrapper.Clone().Name("pod2").StatusPhase(corev1.PodFailed).Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+		},
+		"unretriable elastic group is finished when all the pods are terminated": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().
+					KueueFinalizer().
+					Annotation(podconstants.RetriableInGroupAnnotationKey, podconstants.RetriableInGroupAnnotationValue).
+					StatusPhase(corev1.PodSucceeded).
+					Obj(),
+				*basePodWrapper.Clone().
+					KueueFinalizer().
+					Name("pod2").
+					Annotation(podconstants.RetriableInGroupAnnotationKey, podconstants.RetriableInGroupAnnotationValue).
+					StatusPhase(corev1.PodFailed).
+					Obj(),
+			},
+			workloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 2).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*basePodWrapper.Clone().
+					Annotation(podconstants.RetriableInGroupAnnotationKey, podconstants.RetriableInGroupAnnotationValue).
+					StatusPhase(corev1.PodSucceeded).
+					Obj(),
+				*basePodWrapper.Clone().
+					Name("pod2").
+					Annotation(podconstants.RetriableInGroupAnnotationKey, podconstants.RetriableInGroupAnnotationValue).
+					StatusPhase(corev1.PodFailed).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseSliceWrapper.Clone().
+					PodSets(*utiltestingapi.MakePodSet(kueue.NewPodSetReference(podUID), 1).Request(corev1.ResourceCPU, "1").Obj()).
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod", "test-uid").
+					OwnerReference(corev1.SchemeGroupVersion.WithKind("Pod"), "pod2", "test-uid").
+					ReserveQuotaAt(admission(2), now).
+					AdmittedAt(true, now).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadFinished,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadFinishedReasonSucceeded,
+						Message: "Pods succeeded: 1/1.",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "pod", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "FinishedWorkload",
+					Message:   "Workload 'ns/test-group' is declared finished",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, true)
+
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge})
+			indexer := utiltesting.AsIndexer(clientBuilder)
+			if err := SetupIndexes(ctx, indexer); err != nil {
+				t.Fatalf("Could not setup indexes: %v", err)
+			}
+
+			kcBuilder := clientBuilder.WithObjects(utiltesting.MakeNamespace("ns")).
+				WithObjects(utiltestingapi.MakeResourceFlavor("unit-test-flavor").Obj())
+			for i := range tc.pods {
+				kcBuilder = kcBuilder.WithObjects(&tc.pods[i])
+			}
+			for i := range tc.workloads {
+				kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
+			}
+
+			kClient := kcBuilder.Build()
+			for _, testWl := range tc.workloads {
+				if err := kClient.Create(ctx, &testWl); err != nil {
+					t.Fatalf("Could not create workload: %v", err)
+				}
+			}
+			recorder := &utiltesting.EventRecorder{}
+			reconciler, err := NewReconciler(ctx, kClient, indexer, recorder, jobframework.WithClock(testingclock.NewFakeClock(now)))
+			if err != nil {
+				t.Errorf("Error creating the reconciler: %v", err)
+			}
+
+			_, err = reconciler.Reconcile(ctx, reconcileRequestForPod(&tc.pods[0]))
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile returned error (-want,+got):\n%s", diff)
+			}
+
+			var gotPods corev1.PodList
+			if err := kClient.List(ctx, &gotPods); err != nil {
+				t.Fatalf("Could not get Pods after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPods, gotPods.Items, podCmpOpts...); diff != "" {
+				t.Errorf("Pods after reconcile (-want,+got):\n%s", diff)
+			}
+
+			var gotWorkloads kueue.WorkloadList
+			if err := kClient.List(ctx, &gotWorkloads); err != nil {
+				t.Fatalf("Could not get Workloads after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads.Items, defaultWorkloadCmpOpts...); diff != "" {
+				t.Errorf("Workloads after reconcile (-want,+got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantEvents, recorder.RecordedEvents, cmpopts.SortSlices(utiltesting.SortEvents)); diff != "" {
+				t.Errorf("unexpected events (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestReconciler_ErrorFinalizingPod(t *testing.T) {
 	now := time.Now().Truncate(time.Second)
 
@@ -36,9 +36,11 @@ import (
 	ctrlconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/serving"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	"sigs.k8s.io/kueue/pkg/util/webhook"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 var (
@@ -50,6 +52,7 @@ var (
 	prebuiltWorkloadLabelPath      = labelsPath.Key(ctrlconstants.PrebuiltWorkloadLabel)
 	groupTotalCountAnnotationPath  = annotationsPath.Key(podconstants.GroupTotalCountAnnotation)
 	retriableInGroupAnnotationPath = annotationsPath.Key(podconstants.RetriableInGroupAnnotationKey)
+	elasticJobAnnotationPath       = annotationsPath.Key(workloadslicing.EnabledAnnotationKey)
 )
 
 type PodWebhook struct {
@@ -185,6 +188,11 @@ func (w *PodWebhook) Default(ctx context.Context, obj *corev1.Pod) error {
 		if err := pod.addRoleHash(); err != nil {
 			return err
 		}
+		if suspendByParent {
+			if err := serving.DefaultTier(ctx, w.client, &pod.pod); err != nil {
+				return err
+			}
+		}
 		// copy back changes to the object
 		pod.pod.DeepCopyInto(obj)
 	}
@@ -205,6 +213,7 @@ func (w *PodWebhook) ValidateCreate(ctx context.Context, obj *corev1.Pod) (admis
 
 	allErrs := jobframework.ValidateJobOnCreate(pod)
 	allErrs = append(allErrs, validateCommon(pod)...)
+	allErrs = append(allErrs, validateElasticPodGroup(pod)...)
 
 	if warn := warningForPodManagedLabel(pod); warn != "" {
 		warnings = append(warnings, warn)
@@ -305,6 +314,29 @@ func validatePodGroupMetadata(p *Pod) field.ErrorList {
 	return allErrs
 }
 
+// validateElasticPodGroup validates that only the pod groups are elastic, and that
+// they don't use the fast admission nor the serving mode, which size is fixed.
+func validateElasticPodGroup(pod *Pod) field.ErrorList {
+	if !workloadslicing.Enabled(pod.Object()) {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if podGroupName(pod.pod) == "" {
+		return append(allErrs, field.Forbidden(elasticJobAnnotationPath,
+			fmt.Sprintf("only the pods with the '%s' label can be elastic", podconstants.GroupNameLabel)))
+	}
+	if _, useFastAdmission := pod.pod.GetAnnotations()[podconstants.GroupFastAdmissionAnnotationKey]; useFastAdmission {
+		allErrs = append(allErrs, field.Forbidden(elasticJobAnnotationPath,
+			fmt.Sprintf("the elastic pod group can't use the '%s' annotation", podconstants.GroupFastAdmissionAnnotationKey)))
+	}
+	if pod.pod.GetAnnotations()[podconstants.GroupServingAnnotationKey] == podconstants.GroupServingAnnotationValue {
+		allErrs = append(allErrs, field.Forbidden(elasticJobAnnotationPath,
+			fmt.Sprintf("the elastic pod group can't use the '%s' annotation", podconstants.GroupServingAnnotationKey)))
+	}
+	return allErrs
+}
+
 func validateTopologyRequest(pod *Pod) field.ErrorList {
 	return jobframework.ValidateTASPodSetRequest(metaPath, &pod.pod.ObjectMeta)
 }
@@ -52,6 +52,7 @@ import (
 	testingpytorchjob "sigs.k8s.io/kueue/pkg/util/testingjobs/pytorchjob"
 	testingtfjob "sigs.k8s.io/kueue/pkg/util/testingjobs/tfjob"
 	testingxgboostjob "sigs.k8s.io/kueue/pkg/util/testingjobs/xgboostjob"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/kubeflow/jobs"
 	_ "sigs.k8s.io/kueue/pkg/controller/jobs/mpijob"
@@ -83,6 +84,18 @@ func TestDefault(t *testing.T) {
 		t.Fatalf("failed to parse namespace selector")
 	}
 
+	servingPod := func(name, tier, priorityClass string) *testingpod.PodWrapper {
+		return testingpod.MakePod(name, defaultNamespace.Name).
+			SuspendedByParent("apps/deployment").
+			Queue("test-queue").
+			Label(podconstants.ServingGroupLabel, "test-deployment").
+			Label(podconstants.ServingTierLabel, tier).
+			Label(constants.WorkloadPriorityClassLabel, priorityClass).
+			Annotation(podconstants.ServingMinReplicasAnnotation, "2").
+			Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+			Annotation(podconstants.ServingGuaranteedPriorityClassAnnotation, "guaranteed")
+	}
+
 	testCases := map[string]struct {
 		enableTopologyAwareScheduling bool
 
@@ -549,6 +562,32 @@ func TestDefault(t *testing.T) {
 				Queue("queue").
 				Obj(),
 		},
+		"serving pod within the minimum replicas joins the guaranteed tier": {
+			initObjects: []client.Object{
+				defaultNamespace,
+				servingPod("guaranteed-pod", podconstants.ServingTierGuaranteed, "guaranteed").Obj(),
+				servingPod("terminated-pod", podconstants.ServingTierGuaranteed, "guaranteed").
+					StatusPhase(corev1.PodSucceeded).
+					Obj(),
+			},
+			pod: servingPod("test-pod", podconstants.ServingTierBurst, "burst").Obj(),
+			want: servingPod("test-pod", podconstants.ServingTierGuaranteed, "guaranteed").
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				Obj(),
+		},
+		"serving pod above the minimum replicas stays in the burst tier": {
+			initObjects: []client.Object{
+				defaultNamespace,
+				servingPod("guaranteed-pod-1", podconstants.ServingTierGuaranteed, "guaranteed").Obj(),
+				servingPod("guaranteed-pod-2", podconstants.ServingTierGuaranteed, "guaranteed").Obj(),
+			},
+			pod: servingPod("test-pod", podconstants.ServingTierBurst, "burst").Obj(),
+			want: servingPod("test-pod", podconstants.ServingTierBurst, "burst").
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				Obj(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -686,9 +725,10 @@ func TestGetRoleHash(t *testing.T) {
 func TestValidateCreate(t *testing.T) {
 	t.Cleanup(jobframework.EnableIntegrationsForTest(t, "batch/job"))
 	testCases := map[string]struct {
-		pod       *corev1.Pod
-		wantErr   error
-		wantWarns admission.Warnings
+		pod               *corev1.Pod
+		enableElasticJobs bool
+		wantErr           error
+		wantWarns         admission.Warnings
 	}{
 		"pod owner is managed by kueue": {
 			pod: testingpod.MakePod("test-pod", "test-ns").
@@ -806,10 +846,71 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"elastic pod group": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Group("test-group").
+				GroupTotalCount("3").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			enableElasticJobs: true,
+		},
+		"elastic pod without group name": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			enableElasticJobs: true,
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metadata.annotations[kueue.x-k8s.io/elastic-job]",
+				},
+			}.ToAggregate(),
+		},
+		"elastic pod without group name when ElasticJobsViaWorkloadSlices is disabled": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+		},
+		"elastic pod group with fast admission": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Group("test-group").
+				GroupTotalCount("3").
+				Annotation(podconstants.GroupFastAdmissionAnnotationKey, podconstants.GroupFastAdmissionAnnotationValue).
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			enableElasticJobs: true,
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metadata.annotations[kueue.x-k8s.io/elastic-job]",
+				},
+			}.ToAggregate(),
+		},
+		"elastic serving pod group": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Group("test-group").
+				GroupTotalCount("3").
+				Annotation(podconstants.GroupServingAnnotationKey, podconstants.GroupServingAnnotationValue).
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			enableElasticJobs: true,
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metadata.annotations[kueue.x-k8s.io/elastic-job]",
+				},
+			}.ToAggregate(),
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.enableElasticJobs)
 			builder := utiltesting.NewClientBuilder()
 			cli := builder.Build()
 
@@ -202,7 +202,9 @@ func (w *RayClusterWebhook) validateTopologyRequest(ctx context.Context, rayJob
 	if podSetsErr == nil {
 		headGroupPodSet := podset.FindPodSetByName(podSets, headGroupPodSetName)
 		allErrs = append(allErrs, jobframework.ValidateSliceSizeAnnotationUpperBound(headGroupMetaPath, &rayJob.Spec.HeadGroupSpec.Template.ObjectMeta, headGroupPodSet)...)
-		allErrs = append(allErrs, jobframework.ValidatePodSetGroupingTopology(podSets, BuildPodSetAnnotationsPathByNameMap(&rayJob.Spec, headGroupMetaPath, workerGroupSpecsPath))...)
+		podSetAnnotationsPathByName := BuildPodSetAnnotationsPathByNameMap(&rayJob.Spec, headGroupMetaPath, workerGroupSpecsPath)
+		allErrs = append(allErrs, jobframework.ValidatePodSetGroupingTopology(podSets, podSetAnnotationsPathByName)...)
+		allErrs = append(allErrs, jobframework.ValidatePodSetTopologyConstraints(podSets, podSetAnnotationsPathByName)...)
 	}
 
 	for i, wgs := range rayJob.Spec.WorkerGroupSpecs {
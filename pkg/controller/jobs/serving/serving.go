@@ -0,0 +1,192 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package serving implements the scalable serving mode shared by the serving
+// integrations.
+//
+// In scalable serving mode, every pod of a serving object is admitted as its
+// own Workload through the pod integration. The pods up to the minimum replicas
+// use the guaranteed WorkloadPriorityClass of the serving object, while the pods
+// added by autoscaler scale-ups use the lower burst WorkloadPriorityClass, so
+// that they can be preempted to reclaim the quota.
+//
+// The serving objects aren't admitted as one Workload with a slice per
+// scale-up: the slices of a Workload replace each other and share a single
+// priority, so a burst replica couldn't be preempted without evicting the
+// guaranteed ones. Besides, the ReplicaSets create and delete the pods one by
+// one, with no stable index a slice could keep track of.
+package serving
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+)
+
+const hashLength = 5
+
+var (
+	labelsPath                  = field.NewPath("metadata", "labels")
+	annotationsPath             = field.NewPath("metadata", "annotations")
+	guaranteedPriorityClassPath = labelsPath.Key(controllerconstants.WorkloadPriorityClassLabel)
+	burstPriorityClassPath      = annotationsPath.Key(podconstants.ServingBurstPriorityClassAnnotation)
+
+	errMinReplicasNotSet = errors.New("must be set in scalable serving mode")
+	errMinReplicas       = errors.New("must be a non-negative integer")
+)
+
+// Enabled returns true if the serving object opted in to the scalable serving mode.
+func Enabled(obj client.Object) bool {
+	return BurstPriorityClassName(obj) != ""
+}
+
+// BurstPriorityClassName returns the WorkloadPriorityClass of the pods above the
+// guaranteed minimum replicas.
+func BurstPriorityClassName(obj client.Object) string {
+	return obj.GetAnnotations()[podconstants.ServingBurstPriorityClassAnnotation]
+}
+
+// MinReplicas returns the guaranteed minimum replicas set by the
+// ServingMinReplicasAnnotation of the serving object.
+func MinReplicas(obj client.Object) (int32, error) {
+	value, found := obj.GetAnnotations()[podconstants.ServingMinReplicasAnnotation]
+	if !found {
+		return 0, errMinReplicasNotSet
+	}
+	return parseMinReplicas(value)
+}
+
+func parseMinReplicas(value string) (int32, error) {
+	minReplicas, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || minReplicas < 0 {
+		return 0, errMinReplicas
+	}
+	return int32(minReplicas), nil
+}
+
+// GroupName returns the value of the ServingGroupLabel for the pods of the
+// serving object with the given name.
+func GroupName(name string) string {
+	if len(name) <= validation.LabelValueMaxLength {
+		return name
+	}
+	h := sha1.Sum([]byte(name))
+	return name[:validation.LabelValueMaxLength-hashLength-1] + "-" + hex.EncodeToString(h[:])[:hashLength]
+}
+
+// ApplyToPodTemplate marks the pod template metadata of a serving object in
+// scalable serving mode. The pods are templated in the burst tier, DefaultTier
+// moves them to the guaranteed tier at creation while the minimum replicas
+// aren't reached.
+func ApplyToPodTemplate(obj client.Object, groupName string, minReplicas int32, labels, annotations map[string]string) {
+	labels[podconstants.ServingGroupLabel] = groupName
+	labels[podconstants.ServingTierLabel] = podconstants.ServingTierBurst
+	labels[controllerconstants.WorkloadPriorityClassLabel] = BurstPriorityClassName(obj)
+	annotations[podconstants.ServingMinReplicasAnnotation] = strconv.Itoa(int(minReplicas))
+	annotations[podconstants.ServingBurstPriorityClassAnnotation] = BurstPriorityClassName(obj)
+	annotations[podconstants.ServingGuaranteedPriorityClassAnnotation] = jobframework.WorkloadPriorityClassName(obj)
+}
+
+// Validate checks the priority classes of a serving object in scalable serving
+// mode. The burst WorkloadPriorityClass must have a lower priority than the
+// guaranteed one, for the burst pods to be preempted first.
+func Validate(ctx context.Context, c client.Client, obj client.Object) field.ErrorList {
+	if allErrs := validatePriorityClassNames(obj); len(allErrs) > 0 || !Enabled(obj) {
+		return allErrs
+	}
+	return validatePriorities(ctx, c, obj)
+}
+
+// ValidateUpdate checks the priority classes of an updated serving object in
+// scalable serving mode. The priorities are only compared when the priority
+// classes change, so that the removal of a priority class doesn't block the
+// unrelated updates.
+func ValidateUpdate(ctx context.Context, c client.Client, oldObj, newObj client.Object) field.ErrorList {
+	if allErrs := validatePriorityClassNames(newObj); len(allErrs) > 0 || !Enabled(newObj) {
+		return allErrs
+	}
+	if Enabled(oldObj) &&
+		BurstPriorityClassName(oldObj) == BurstPriorityClassName(newObj) &&
+		jobframework.WorkloadPriorityClassName(oldObj) == jobframework.WorkloadPriorityClassName(newObj) {
+		return nil
+	}
+	return validatePriorities(ctx, c, newObj)
+}
+
+func validatePriorityClassNames(obj client.Object) field.ErrorList {
+	if !Enabled(obj) {
+		return nil
+	}
+	var allErrs field.ErrorList
+	guaranteed := jobframework.WorkloadPriorityClassName(obj)
+	if guaranteed == "" {
+		allErrs = append(allErrs, field.Required(guaranteedPriorityClassPath, "must be set in scalable serving mode"))
+	}
+	if burst := BurstPriorityClassName(obj); burst == guaranteed {
+		allErrs = append(allErrs, field.Invalid(burstPriorityClassPath, burst, "must differ from the guaranteed WorkloadPriorityClass"))
+	}
+	return allErrs
+}
+
+func validatePriorities(ctx context.Context, c client.Client, obj client.Object) field.ErrorList {
+	guaranteed := jobframework.WorkloadPriorityClassName(obj)
+	_, guaranteedPriority, err := priority.GetPriorityFromWorkloadPriorityClass(ctx, c, guaranteed)
+	if err != nil {
+		return field.ErrorList{priorityClassError(guaranteedPriorityClassPath, guaranteed, err)}
+	}
+	burst := BurstPriorityClassName(obj)
+	_, burstPriority, err := priority.GetPriorityFromWorkloadPriorityClass(ctx, c, burst)
+	if err != nil {
+		return field.ErrorList{priorityClassError(burstPriorityClassPath, burst, err)}
+	}
+	if burstPriority >= guaranteedPriority {
+		return field.ErrorList{field.Invalid(burstPriorityClassPath, burst,
+			fmt.Sprintf("must have a lower priority than the guaranteed WorkloadPriorityClass %q (%d)", guaranteed, guaranteedPriority))}
+	}
+	return nil
+}
+
+func priorityClassError(path *field.Path, name string, err error) *field.Error {
+	if apierrors.IsNotFound(err) {
+		return field.NotFound(path, name)
+	}
+	return field.InternalError(path, err)
+}
+
+// ValidateMinReplicas checks the ServingMinReplicasAnnotation of a serving
+// object in scalable serving mode.
+func ValidateMinReplicas(obj client.Object) field.ErrorList {
+	if !Enabled(obj) {
+		return nil
+	}
+	if _, err := MinReplicas(obj); err != nil {
+		return field.ErrorList{field.Invalid(annotationsPath.Key(podconstants.ServingMinReplicasAnnotation), obj.GetAnnotations()[podconstants.ServingMinReplicasAnnotation], err.Error())}
+	}
+	return nil
+}
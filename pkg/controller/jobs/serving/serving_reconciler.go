@@ -0,0 +1,230 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serving
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/util/parallelize"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;update;patch
+
+var (
+	_ jobframework.JobReconcilerInterface = (*Reconciler)(nil)
+)
+
+// Reconciler keeps the tiers of the pods of the serving objects of a framework
+// in scalable serving mode. Each pod is admitted as its own Workload by the pod
+// integration, which follows the WorkloadPriorityClass of its tier.
+type Reconciler struct {
+	client         client.Client
+	controllerName string
+	frameworkName  string
+	roleTracker    *roletracker.RoleTracker
+}
+
+// NewReconcilerFactory returns the factory of the Reconciler for the pods
+// suspended by the given framework.
+func NewReconcilerFactory(controllerName, frameworkName string) jobframework.ReconcilerFactory {
+	return func(_ context.Context, client client.Client, _ client.FieldIndexer, _ record.EventRecorder, opts ...jobframework.Option) (jobframework.JobReconcilerInterface, error) {
+		options := jobframework.ProcessOptions(opts...)
+		return &Reconciler{
+			client:         client,
+			controllerName: controllerName,
+			frameworkName:  frameworkName,
+			roleTracker:    options.RoleTracker,
+		}, nil
+	}
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctrl.Log.V(3).Info("Setting up scalable serving reconciler", "framework", r.frameworkName)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(r.controllerName).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.podToGroup)).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.roleTracker, r.controllerName),
+		}).
+		Complete(r)
+}
+
+func (r *Reconciler) podToGroup(_ context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetAnnotations()[podconstants.SuspendedByParentAnnotation] != r.frameworkName {
+		return nil
+	}
+	groupName, found := obj.GetLabels()[podconstants.ServingGroupLabel]
+	if !found {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: groupName}}}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile serving group")
+
+	podList := &corev1.PodList{}
+	if err := r.client.List(ctx, podList, client.InNamespace(req.Namespace), client.MatchingLabels{
+		podconstants.ServingGroupLabel: req.Name,
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	pods := make([]*corev1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		if pod := &podList.Items[i]; isActiveMember(pod, r.frameworkName) {
+			pods = append(pods, pod)
+		}
+	}
+
+	updates := assignTiers(pods)
+	err := parallelize.Until(ctx, len(updates), func(i int) error {
+		pod, tier := updates[i].pod, updates[i].tier
+		return client.IgnoreNotFound(clientutil.Patch(ctx, r.client, pod, func() (bool, error) {
+			log.V(3).Info("Updating serving tier of pod", "pod", klog.KObj(pod), "tier", tier)
+			setTier(pod, tier)
+			return true, nil
+		}))
+	})
+	return ctrl.Result{}, err
+}
+
+// DefaultTier assigns the tier of a new pod in scalable serving mode. The pod
+// joins the guaranteed tier while its group has fewer guaranteed pods than the
+// minimum replicas, so that the pods within the minimum replicas are never
+// admitted as preemptible. The pods created concurrently may all join the
+// guaranteed tier, the Reconciler moves the newest of them back to the burst
+// tier.
+func DefaultTier(ctx context.Context, c client.Reader, pod *corev1.Pod) error {
+	groupName, found := pod.Labels[podconstants.ServingGroupLabel]
+	if !found {
+		return nil
+	}
+	minReplicas, err := parseMinReplicas(pod.Annotations[podconstants.ServingMinReplicasAnnotation])
+	if err != nil || minReplicas == 0 {
+		return nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(pod.Namespace), client.MatchingLabels{
+		podconstants.ServingGroupLabel: groupName,
+		podconstants.ServingTierLabel:  podconstants.ServingTierGuaranteed,
+	}); err != nil {
+		return err
+	}
+	guaranteed := 0
+	for i := range podList.Items {
+		if isActiveMember(&podList.Items[i], pod.Annotations[podconstants.SuspendedByParentAnnotation]) {
+			guaranteed++
+		}
+	}
+	if guaranteed < int(minReplicas) {
+		setTier(pod, podconstants.ServingTierGuaranteed)
+	}
+	return nil
+}
+
+func isActiveMember(pod *corev1.Pod, frameworkName string) bool {
+	return pod.Annotations[podconstants.SuspendedByParentAnnotation] == frameworkName &&
+		!utilpod.IsTerminated(pod) && pod.DeletionTimestamp == nil
+}
+
+type tierUpdate struct {
+	pod  *corev1.Pod
+	tier string
+}
+
+// assignTiers assigns the guaranteed tier to the first minimum replicas pods,
+// and the burst tier to the others. The pods already in the guaranteed tier
+// keep it, then the oldest pods are preferred. It returns the pods whose tier
+// changes.
+func assignTiers(pods []*corev1.Pod) []tierUpdate {
+	if len(pods) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(pods, func(a, b *corev1.Pod) int {
+		aGuaranteed := a.Labels[podconstants.ServingTierLabel] == podconstants.ServingTierGuaranteed
+		bGuaranteed := b.Labels[podconstants.ServingTierLabel] == podconstants.ServingTierGuaranteed
+		if aGuaranteed != bGuaranteed {
+			if aGuaranteed {
+				return -1
+			}
+			return 1
+		}
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	minReplicas := groupMinReplicas(pods)
+	var updates []tierUpdate
+	for i, pod := range pods {
+		tier := podconstants.ServingTierBurst
+		if i < minReplicas {
+			tier = podconstants.ServingTierGuaranteed
+		}
+		if pod.Labels[podconstants.ServingTierLabel] != tier {
+			updates = append(updates, tierUpdate{pod: pod, tier: tier})
+		}
+	}
+	return updates
+}
+
+// groupMinReplicas returns the minimum replicas set on the newest pod, which
+// follows the latest version of the serving object.
+func groupMinReplicas(pods []*corev1.Pod) int {
+	newest := slices.MaxFunc(pods, func(a, b *corev1.Pod) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+	minReplicas, err := parseMinReplicas(newest.Annotations[podconstants.ServingMinReplicasAnnotation])
+	if err != nil {
+		return 0
+	}
+	return int(minReplicas)
+}
+
+func setTier(pod *corev1.Pod, tier string) {
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string, 2)
+	}
+	pod.Labels[podconstants.ServingTierLabel] = tier
+	if tier == podconstants.ServingTierGuaranteed {
+		pod.Labels[controllerconstants.WorkloadPriorityClassLabel] = pod.Annotations[podconstants.ServingGuaranteedPriorityClassAnnotation]
+	} else {
+		pod.Labels[controllerconstants.WorkloadPriorityClassLabel] = pod.Annotations[podconstants.ServingBurstPriorityClassAnnotation]
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serving
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+)
+
+const frameworkName = "deployment"
+
+var (
+	baseCmpOpts = cmp.Options{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+	}
+)
+
+func servingPod(name string, created time.Time, tier string, minReplicas string) *testingpod.PodWrapper {
+	priorityClass := "burst"
+	if tier == podconstants.ServingTierGuaranteed {
+		priorityClass = "guaranteed"
+	}
+	return testingpod.MakePod(name, "ns").
+		SuspendedByParent(frameworkName).
+		CreationTimestamp(created).
+		Label(podconstants.ServingGroupLabel, "group").
+		Label(podconstants.ServingTierLabel, tier).
+		Label(controllerconstants.WorkloadPriorityClassLabel, priorityClass).
+		Annotation(podconstants.ServingMinReplicasAnnotation, minReplicas).
+		Annotation(podconstants.ServingBurstPriorityClassAnnotation, "burst").
+		Annotation(podconstants.ServingGuaranteedPriorityClassAnnotation, "guaranteed")
+}
+
+func TestReconciler(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		pods     []corev1.Pod
+		wantPods []corev1.Pod
+	}{
+		"promotes the oldest pods up to the min replicas": {
+			pods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierBurst, "2").Obj(),
+				*servingPod("pod2", now, podconstants.ServingTierBurst, "2").Obj(),
+				*servingPod("pod3", now.Add(-2*time.Minute), podconstants.ServingTierBurst, "2").Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierGuaranteed, "2").Obj(),
+				*servingPod("pod2", now, podconstants.ServingTierBurst, "2").Obj(),
+				*servingPod("pod3", now.Add(-2*time.Minute), podconstants.ServingTierGuaranteed, "2").Obj(),
+			},
+		},
+		"guaranteed pods keep their tier": {
+			pods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierBurst, "1").Obj(),
+				*servingPod("pod2", now, podconstants.ServingTierGuaranteed, "1").Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierBurst, "1").Obj(),
+				*servingPod("pod2", now, podconstants.ServingTierGuaranteed, "1").Obj(),
+			},
+		},
+		"promotes a burst pod when a guaranteed pod failed": {
+			pods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierGuaranteed, "1").
+					StatusPhase(corev1.PodFailed).
+					Obj(),
+				*servingPod("pod2", now, podconstants.ServingTierBurst, "1").Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierGuaranteed, "1").
+					StatusPhase(corev1.PodFailed).
+					Obj(),
+				*servingPod("pod2", now, podconstants.ServingTierGuaranteed, "1").Obj(),
+			},
+		},
+		"demotes the guaranteed pods above the min replicas of the newest pod": {
+			pods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierGuaranteed, "2").Obj(),
+				*servingPod("pod2", now.Add(-time.Minute), podconstants.ServingTierGuaranteed, "2").Obj(),
+				*servingPod("pod3", now, podconstants.ServingTierBurst, "1").Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*servingPod("pod1", now.Add(-time.Minute), podconstants.ServingTierGuaranteed, "2").Obj(),
+				*servingPod("pod2", now.Add(-time.Minute), podconstants.ServingTierBurst, "2").Obj(),
+				*servingPod("pod3", now, podconstants.ServingTierBurst, "1").Obj(),
+			},
+		},
+		"ignores the pods of another framework": {
+			pods: []corev1.Pod{
+				*servingPod("pod1", now, podconstants.ServingTierBurst, "1").
+					SuspendedByParent("statefulset").
+					Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*servingPod("pod1", now, podconstants.ServingTierBurst, "1").
+					SuspendedByParent("statefulset").
+					Obj(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder()
+			indexer := utiltesting.AsIndexer(clientBuilder)
+
+			objs := make([]client.Object, 0, len(tc.pods))
+			for i := range tc.pods {
+				objs = append(objs, tc.pods[i].DeepCopy())
+			}
+			kClient := clientBuilder.WithObjects(objs...).Build()
+
+			reconciler, err := NewReconcilerFactory("test_serving", frameworkName)(ctx, kClient, indexer, record.NewFakeRecorder(10))
+			if err != nil {
+				t.Fatalf("Error creating the reconciler: %v", err)
+			}
+
+			if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "group"}}); err != nil {
+				t.Errorf("Reconcile returned error: %v", err)
+			}
+
+			gotPods := &corev1.PodList{}
+			if err := kClient.List(ctx, gotPods); err != nil {
+				t.Fatalf("Could not get PodList after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPods, gotPods.Items, baseCmpOpts...); diff != "" {
+				t.Errorf("Pods after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
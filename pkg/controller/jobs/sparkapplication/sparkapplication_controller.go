@@ -0,0 +1,520 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "sparkoperator.k8s.io", Version: "v1beta2", Kind: "SparkApplication"}
+
+	jvmMemoryRegexp = regexp.MustCompile(`^([0-9]+)([kmgtp]?)b?$`)
+
+	errMaxExecutorsRequired = jobframework.UnretryableError("spec.dynamicAllocation.maxExecutors must be set when the dynamic allocation is enabled")
+)
+
+const (
+	FrameworkName = "sparkoperator.k8s.io/sparkapplication"
+
+	driverPodSetName   kueue.PodSetReference = "driver"
+	executorPodSetName kueue.PodSetReference = "executor"
+
+	// appNameLabel is set by the Spark operator on the driver and executor pods.
+	appNameLabel       = "sparkoperator.k8s.io/app-name"
+	sparkContainerName = "spark-kubernetes"
+)
+
+// The defaults below follow the ones used by Spark.
+const (
+	defaultCores               = 1
+	defaultMemory              = "1g"
+	defaultExecutorInstances   = 1
+	jvmMemoryOverheadFactor    = 0.1
+	nonJVMMemoryOverheadFactor = 0.4
+	minMemoryOverheadBytes     = 384 << 20
+)
+
+const (
+	applicationTypeJava  = "Java"
+	applicationTypeScala = "Scala"
+
+	stateCompleted        = "COMPLETED"
+	stateFailed           = "FAILED"
+	stateSubmissionFailed = "SUBMISSION_FAILED"
+	stateRunning          = "RUNNING"
+	stateSuspended        = "SUSPENDED"
+)
+
+// podRoles are the spec fields describing the pods of the application,
+// in the order of the PodSets.
+var podRoles = []string{"driver", "executor"}
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:      SetupIndexes,
+		NewJob:            newJob,
+		NewReconciler:     NewReconciler,
+		SetupWebhook:      SetupSparkApplicationWebhook,
+		JobType:           newObject(),
+		MultiKueueAdapter: &multiKueueAdapter{},
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=get;list;watch;update;patch;delete
+// +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+
+type sparkApplicationReconciler struct {
+	jr *jobframework.JobReconciler
+}
+
+func newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func newJob() jobframework.GenericJob {
+	return fromObject(newObject())
+}
+
+func NewReconciler(ctx context.Context, client client.Client, indexer client.FieldIndexer, eventRecorder record.EventRecorder, opts ...jobframework.Option) (jobframework.JobReconcilerInterface, error) {
+	return &sparkApplicationReconciler{
+		jr: jobframework.NewReconciler(client, eventRecorder, opts...),
+	}, nil
+}
+
+func (r *sparkApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, newJob())
+}
+
+func (r *sparkApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	controllerName := strings.ToLower(newJob().GVK().Kind)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newJob().Object()).Owns(&kueue.Workload{}).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), controllerName),
+		}).
+		Complete(r)
+}
+
+// SparkApplication wraps the unstructured SparkApplication object.
+type SparkApplication struct {
+	*unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*SparkApplication)(nil)
+var _ jobframework.JobWithManagedBy = (*SparkApplication)(nil)
+
+// sparkApplicationSpec is the subset of the SparkApplication spec used by Kueue.
+type sparkApplicationSpec struct {
+	Type                 string                 `json:"type,omitempty"`
+	MemoryOverheadFactor *string                `json:"memoryOverheadFactor,omitempty"`
+	NodeSelector         map[string]string      `json:"nodeSelector,omitempty"`
+	Driver               sparkPodSpec           `json:"driver"`
+	Executor             sparkPodSpec           `json:"executor"`
+	DynamicAllocation    *dynamicAllocationSpec `json:"dynamicAllocation,omitempty"`
+}
+
+// sparkPodSpec is the subset of the driver and executor specs used by Kueue.
+type sparkPodSpec struct {
+	Instances      *int32              `json:"instances,omitempty"`
+	Cores          *int32              `json:"cores,omitempty"`
+	CoreRequest    *string             `json:"coreRequest,omitempty"`
+	CoreLimit      *string             `json:"coreLimit,omitempty"`
+	Memory         *string             `json:"memory,omitempty"`
+	MemoryOverhead *string             `json:"memoryOverhead,omitempty"`
+	GPU            *gpuSpec            `json:"gpu,omitempty"`
+	Labels         map[string]string   `json:"labels,omitempty"`
+	Annotations    map[string]string   `json:"annotations,omitempty"`
+	NodeSelector   map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations    []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+type gpuSpec struct {
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity"`
+}
+
+type dynamicAllocationSpec struct {
+	Enabled          bool   `json:"enabled,omitempty"`
+	InitialExecutors *int32 `json:"initialExecutors,omitempty"`
+	MaxExecutors     *int32 `json:"maxExecutors,omitempty"`
+}
+
+func fromObject(obj runtime.Object) *SparkApplication {
+	return &SparkApplication{Unstructured: obj.(*unstructured.Unstructured)}
+}
+
+func (j *SparkApplication) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *SparkApplication) spec() (*sparkApplicationSpec, error) {
+	spec := &sparkApplicationSpec{}
+	specMap, _, err := unstructured.NestedMap(j.Unstructured.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (j *SparkApplication) applicationState() string {
+	state, _, _ := unstructured.NestedString(j.Unstructured.Object, "status", "applicationState", "state")
+	return state
+}
+
+func (j *SparkApplication) IsSuspended() bool {
+	suspend, _, _ := unstructured.NestedBool(j.Unstructured.Object, "spec", "suspend")
+	return suspend
+}
+
+func (j *SparkApplication) IsActive() bool {
+	switch j.applicationState() {
+	case "", stateSuspended, stateCompleted, stateFailed, stateSubmissionFailed:
+		return false
+	}
+	return true
+}
+
+func (j *SparkApplication) Suspend() {
+	_ = unstructured.SetNestedField(j.Unstructured.Object, true, "spec", "suspend")
+}
+
+func (j *SparkApplication) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *SparkApplication) PodLabelSelector() string {
+	return fmt.Sprintf("%s=%s", appNameLabel, j.GetName())
+}
+
+func (j *SparkApplication) PodSets(ctx context.Context) ([]kueue.PodSet, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	driverTemplate, err := podTemplate(spec, &spec.Driver)
+	if err != nil {
+		return nil, fmt.Errorf("driver: %w", err)
+	}
+	executorTemplate, err := podTemplate(spec, &spec.Executor)
+	if err != nil {
+		return nil, fmt.Errorf("executor: %w", err)
+	}
+	executors, err := executorCount(spec)
+	if err != nil {
+		return nil, err
+	}
+	return []kueue.PodSet{
+		{
+			Name:     driverPodSetName,
+			Count:    1,
+			Template: *driverTemplate,
+		},
+		{
+			Name:     executorPodSetName,
+			Count:    executors,
+			Template: *executorTemplate,
+		},
+	}, nil
+}
+
+// executorCount returns the number of executors to reserve quota for, which
+// is the maximum number of executors when the dynamic allocation is enabled.
+// An application using the dynamic allocation without a maximum number of
+// executors is rejected, as it could scale beyond its reserved quota.
+func executorCount(spec *sparkApplicationSpec) (int32, error) {
+	if da := spec.DynamicAllocation; da != nil && da.Enabled {
+		if da.MaxExecutors == nil {
+			return 0, errMaxExecutorsRequired
+		}
+		return *da.MaxExecutors, nil
+	}
+	return ptr.Deref(spec.Executor.Instances, defaultExecutorInstances), nil
+}
+
+// podTemplate builds the template of the pods created by the Spark operator
+// for the given driver or executor spec.
+func podTemplate(spec *sparkApplicationSpec, podSpec *sparkPodSpec) (*corev1.PodTemplateSpec, error) {
+	resources, err := podResources(spec, podSpec)
+	if err != nil {
+		return nil, err
+	}
+	// The node selector of the pods is merged into the one of the application.
+	var nodeSelector map[string]string
+	utilmaps.Copy(&nodeSelector, spec.NodeSelector)
+	utilmaps.Copy(&nodeSelector, podSpec.NodeSelector)
+	return &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      podSpec.Labels,
+			Annotations: podSpec.Annotations,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:      sparkContainerName,
+					Resources: *resources,
+				},
+			},
+			NodeSelector: nodeSelector,
+			Tolerations:  podSpec.Tolerations,
+		},
+	}, nil
+}
+
+func podResources(spec *sparkApplicationSpec, podSpec *sparkPodSpec) (*corev1.ResourceRequirements, error) {
+	cpu := *resource.NewQuantity(int64(ptr.Deref(podSpec.Cores, defaultCores)), resource.DecimalSI)
+	if podSpec.CoreRequest != nil {
+		q, err := resource.ParseQuantity(*podSpec.CoreRequest)
+		if err != nil {
+			return nil, fmt.Errorf("coreRequest: %w", err)
+		}
+		cpu = q
+	}
+
+	memory, err := parseJVMMemory(ptr.Deref(podSpec.Memory, defaultMemory))
+	if err != nil {
+		return nil, fmt.Errorf("memory: %w", err)
+	}
+	overhead, err := memoryOverhead(spec, podSpec, memory)
+	if err != nil {
+		return nil, fmt.Errorf("memoryOverhead: %w", err)
+	}
+	memoryQuantity := *resource.NewQuantity(memory+overhead, resource.BinarySI)
+
+	resources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    cpu,
+			corev1.ResourceMemory: memoryQuantity,
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: memoryQuantity,
+		},
+	}
+	if podSpec.CoreLimit != nil {
+		q, err := resource.ParseQuantity(*podSpec.CoreLimit)
+		if err != nil {
+			return nil, fmt.Errorf("coreLimit: %w", err)
+		}
+		resources.Limits[corev1.ResourceCPU] = q
+	}
+	if gpu := podSpec.GPU; gpu != nil && gpu.Name != "" {
+		q := *resource.NewQuantity(gpu.Quantity, resource.DecimalSI)
+		resources.Requests[corev1.ResourceName(gpu.Name)] = q
+		resources.Limits[corev1.ResourceName(gpu.Name)] = q
+	}
+	return resources, nil
+}
+
+// memoryOverhead returns the non-heap memory added by Spark to the memory
+// of the driver or executor pods.
+func memoryOverhead(spec *sparkApplicationSpec, podSpec *sparkPodSpec, memory int64) (int64, error) {
+	if podSpec.MemoryOverhead != nil {
+		return parseJVMMemory(*podSpec.MemoryOverhead)
+	}
+	factor := nonJVMMemoryOverheadFactor
+	if spec.Type == applicationTypeJava || spec.Type == applicationTypeScala {
+		factor = jvmMemoryOverheadFactor
+	}
+	if spec.MemoryOverheadFactor != nil {
+		f, err := strconv.ParseFloat(*spec.MemoryOverheadFactor, 64)
+		if err != nil {
+			return 0, err
+		}
+		factor = f
+	}
+	return max(int64(math.Ceil(float64(memory)*factor)), minMemoryOverheadBytes), nil
+}
+
+// parseJVMMemory parses a JVM memory string, like 512m or 2g, into bytes.
+// Values without a unit are in mebibytes.
+func parseJVMMemory(s string) (int64, error) {
+	s = strings.ToLower(s)
+	matches := jvmMemoryRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid memory quantity %q", s)
+	}
+	value, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	shift := map[string]uint{"": 20, "k": 10, "m": 20, "g": 30, "t": 40, "p": 50}[matches[2]]
+	if strings.HasSuffix(s, "b") && matches[2] == "" {
+		shift = 0
+	}
+	return value << shift, nil
+}
+
+func (j *SparkApplication) RunWithPodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != len(podRoles) {
+		return podset.BadPodSetsInfoLenError(len(podRoles), len(podSetsInfo))
+	}
+
+	if err := unstructured.SetNestedField(j.Unstructured.Object, false, "spec", "suspend"); err != nil {
+		return err
+	}
+
+	for i, role := range podRoles {
+		meta, podSpec, err := j.podMeta(role)
+		if err != nil {
+			return err
+		}
+		if err := podset.Merge(meta, podSpec, podSetsInfo[i]); err != nil {
+			return err
+		}
+		if err := j.setPodMeta(role, meta, podSpec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *SparkApplication) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != len(podRoles) {
+		return false
+	}
+
+	changed := false
+	for i, role := range podRoles {
+		meta, podSpec, err := j.podMeta(role)
+		if err != nil {
+			continue
+		}
+		if podset.RestorePodSpec(meta, podSpec, podSetsInfo[i]) {
+			changed = j.setPodMeta(role, meta, podSpec) == nil || changed
+		}
+	}
+	return changed
+}
+
+// podMeta returns the pod metadata and the scheduling fields of the
+// driver or executor spec.
+func (j *SparkApplication) podMeta(role string) (*metav1.ObjectMeta, *corev1.PodSpec, error) {
+	roleMap, _, err := unstructured.NestedMap(j.Unstructured.Object, "spec", role)
+	if err != nil {
+		return nil, nil, err
+	}
+	podSpec := &sparkPodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(roleMap, podSpec); err != nil {
+		return nil, nil, err
+	}
+	meta := &metav1.ObjectMeta{
+		Labels:      podSpec.Labels,
+		Annotations: podSpec.Annotations,
+	}
+	spec := &corev1.PodSpec{
+		NodeSelector: podSpec.NodeSelector,
+		Tolerations:  podSpec.Tolerations,
+	}
+	return meta, spec, nil
+}
+
+// setPodMeta sets the pod metadata and the scheduling fields of the
+// driver or executor spec.
+func (j *SparkApplication) setPodMeta(role string, meta *metav1.ObjectMeta, podSpec *corev1.PodSpec) error {
+	obj := j.Unstructured.Object
+	for field, value := range map[string]map[string]string{
+		"labels":       meta.Labels,
+		"annotations":  meta.Annotations,
+		"nodeSelector": podSpec.NodeSelector,
+	} {
+		if err := jobframework.SetNestedStringMap(obj, value, "spec", role, field); err != nil {
+			return err
+		}
+	}
+	return jobframework.SetNestedSlice(obj, podSpec.Tolerations, "spec", role, "tolerations")
+}
+
+func (j *SparkApplication) Finished(ctx context.Context) (message string, success, finished bool) {
+	message, _, _ = unstructured.NestedString(j.Unstructured.Object, "status", "applicationState", "errorMessage")
+	switch j.applicationState() {
+	case stateCompleted:
+		return message, true, true
+	case stateFailed, stateSubmissionFailed:
+		return message, false, true
+	}
+	return message, false, false
+}
+
+func (j *SparkApplication) PodsReady(ctx context.Context) bool {
+	return j.applicationState() == stateRunning
+}
+
+func (j *SparkApplication) CanDefaultManagedBy() bool {
+	return features.Enabled(features.MultiKueue) && j.ManagedBy() == nil
+}
+
+func (j *SparkApplication) ManagedBy() *string {
+	managedBy, found, _ := unstructured.NestedString(j.Unstructured.Object, "spec", "managedBy")
+	if !found {
+		return nil
+	}
+	return &managedBy
+}
+
+func (j *SparkApplication) SetManagedBy(managedBy *string) {
+	if managedBy == nil {
+		unstructured.RemoveNestedField(j.Unstructured.Object, "spec", "managedBy")
+		return
+	}
+	_ = unstructured.SetNestedField(j.Unstructured.Object, *managedBy, "spec", "managedBy")
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+func GetWorkloadNameForSparkApplication(name string, uid types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(name, uid, gvk)
+}
@@ -0,0 +1,399 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingsparkapp "sigs.k8s.io/kueue/pkg/util/testingjobs/sparkapplication"
+)
+
+func sparkPodTemplate(cpu, memory string, nodeSelector map[string]string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: sparkContainerName,
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse(memory),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse(memory),
+						},
+					},
+				},
+			},
+			NodeSelector: nodeSelector,
+		},
+	}
+}
+
+func TestPodSets(t *testing.T) {
+	testCases := map[string]struct {
+		app         *unstructured.Unstructured
+		wantPodSets func() []kueue.PodSet
+		wantErr     bool
+	}{
+		"driver and executors": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				ExecutorInstances(3).
+				Obj(),
+			wantPodSets: func() []kueue.PodSet {
+				return []kueue.PodSet{
+					{
+						Name:     driverPodSetName,
+						Count:    1,
+						Template: sparkPodTemplate("1", "896Mi", nil),
+					},
+					{
+						Name:     executorPodSetName,
+						Count:    3,
+						Template: sparkPodTemplate("1", "896Mi", nil),
+					},
+				}
+			},
+		},
+		"core request and memory overhead": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				DriverCoreRequest("500m").
+				DriverMemory("2g").
+				DriverMemoryOverhead("1g").
+				ExecutorCores(2).
+				ExecutorMemory("8g").
+				Obj(),
+			wantPodSets: func() []kueue.PodSet {
+				return []kueue.PodSet{
+					{
+						Name:     driverPodSetName,
+						Count:    1,
+						Template: sparkPodTemplate("500m", "3Gi", nil),
+					},
+					{
+						Name:     executorPodSetName,
+						Count:    1,
+						Template: sparkPodTemplate("2", "9448928052", nil),
+					},
+				}
+			},
+		},
+		"python application": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				Type("Python").
+				ExecutorMemory("2g").
+				Obj(),
+			wantPodSets: func() []kueue.PodSet {
+				return []kueue.PodSet{
+					{
+						Name:     driverPodSetName,
+						Count:    1,
+						Template: sparkPodTemplate("1", "896Mi", nil),
+					},
+					{
+						Name:     executorPodSetName,
+						Count:    1,
+						Template: sparkPodTemplate("1", "3006477108", nil),
+					},
+				}
+			},
+		},
+		"gpu, node selectors and tolerations": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				DriverNodeSelector("pool", "cpu").
+				DriverLabel("team", "data").
+				ExecutorGPU("nvidia.com/gpu", 1).
+				ExecutorNodeSelector("pool", "gpu").
+				ExecutorToleration(corev1.Toleration{
+					Key:      "gpu",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "true",
+					Effect:   corev1.TaintEffectNoSchedule,
+				}).
+				Obj(),
+			wantPodSets: func() []kueue.PodSet {
+				driverTemplate := sparkPodTemplate("1", "896Mi", map[string]string{"pool": "cpu"})
+				driverTemplate.Labels = map[string]string{"team": "data"}
+				executorTemplate := sparkPodTemplate("1", "896Mi", map[string]string{"pool": "gpu"})
+				executorTemplate.Spec.Containers[0].Resources.Requests["nvidia.com/gpu"] = resource.MustParse("1")
+				executorTemplate.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"] = resource.MustParse("1")
+				executorTemplate.Spec.Tolerations = []corev1.Toleration{{
+					Key:      "gpu",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "true",
+					Effect:   corev1.TaintEffectNoSchedule,
+				}}
+				return []kueue.PodSet{
+					{
+						Name:     driverPodSetName,
+						Count:    1,
+						Template: driverTemplate,
+					},
+					{
+						Name:     executorPodSetName,
+						Count:    1,
+						Template: executorTemplate,
+					},
+				}
+			},
+		},
+		"dynamic allocation reserves the max executors": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				DynamicAllocation(ptr.To[int64](2), ptr.To[int64](5)).
+				Obj(),
+			wantPodSets: func() []kueue.PodSet {
+				return []kueue.PodSet{
+					{
+						Name:     driverPodSetName,
+						Count:    1,
+						Template: sparkPodTemplate("1", "896Mi", nil),
+					},
+					{
+						Name:     executorPodSetName,
+						Count:    5,
+						Template: sparkPodTemplate("1", "896Mi", nil),
+					},
+				}
+			},
+		},
+		"dynamic allocation without max executors is rejected": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				DynamicAllocation(ptr.To[int64](2), nil).
+				Obj(),
+			wantErr: true,
+		},
+		"invalid memory": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				ExecutorMemory("2Gi").
+				Obj(),
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			gotPodSets, err := fromObject(tc.app).PodSets(ctx)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantPodSets(), gotPodSets); diff != "" {
+				t.Errorf("pod sets mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseJVMMemory(t *testing.T) {
+	testCases := map[string]struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		"bytes":            {value: "100b", want: 100},
+		"kibibytes":        {value: "2k", want: 2 << 10},
+		"mebibytes":        {value: "512m", want: 512 << 20},
+		"gibibytes suffix": {value: "2gb", want: 2 << 30},
+		"upper case":       {value: "2G", want: 2 << 30},
+		"no unit":          {value: "512", want: 512 << 20},
+		"kubernetes unit":  {value: "2Gi", wantErr: true},
+		"decimal":          {value: "1.5g", wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseJVMMemory(tc.value)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("Unexpected value, want: %d, got: %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfo(t *testing.T) {
+	baseApp := testingsparkapp.MakeSparkApplication("app", "ns").
+		ExecutorNodeSelector("pool", "spark")
+
+	cases := map[string]struct {
+		app          *unstructured.Unstructured
+		runInfo      []podset.PodSetInfo
+		restoreInfo  []podset.PodSetInfo
+		wantRunError error
+		wantAfterRun *unstructured.Unstructured
+		wantFinal    *unstructured.Unstructured
+	}{
+		"valid configuration": {
+			app: baseApp.Clone().Obj(),
+			runInfo: []podset.PodSetInfo{
+				{
+					NodeSelector: map[string]string{"flavor": "on-demand"},
+				},
+				{
+					Labels:       map[string]string{"kueue.x-k8s.io/podset": "executor"},
+					NodeSelector: map[string]string{"flavor": "spot"},
+					Tolerations: []corev1.Toleration{{
+						Key:      "spot",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					}},
+				},
+			},
+			restoreInfo: []podset.PodSetInfo{
+				{},
+				{
+					NodeSelector: map[string]string{"pool": "spark"},
+				},
+			},
+			wantAfterRun: baseApp.Clone().
+				Suspend(false).
+				DriverNodeSelector("flavor", "on-demand").
+				ExecutorNodeSelector("flavor", "spot").
+				ExecutorLabel("kueue.x-k8s.io/podset", "executor").
+				ExecutorToleration(corev1.Toleration{
+					Key:      "spot",
+					Operator: corev1.TolerationOpExists,
+					Effect:   corev1.TaintEffectNoSchedule,
+				}).
+				Obj(),
+			wantFinal: baseApp.Clone().Obj(),
+		},
+		"conflicting node selector": {
+			app: baseApp.Clone().Obj(),
+			runInfo: []podset.PodSetInfo{
+				{},
+				{
+					NodeSelector: map[string]string{"pool": "other"},
+				},
+			},
+			wantRunError: podset.ErrInvalidPodSetUpdate,
+		},
+		"invalid runInfo": {
+			app: baseApp.Clone().Obj(),
+			runInfo: []podset.PodSetInfo{
+				{},
+			},
+			wantRunError: podset.ErrInvalidPodsetInfo,
+			wantAfterRun: baseApp.Clone().Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			app := fromObject(tc.app)
+			gotRunError := app.RunWithPodSetsInfo(ctx, tc.runInfo)
+
+			if diff := cmp.Diff(tc.wantRunError, gotRunError, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected run error (-want/+got): %s", diff)
+			}
+			if tc.wantAfterRun != nil {
+				if diff := cmp.Diff(tc.wantAfterRun, tc.app); diff != "" {
+					t.Errorf("Unexpected application after run (-want/+got): %s", diff)
+				}
+			}
+
+			if tc.wantRunError == nil {
+				app.Suspend()
+				if !app.RestorePodSetsInfo(tc.restoreInfo) {
+					t.Error("Expected the application to be changed on restore")
+				}
+				if diff := cmp.Diff(tc.wantFinal, tc.app); diff != "" {
+					t.Errorf("Unexpected application after restore (-want/+got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFinished(t *testing.T) {
+	testCases := map[string]struct {
+		state        string
+		wantSuccess  bool
+		wantFinished bool
+		wantActive   bool
+	}{
+		"not submitted": {},
+		"running": {
+			state:      stateRunning,
+			wantActive: true,
+		},
+		"completed": {
+			state:        stateCompleted,
+			wantSuccess:  true,
+			wantFinished: true,
+		},
+		"failed": {
+			state:        stateFailed,
+			wantFinished: true,
+		},
+		"submission failed": {
+			state:        stateSubmissionFailed,
+			wantFinished: true,
+		},
+		"suspended": {
+			state: stateSuspended,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			app := fromObject(testingsparkapp.MakeSparkApplication("app", "ns").
+				ApplicationState(tc.state).
+				ErrorMessage("driver pod failed").
+				Obj())
+			message, success, finished := app.Finished(ctx)
+			if success != tc.wantSuccess || finished != tc.wantFinished {
+				t.Errorf("Unexpected result, want success: %v, finished: %v, got success: %v, finished: %v", tc.wantSuccess, tc.wantFinished, success, finished)
+			}
+			if message != "driver pod failed" {
+				t.Errorf("Unexpected message: %q", message)
+			}
+			if active := app.IsActive(); active != tc.wantActive {
+				t.Errorf("Unexpected active, want: %v, got: %v", tc.wantActive, active)
+			}
+		})
+	}
+}
+
+func TestManagedBy(t *testing.T) {
+	app := fromObject(testingsparkapp.MakeSparkApplication("app", "ns").Obj())
+	if app.ManagedBy() != nil {
+		t.Errorf("Unexpected managedBy: %q", *app.ManagedBy())
+	}
+	app.SetManagedBy(ptr.To(kueue.MultiKueueControllerName))
+	if diff := cmp.Diff(ptr.To(kueue.MultiKueueControllerName), app.ManagedBy()); diff != "" {
+		t.Errorf("Unexpected managedBy (-want/+got): %s", diff)
+	}
+	app.SetManagedBy(nil)
+	if _, found, _ := unstructured.NestedFieldNoCopy(app.Unstructured.Object, "spec", "managedBy"); found {
+		t.Error("Expected spec.managedBy to be removed")
+	}
+}
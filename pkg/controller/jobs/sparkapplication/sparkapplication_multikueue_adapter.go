@@ -0,0 +1,137 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+)
+
+type multiKueueAdapter struct{}
+
+var _ jobframework.MultiKueueAdapter = (*multiKueueAdapter)(nil)
+
+func (b *multiKueueAdapter) SyncJob(ctx context.Context, localClient client.Client, remoteClient client.Client, key types.NamespacedName, workloadName, origin string) error {
+	localApp := fromObject(newObject())
+	err := localClient.Get(ctx, key, localApp.Object())
+	if err != nil {
+		return err
+	}
+
+	remoteApp := fromObject(newObject())
+	err = remoteClient.Get(ctx, key, remoteApp.Object())
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	// if the remote exists, just copy the status
+	if err == nil {
+		return clientutil.PatchStatus(ctx, localClient, localApp.Object(), func() (bool, error) {
+			status, found, err := unstructured.NestedMap(remoteApp.Unstructured.Object, "status")
+			if err != nil || !found {
+				return false, err
+			}
+			localApp.Unstructured.Object["status"] = status
+			return true, nil
+		})
+	}
+
+	remoteApp = fromObject(newObject())
+	remoteApp.SetName(localApp.GetName())
+	remoteApp.SetNamespace(localApp.GetNamespace())
+	remoteApp.SetAnnotations(maps.Clone(localApp.GetAnnotations()))
+	spec, _, err := unstructured.NestedMap(localApp.Unstructured.Object, "spec")
+	if err != nil {
+		return err
+	}
+	remoteApp.Unstructured.Object["spec"] = spec
+
+	// add the prebuilt workload
+	labels := maps.Clone(localApp.GetLabels())
+	if labels == nil {
+		labels = make(map[string]string, 2)
+	}
+	labels[constants.PrebuiltWorkloadLabel] = workloadName
+	labels[kueue.MultiKueueOriginLabel] = origin
+	remoteApp.SetLabels(labels)
+
+	// clear the managedBy enables the controller to take over
+	remoteApp.SetManagedBy(nil)
+
+	return remoteClient.Create(ctx, remoteApp.Object())
+}
+
+func (b *multiKueueAdapter) DeleteRemoteObject(ctx context.Context, remoteClient client.Client, key types.NamespacedName) error {
+	app := newObject()
+	app.SetName(key.Name)
+	app.SetNamespace(key.Namespace)
+	return client.IgnoreNotFound(remoteClient.Delete(ctx, app))
+}
+
+func (b *multiKueueAdapter) IsJobManagedByKueue(ctx context.Context, c client.Client, key types.NamespacedName) (bool, string, error) {
+	app := fromObject(newObject())
+	err := c.Get(ctx, key, app.Object())
+	if err != nil {
+		return false, "", err
+	}
+	appControllerName := ptr.Deref(app.ManagedBy(), "")
+	if appControllerName != kueue.MultiKueueControllerName {
+		return false, fmt.Sprintf("Expecting spec.managedBy to be %q not %q", kueue.MultiKueueControllerName, appControllerName), nil
+	}
+	return true, "", nil
+}
+
+func (b *multiKueueAdapter) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+var _ jobframework.MultiKueueWatcher = (*multiKueueAdapter)(nil)
+
+func (*multiKueueAdapter) GetEmptyList() client.ObjectList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	return list
+}
+
+func (*multiKueueAdapter) WorkloadKeysFor(o runtime.Object) ([]types.NamespacedName, error) {
+	app, isApp := o.(*unstructured.Unstructured)
+	if !isApp || app.GroupVersionKind() != gvk {
+		return nil, errors.New("not a sparkapplication")
+	}
+
+	prebuiltWl, hasPrebuiltWorkload := app.GetLabels()[constants.PrebuiltWorkloadLabel]
+	if !hasPrebuiltWorkload {
+		return nil, fmt.Errorf("no prebuilt workload found for sparkapplication: %s", klog.KObj(app))
+	}
+
+	return []types.NamespacedName{{Name: prebuiltWl, Namespace: app.GetNamespace()}}, nil
+}
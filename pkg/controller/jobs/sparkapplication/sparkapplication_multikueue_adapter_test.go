@@ -0,0 +1,212 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/slices"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingsparkapp "sigs.k8s.io/kueue/pkg/util/testingjobs/sparkapplication"
+)
+
+const (
+	TestNamespace = "ns"
+)
+
+func TestMultiKueueAdapter(t *testing.T) {
+	objCheckOpts := cmp.Options{
+		cmpopts.EquateEmpty(),
+		cmp.Transformer("IgnoreResourceVersion", func(u unstructured.Unstructured) map[string]any {
+			obj := u.DeepCopy()
+			obj.SetResourceVersion("")
+			return obj.Object
+		}),
+	}
+
+	appBuilder := testingsparkapp.MakeSparkApplication("app1", TestNamespace).Suspend(false)
+
+	cases := map[string]struct {
+		managersApps []unstructured.Unstructured
+		workerApps   []unstructured.Unstructured
+
+		operation func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error
+
+		wantError        error
+		wantManagersApps []unstructured.Unstructured
+		wantWorkerApps   []unstructured.Unstructured
+	}{
+		"sync creates missing remote application": {
+			managersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ManagedBy(kueue.MultiKueueControllerName).
+					Obj(),
+			},
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.SyncJob(ctx, managerClient, workerClient, types.NamespacedName{Name: "app1", Namespace: TestNamespace}, "wl1", "origin1")
+			},
+
+			wantManagersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ManagedBy(kueue.MultiKueueControllerName).
+					Obj(),
+			},
+			wantWorkerApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+		},
+		"sync status from remote application": {
+			managersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().Obj(),
+			},
+			workerApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					ApplicationState(stateCompleted).
+					Obj(),
+			},
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.SyncJob(ctx, managerClient, workerClient, types.NamespacedName{Name: "app1", Namespace: TestNamespace}, "wl1", "origin1")
+			},
+
+			wantManagersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ApplicationState(stateCompleted).
+					Obj(),
+			},
+			wantWorkerApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					ApplicationState(stateCompleted).
+					Obj(),
+			},
+		},
+		"remote application is deleted": {
+			workerApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.DeleteRemoteObject(ctx, workerClient, types.NamespacedName{Name: "app1", Namespace: TestNamespace})
+			},
+		},
+		"application with wrong managedBy is not considered managed": {
+			managersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ManagedBy("some-other-controller").
+					Obj(),
+			},
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				if isManged, _, _ := adapter.IsJobManagedByKueue(ctx, managerClient, types.NamespacedName{Name: "app1", Namespace: TestNamespace}); isManged {
+					return errors.New("expecting false")
+				}
+				return nil
+			},
+			wantManagersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ManagedBy("some-other-controller").
+					Obj(),
+			},
+		},
+		"application managedBy multikueue": {
+			managersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ManagedBy(kueue.MultiKueueControllerName).
+					Obj(),
+			},
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				if isManged, _, _ := adapter.IsJobManagedByKueue(ctx, managerClient, types.NamespacedName{Name: "app1", Namespace: TestNamespace}); !isManged {
+					return errors.New("expecting true")
+				}
+				return nil
+			},
+			wantManagersApps: []unstructured.Unstructured{
+				*appBuilder.Clone().
+					ManagedBy(kueue.MultiKueueControllerName).
+					Obj(),
+			},
+		},
+		"missing application is not considered managed": {
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				if isManged, _, _ := adapter.IsJobManagedByKueue(ctx, managerClient, types.NamespacedName{Name: "app1", Namespace: TestNamespace}); isManged {
+					return errors.New("expecting false")
+				}
+				return nil
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			toObjects := func(apps []unstructured.Unstructured) []client.Object {
+				return slices.Map(apps, func(app *unstructured.Unstructured) client.Object { return app })
+			}
+			managerObjects := toObjects(tc.managersApps)
+			managerClient := utiltesting.NewClientBuilder().
+				WithObjects(managerObjects...).
+				WithStatusSubresource(managerObjects...).
+				Build()
+			workerClient := utiltesting.NewClientBuilder().
+				WithObjects(toObjects(tc.workerApps)...).
+				Build()
+
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			adapter := &multiKueueAdapter{}
+
+			gotErr := tc.operation(ctx, adapter, managerClient, workerClient)
+
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("unexpected error (-want/+got):\n%s", diff)
+			}
+
+			gotManagersApps := adapter.GetEmptyList().(*unstructured.UnstructuredList)
+			if err := managerClient.List(ctx, gotManagersApps); err != nil {
+				t.Errorf("unexpected list manager's applications error %s", err)
+			} else {
+				if diff := cmp.Diff(tc.wantManagersApps, gotManagersApps.Items, objCheckOpts...); diff != "" {
+					t.Errorf("unexpected manager's applications (-want/+got):\n%s", diff)
+				}
+			}
+
+			gotWorkerApps := adapter.GetEmptyList().(*unstructured.UnstructuredList)
+			if err := workerClient.List(ctx, gotWorkerApps); err != nil {
+				t.Errorf("unexpected list worker's applications error %s", err)
+			} else {
+				if diff := cmp.Diff(tc.wantWorkerApps, gotWorkerApps.Items, objCheckOpts...); diff != "" {
+					t.Errorf("unexpected worker's applications (-want/+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/webhook"
+)
+
+var (
+	specPath              = field.NewPath("spec")
+	dynamicAllocationPath = specPath.Child("dynamicAllocation")
+)
+
+type SparkApplicationWebhook struct {
+	client                       client.Client
+	queues                       *qcache.Manager
+	manageJobsWithoutQueueName   bool
+	managedJobsNamespaceSelector labels.Selector
+	cache                        *schdcache.Cache
+}
+
+// SetupSparkApplicationWebhook configures the webhook for SparkApplication.
+func SetupSparkApplicationWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &SparkApplicationWebhook{
+		client:                       mgr.GetClient(),
+		queues:                       options.Queues,
+		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+		cache:                        options.Cache,
+	}
+	obj := newObject()
+	jobframework.RegisterUnstructuredType(mgr.GetScheme(), gvk)
+	if options.NoopWebhook {
+		return webhook.SetupNoopWebhook(mgr, obj)
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		WithLogConstructor(jobframework.WebhookLogConstructor(fromObject(obj).GVK(), options.RoleTracker)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-sparkoperator-k8s-io-v1beta2-sparkapplication,mutating=true,failurePolicy=fail,sideEffects=None,groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=create,versions=v1beta2,name=msparkapplication.kb.io,admissionReviewVersions=v1
+
+var _ admission.Defaulter[*unstructured.Unstructured] = &SparkApplicationWebhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *SparkApplicationWebhook) Default(ctx context.Context, obj *unstructured.Unstructured) error {
+	job := fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("sparkapplication-webhook")
+	log.V(5).Info("Applying defaults")
+	jobframework.ApplyDefaultLocalQueue(job.Object(), w.queues.DefaultLocalQueueExist)
+	if err := jobframework.ApplyDefaultForSuspend(ctx, job, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector); err != nil {
+		return err
+	}
+	jobframework.ApplyDefaultForManagedBy(job, w.queues, w.cache, log)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-sparkoperator-k8s-io-v1beta2-sparkapplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=create;update,versions=v1beta2,name=vsparkapplication.kb.io,admissionReviewVersions=v1
+
+var _ admission.Validator[*unstructured.Unstructured] = &SparkApplicationWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *SparkApplicationWebhook) ValidateCreate(ctx context.Context, obj *unstructured.Unstructured) (admission.Warnings, error) {
+	log := ctrl.LoggerFrom(ctx).WithName("sparkapplication-webhook")
+	log.Info("Validating create")
+	return nil, w.validateCreate(fromObject(obj)).ToAggregate()
+}
+
+func (w *SparkApplicationWebhook) validateCreate(job *SparkApplication) field.ErrorList {
+	var allErrors field.ErrorList
+	if w.manageJobsWithoutQueueName || jobframework.QueueName(job) != "" {
+		allErrors = append(allErrors, validateSpec(job)...)
+	}
+	allErrors = append(allErrors, jobframework.ValidateJobOnCreate(job)...)
+	return allErrors
+}
+
+// validateSpec checks that the resources of the application can be computed.
+func validateSpec(job *SparkApplication) field.ErrorList {
+	spec, err := job.spec()
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath, nil, err.Error())}
+	}
+
+	var allErrors field.ErrorList
+	if spec.MemoryOverheadFactor != nil {
+		if _, err := strconv.ParseFloat(*spec.MemoryOverheadFactor, 64); err != nil {
+			allErrors = append(allErrors, field.Invalid(specPath.Child("memoryOverheadFactor"), *spec.MemoryOverheadFactor, "must be a number"))
+		}
+	}
+	for i, podSpec := range []*sparkPodSpec{&spec.Driver, &spec.Executor} {
+		rolePath := specPath.Child(podRoles[i])
+		allErrors = append(allErrors, validateQuantity(rolePath.Child("coreRequest"), podSpec.CoreRequest)...)
+		allErrors = append(allErrors, validateQuantity(rolePath.Child("coreLimit"), podSpec.CoreLimit)...)
+		allErrors = append(allErrors, validateJVMMemory(rolePath.Child("memory"), podSpec.Memory)...)
+		allErrors = append(allErrors, validateJVMMemory(rolePath.Child("memoryOverhead"), podSpec.MemoryOverhead)...)
+	}
+	// The quota is reserved for the maximum number of executors.
+	if da := spec.DynamicAllocation; da != nil && da.Enabled && da.MaxExecutors == nil {
+		allErrors = append(allErrors, field.Required(dynamicAllocationPath.Child("maxExecutors"), "must be set for a kueue managed application using the dynamic allocation"))
+	}
+	return allErrors
+}
+
+func validateQuantity(path *field.Path, value *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := resource.ParseQuantity(*value); err != nil {
+		return field.ErrorList{field.Invalid(path, *value, err.Error())}
+	}
+	return nil
+}
+
+func validateJVMMemory(path *field.Path, value *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := parseJVMMemory(*value); err != nil {
+		return field.ErrorList{field.Invalid(path, *value, "must be a JVM memory string, like 512m or 2g")}
+	}
+	return nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *SparkApplicationWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj *unstructured.Unstructured) (admission.Warnings, error) {
+	oldJob := fromObject(oldObj)
+	newJob := fromObject(newObj)
+	log := ctrl.LoggerFrom(ctx).WithName("sparkapplication-webhook")
+	if w.manageJobsWithoutQueueName || jobframework.QueueName(newJob) != "" {
+		log.Info("Validating update")
+		allErrors := jobframework.ValidateJobOnUpdate(oldJob, newJob, w.queues.DefaultLocalQueueExist)
+		allErrors = append(allErrors, w.validateCreate(newJob)...)
+		return nil, allErrors.ToAggregate()
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *SparkApplicationWebhook) ValidateDelete(_ context.Context, _ *unstructured.Unstructured) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingsparkapp "sigs.k8s.io/kueue/pkg/util/testingjobs/sparkapplication"
+)
+
+func TestDefault(t *testing.T) {
+	testcases := map[string]struct {
+		oldApp         *unstructured.Unstructured
+		newApp         *unstructured.Unstructured
+		manageAll      bool
+		defaultLqExist bool
+	}{
+		"unmanaged": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "ns").
+				Suspend(false).
+				Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "ns").
+				Suspend(false).
+				Obj(),
+		},
+		"managed - by config": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "ns").
+				Suspend(false).
+				Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "ns").
+				Suspend(true).
+				Obj(),
+			manageAll: true,
+		},
+		"managed - by queue": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Suspend(false).
+				Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Suspend(true).
+				Obj(),
+		},
+		"default lq is created, application doesn't have queue label": {
+			defaultLqExist: true,
+			oldApp:         testingsparkapp.MakeSparkApplication("app", "default").Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "default").
+				Queue("default").
+				Obj(),
+		},
+		"default lq isn't created, application doesn't have queue label": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "").Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "").Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().Build()
+			cqCache := schdcache.New(cli)
+			queueManager := qcache.NewManagerForUnitTests(cli, cqCache)
+			if tc.defaultLqExist {
+				if err := queueManager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue("default", "default").
+					ClusterQueue("cluster-queue").Obj()); err != nil {
+					t.Fatalf("failed to create default local queue: %v", err)
+				}
+			}
+			wh := &SparkApplicationWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+				queues:                     queueManager,
+				cache:                      cqCache,
+			}
+			result := tc.oldApp.DeepCopy()
+			if err := wh.Default(ctx, result); err != nil {
+				t.Errorf("unexpected Default() error: %v", err)
+			}
+			if diff := cmp.Diff(tc.newApp, result); diff != "" {
+				t.Errorf("Default() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	testcases := map[string]struct {
+		app       *unstructured.Unstructured
+		manageAll bool
+		wantErr   error
+	}{
+		"valid": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Obj(),
+		},
+		"invalid unmanaged": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				ExecutorMemory("2Gi").
+				Obj(),
+		},
+		"invalid managed - memory": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				DriverMemoryOverhead("1.5g").
+				ExecutorMemory("2Gi").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "driver", "memoryOverhead"), "1.5g", "must be a JVM memory string, like 512m or 2g"),
+				field.Invalid(field.NewPath("spec", "executor", "memory"), "2Gi", "must be a JVM memory string, like 512m or 2g"),
+			}.ToAggregate(),
+		},
+		"invalid managed - core request": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				DriverCoreRequest("one").
+				Obj(),
+			manageAll: true,
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "driver", "coreRequest"), "one", "quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"),
+			}.ToAggregate(),
+		},
+		"invalid managed - dynamic allocation without max executors": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				DynamicAllocation(ptr.To[int64](2), nil).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Required(field.NewPath("spec", "dynamicAllocation", "maxExecutors"), "must be set for a kueue managed application using the dynamic allocation"),
+			}.ToAggregate(),
+		},
+		"valid managed - dynamic allocation with max executors": {
+			app: testingsparkapp.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				DynamicAllocation(nil, ptr.To[int64](4)).
+				Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wh := &SparkApplicationWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+			}
+			_, gotErr := wh.ValidateCreate(ctx, tc.app)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("validateCreate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testcases := map[string]struct {
+		oldApp  *unstructured.Unstructured
+		newApp  *unstructured.Unstructured
+		wantErr error
+	}{
+		"queue name can be changed while suspended": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "ns").Queue("queue").Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "ns").Queue("queue2").Obj(),
+		},
+		"queue name cannot be changed while running": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "ns").Queue("queue").Suspend(false).Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "ns").Queue("queue2").Suspend(false).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.QueueLabel), kueue.LocalQueueName("queue2"), "field is immutable"),
+			}.ToAggregate(),
+		},
+		"invalid memory": {
+			oldApp: testingsparkapp.MakeSparkApplication("app", "ns").Queue("queue").Obj(),
+			newApp: testingsparkapp.MakeSparkApplication("app", "ns").Queue("queue").ExecutorMemory("2Gi").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "executor", "memory"), "2Gi", "must be a JVM memory string, like 512m or 2g"),
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().Build()
+			wh := &SparkApplicationWebhook{
+				queues: qcache.NewManagerForUnitTests(cli, schdcache.New(cli)),
+			}
+			_, gotErr := wh.ValidateUpdate(ctx, tc.oldApp, tc.newApp)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("ValidateUpdate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	kftrainerruntimecore "github.com/kubeflow/trainer/v2/pkg/runtime/core"
 	kftrainerjobset "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
 	trainjobutil "github.com/kubeflow/trainer/v2/pkg/util/trainjob"
+	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -286,6 +287,11 @@ func (t *TrainJob) RunWithPodSetsInfo(ctx context.Context, podSetsInfo []podset.
 	}
 	t.Spec.PodTemplateOverrides = userOverrides
 	for _, info := range podSetsInfo {
+		schedulingGates := info.SchedulingGates
+		if jobframework.WorkloadSliceEnabled(t) {
+			// The pods created after scaling the TrainJob up wait for the admission of the new workload slice.
+			schedulingGates = append(schedulingGates[:len(schedulingGates):len(schedulingGates)], corev1.PodSchedulingGate{Name: kueue.ElasticJobSchedulingGate})
+		}
 		// The trainjob controller merges each podSpecOverride sequentially, so any existing user provided override will be processed first
 		t.Spec.PodTemplateOverrides = append(t.Spec.PodTemplateOverrides, kftrainer.PodTemplateOverride{
 			TargetJobs: []kftrainer.PodTemplateOverrideTargetJob{
@@ -298,7 +304,7 @@ func (t *TrainJob) RunWithPodSetsInfo(ctx context.Context, podSetsInfo []podset.
 			Spec: &kftrainer.PodTemplateSpecOverride{
 				NodeSelector:    info.NodeSelector,
 				Tolerations:     info.Tolerations,
-				SchedulingGates: info.SchedulingGates,
+				SchedulingGates: schedulingGates,
 			},
 		})
 	}
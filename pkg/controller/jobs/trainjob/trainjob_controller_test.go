@@ -34,11 +34,13 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	testingjobset "sigs.k8s.io/kueue/pkg/util/testingjobs/jobset"
 	testingtrainjob "sigs.k8s.io/kueue/pkg/util/testingjobs/trainjob"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 var (
@@ -88,9 +90,47 @@ func TestRunWithPodsetsInfo(t *testing.T) {
 	cases := map[string]struct {
 		trainJob     *kftrainerapi.TrainJob
 		podsetsInfo  []podset.PodSetInfo
+		elasticJobs  bool
 		wantTrainJob *kftrainerapi.TrainJob
 		wantErr      bool
 	}{
+		"should gate the pods of an elastic TrainJob": {
+			trainJob: testTrainJob.Clone().
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			podsetsInfo: []podset.PodSetInfo{
+				{
+					Name: "node",
+					Labels: map[string]string{
+						constants.PodSetLabel: "node",
+					},
+					SchedulingGates: []corev1.PodSchedulingGate{{Name: "test-scheduling-gate-1"}},
+				},
+			},
+			elasticJobs: true,
+			wantTrainJob: testTrainJob.Clone().
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				PodTemplateOverrides([]kftrainerapi.PodTemplateOverride{
+					{
+						TargetJobs: []kftrainerapi.PodTemplateOverrideTargetJob{
+							{Name: "node"},
+						},
+						Metadata: &metav1.ObjectMeta{
+							Labels: map[string]string{
+								constants.PodSetLabel: "node",
+							},
+						},
+						Spec: &kftrainerapi.PodTemplateSpecOverride{
+							SchedulingGates: []corev1.PodSchedulingGate{
+								{Name: "test-scheduling-gate-1"},
+								{Name: kueue.ElasticJobSchedulingGate},
+							},
+						},
+					},
+				}).
+				Suspend(false).
+				Obj(),
+		},
 		"should add to the TrainJob the config specified in the PodSet info": {
 			trainJob: testTrainJob.Clone().Obj(),
 			podsetsInfo: []podset.PodSetInfo{
@@ -298,6 +338,7 @@ func TestRunWithPodsetsInfo(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobs)
 			ctx, _ := utiltesting.ContextWithLog(t)
 			clientBuilder := utiltesting.NewClientBuilder(kftrainerapi.AddToScheme, jobsetapi.AddToScheme).WithObjects()
 			indexer := utiltesting.AsIndexer(clientBuilder)
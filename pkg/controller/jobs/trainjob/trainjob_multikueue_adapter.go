@@ -27,13 +27,16 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	jobsetapi "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/util/api"
 	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 type multiKueueAdapter struct{}
@@ -53,12 +56,22 @@ func (b *multiKueueAdapter) SyncJob(ctx context.Context, localClient client.Clie
 		return err
 	}
 
-	// if the remote exists, just copy the status
+	// if the remote exists, copy the status
 	if err == nil {
-		return clientutil.PatchStatus(ctx, localClient, &localJob, func() (bool, error) {
+		if err := clientutil.PatchStatus(ctx, localClient, &localJob, func() (bool, error) {
 			localJob.Status = remoteJob.Status
 			return true, nil
-		})
+		}); err != nil {
+			return err
+		}
+
+		// Sync elastic workload if needed.
+		if jobframework.ElasticJobSyncNeeded(ctrl.LoggerFrom(ctx), workloadName, fromObject(&localJob), &remoteJob, nodesCounts(&localJob), nodesCounts(&remoteJob)) {
+			return jobframework.SyncElasticJob(ctx, remoteClient, workloadName, &remoteJob, func() {
+				syncNumNodes(&remoteJob, &localJob)
+			})
+		}
+		return nil
 	}
 
 	remoteJob = kftrainerapi.TrainJob{
@@ -79,6 +92,26 @@ func (b *multiKueueAdapter) SyncJob(ctx context.Context, localClient client.Clie
 	return remoteClient.Create(ctx, &remoteJob)
 }
 
+// nodesCounts returns the number of training nodes, which is the only count
+// of a TrainJob that can be scaled.
+func nodesCounts(trainJob *kftrainerapi.TrainJob) workload.PodSetsCounts {
+	if trainJob.Spec.Trainer == nil || trainJob.Spec.Trainer.NumNodes == nil {
+		return workload.PodSetsCounts{}
+	}
+	return workload.PodSetsCounts{"trainer": *trainJob.Spec.Trainer.NumNodes}
+}
+
+// syncNumNodes sets the number of training nodes of dst to the one of src.
+func syncNumNodes(dst, src *kftrainerapi.TrainJob) {
+	if src.Spec.Trainer == nil {
+		return
+	}
+	if dst.Spec.Trainer == nil {
+		dst.Spec.Trainer = &kftrainerapi.Trainer{}
+	}
+	dst.Spec.Trainer.NumNodes = src.Spec.Trainer.NumNodes
+}
+
 func (b *multiKueueAdapter) DeleteRemoteObject(ctx context.Context, remoteClient client.Client, key types.NamespacedName) error {
 	job := kftrainerapi.TrainJob{}
 	job.SetName(key.Name)
@@ -122,3 +155,10 @@ func (*multiKueueAdapter) WorkloadKeysFor(o runtime.Object) ([]types.NamespacedN
 
 	return []types.NamespacedName{{Name: prebuiltWl, Namespace: trainJob.Namespace}}, nil
 }
+
+var _ jobframework.MultiKueuePodsSelector = (*multiKueueAdapter)(nil)
+
+// RemotePodsSelector - the pods of a TrainJob belong to the JobSet created with its name.
+func (*multiKueueAdapter) RemotePodsSelector(key types.NamespacedName) map[string]string {
+	return map[string]string{jobsetapi.JobSetNameKey: key.Name}
+}
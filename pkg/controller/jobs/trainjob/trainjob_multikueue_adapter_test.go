@@ -31,9 +31,12 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/slices"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingtrainjob "sigs.k8s.io/kueue/pkg/util/testingjobs/trainjob"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 const (
@@ -48,6 +51,10 @@ func TestMultiKueueAdapter(t *testing.T) {
 
 	baseTrainJobBuilder := testingtrainjob.MakeTrainJob("trainjob1", TestNamespace).Suspend(false)
 	baseTrainJobManagedByKueueBuilder := baseTrainJobBuilder.Clone().ManagedBy(kueue.MultiKueueControllerName)
+	elasticTrainJobBuilder := baseTrainJobBuilder.Clone().
+		Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue)
+	elasticTrainJobManagedByKueueBuilder := elasticTrainJobBuilder.Clone().ManagedBy(kueue.MultiKueueControllerName)
+	scaledUpWorkloadName := jobframework.GetWorkloadNameForOwnerWithGVKAndGeneration("trainjob1", "", gvk, 0)
 
 	cases := map[string]struct {
 		managersTrainJobs []kftrainerapi.TrainJob
@@ -55,11 +62,65 @@ func TestMultiKueueAdapter(t *testing.T) {
 
 		operation func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error
 
+		elasticJobs bool
+
 		wantError             error
 		wantManagersTrainJobs []kftrainerapi.TrainJob
 		wantWorkerTrainJobs   []kftrainerapi.TrainJob
 	}{
 
+		"sync scales the remote elastic TrainJob up": {
+			managersTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobManagedByKueueBuilder.Clone().TrainerNumNodes(3).Obj(),
+			},
+			workerTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobBuilder.Clone().
+					TrainerNumNodes(2).
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+			elasticJobs: true,
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.SyncJob(ctx, managerClient, workerClient, types.NamespacedName{Name: "trainjob1", Namespace: TestNamespace}, scaledUpWorkloadName, "origin1")
+			},
+			wantManagersTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobManagedByKueueBuilder.Clone().TrainerNumNodes(3).Obj(),
+			},
+			wantWorkerTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobBuilder.Clone().
+					TrainerNumNodes(3).
+					Label(constants.PrebuiltWorkloadLabel, scaledUpWorkloadName).
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+		},
+		"sync skips the stale workload slice of the scaled up elastic TrainJob": {
+			managersTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobManagedByKueueBuilder.Clone().TrainerNumNodes(3).Obj(),
+			},
+			workerTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobBuilder.Clone().
+					TrainerNumNodes(2).
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+			elasticJobs: true,
+			operation: func(ctx context.Context, adapter *multiKueueAdapter, managerClient, workerClient client.Client) error {
+				return adapter.SyncJob(ctx, managerClient, workerClient, types.NamespacedName{Name: "trainjob1", Namespace: TestNamespace}, "wl1", "origin1")
+			},
+			wantManagersTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobManagedByKueueBuilder.Clone().TrainerNumNodes(3).Obj(),
+			},
+			wantWorkerTrainJobs: []kftrainerapi.TrainJob{
+				*elasticTrainJobBuilder.Clone().
+					TrainerNumNodes(2).
+					Label(constants.PrebuiltWorkloadLabel, "wl1").
+					Label(kueue.MultiKueueOriginLabel, "origin1").
+					Obj(),
+			},
+		},
 		"sync creates missing remote TrainJob": {
 			managersTrainJobs: []kftrainerapi.TrainJob{
 				*baseTrainJobManagedByKueueBuilder.Clone().Obj(),
@@ -254,6 +315,8 @@ func TestMultiKueueAdapter(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.elasticJobs)
+
 			managerBuilder := utiltesting.NewClientBuilder(kftrainerapi.AddToScheme).WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge})
 			managerBuilder = managerBuilder.WithLists(&kftrainerapi.TrainJobList{Items: tc.managersTrainJobs})
 			managerBuilder = managerBuilder.WithStatusSubresource(slices.Map(tc.managersTrainJobs, func(w *kftrainerapi.TrainJob) client.Object { return w })...)
@@ -24,6 +24,7 @@ const (
 	TASTopologyUngater          = "tas-topology-ungater"
 	TASNodeFailureController    = "tas-node-failure-controller"
 	TASNonTasUsageController    = "tas-non-tas-usage-controller"
+	TASNodeDrainController      = "tas-node-drain-controller"
 )
 
 const (
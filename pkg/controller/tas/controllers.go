@@ -46,6 +46,12 @@ func SetupControllers(mgr ctrl.Manager, queues *qcache.Manager, cache *schdcache
 			return ctrlName, err
 		}
 	}
+	if features.Enabled(features.TASFailedNodeReplacement) && features.Enabled(features.TASProactiveNodeDrain) {
+		nodeDrainReconciler := newNodeDrainReconciler(mgr.GetClient(), cache, recorder, cfg.TopologyAwareScheduling, roleTracker)
+		if ctrlName, err := nodeDrainReconciler.SetupWithManager(mgr, cfg); err != nil {
+			return ctrlName, err
+		}
+	}
 	nonTasUsageController := newNonTasUsageReconciler(mgr.GetClient(), cache, roleTracker)
 	if ctrlName, err := nonTasUsageController.SetupWithManager(mgr); err != nil {
 		return ctrlName, err
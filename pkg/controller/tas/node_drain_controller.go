@@ -0,0 +1,407 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/api"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// nodeDrainRetryPeriod is the period after which the migration of a
+	// Workload is retried, when the Workload is already being moved away
+	// from another node.
+	nodeDrainRetryPeriod = 10 * time.Second
+)
+
+// nodeDrainReconciler reconciles Nodes to detect planned drains. The Workloads
+// assigned to a node which is going to be drained are first notified, so that
+// they can checkpoint, and then moved to a replacement node using the
+// UnhealthyNodes mechanism, before the maintenance starts.
+type nodeDrainReconciler struct {
+	client              client.Client
+	cache               *schdcache.Cache
+	clock               clock.Clock
+	logName             string
+	recorder            record.EventRecorder
+	roleTracker         *roletracker.RoleTracker
+	maintenanceLabel    string
+	maintenanceTaintKey string
+	migrationLeadTime   time.Duration
+}
+
+var _ reconcile.Reconciler = (*nodeDrainReconciler)(nil)
+var _ predicate.TypedPredicate[*corev1.Node] = (*nodeDrainReconciler)(nil)
+
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+
+func newNodeDrainReconciler(client client.Client, cache *schdcache.Cache, recorder record.EventRecorder, cfg *config.TopologyAwareScheduling, roleTracker *roletracker.RoleTracker) *nodeDrainReconciler {
+	r := &nodeDrainReconciler{
+		client:              client,
+		cache:               cache,
+		logName:             TASNodeDrainController,
+		clock:               clock.RealClock{},
+		recorder:            recorder,
+		roleTracker:         roleTracker,
+		maintenanceLabel:    config.DefaultTASNodeMaintenanceKey,
+		maintenanceTaintKey: config.DefaultTASNodeMaintenanceKey,
+		migrationLeadTime:   config.DefaultTASNodeDrainMigrationLeadTime,
+	}
+	if cfg != nil && cfg.NodeDrain != nil {
+		r.maintenanceLabel = ptr.Deref(cfg.NodeDrain.MaintenanceLabel, r.maintenanceLabel)
+		r.maintenanceTaintKey = ptr.Deref(cfg.NodeDrain.MaintenanceTaintKey, r.maintenanceTaintKey)
+		if cfg.NodeDrain.MigrationLeadTime != nil {
+			r.migrationLeadTime = cfg.NodeDrain.MigrationLeadTime.Duration
+		}
+	}
+	return r
+}
+
+func (r *nodeDrainReconciler) logger() logr.Logger {
+	return roletracker.WithReplicaRole(ctrl.Log.WithName(r.logName), r.roleTracker)
+}
+
+func (r *nodeDrainReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) (string, error) {
+	return TASNodeDrainController, builder.ControllerManagedBy(mgr).
+		Named("tas_node_drain_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&corev1.Node{},
+			&handler.TypedEnqueueRequestForObject[*corev1.Node]{},
+			r,
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[corev1.SchemeGroupVersion.WithKind("Node").GroupKind().String()],
+			LogConstructor:          roletracker.NewLogConstructor(r.roleTracker, "tas-node-drain-reconciler"),
+		}).
+		Complete(core.WithLeadingManager(mgr, r, &corev1.Node{}, cfg))
+}
+
+func (r *nodeDrainReconciler) Generic(event.TypedGenericEvent[*corev1.Node]) bool {
+	return false
+}
+
+func (r *nodeDrainReconciler) Create(e event.TypedCreateEvent[*corev1.Node]) bool {
+	_, planned := r.plannedMaintenance(r.logger(), e.Object)
+	return planned
+}
+
+func (r *nodeDrainReconciler) Update(e event.TypedUpdateEvent[*corev1.Node]) bool {
+	if e.ObjectOld.Spec.Unschedulable != e.ObjectNew.Spec.Unschedulable ||
+		e.ObjectOld.Labels[r.maintenanceLabel] != e.ObjectNew.Labels[r.maintenanceLabel] ||
+		!equality.Semantic.DeepEqual(e.ObjectOld.Spec.Taints, e.ObjectNew.Spec.Taints) {
+		r.logger().V(4).Info("Node maintenance status may have changed, triggering reconcile", "node", klog.KObj(e.ObjectNew))
+		return true
+	}
+	return false
+}
+
+func (r *nodeDrainReconciler) Delete(event.TypedDeleteEvent[*corev1.Node]) bool {
+	// Deleted nodes are handled by the node failure controller.
+	return false
+}
+
+func (r *nodeDrainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Node")
+
+	var node corev1.Node
+	err := r.client.Get(ctx, req.NamespacedName, &node)
+	if client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	}
+	nodeExists := err == nil
+
+	// The replacements are checked even if the node is gone, as drained nodes
+	// are often deleted.
+	replacementsPending, err := r.completeReplacements(ctx, req.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var requeueAfter time.Duration
+	if replacementsPending {
+		requeueAfter = nodeDrainRetryPeriod
+	}
+	if !nodeExists {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	affectedWorkloads, err := tasWorkloadsOnNode(ctx, r.client, node.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if affectedWorkloads.Len() == 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	maintenanceStart, planned := r.plannedMaintenance(log, &node)
+	var workloadProcessingErrors []error
+	for wlKey := range affectedWorkloads {
+		wlLog := log.WithValues("workload", klog.KRef(wlKey.Namespace, wlKey.Name))
+		var wl kueue.Workload
+		if err := r.client.Get(ctx, wlKey, &wl); err != nil {
+			if !apierrors.IsNotFound(err) {
+				wlLog.Error(err, "Failed to get workload")
+				workloadProcessingErrors = append(workloadProcessingErrors, err)
+			}
+			continue
+		}
+		if workload.IsEvicted(&wl) || workload.IsFinished(&wl) {
+			continue
+		}
+		wlCtx := ctrl.LoggerInto(ctx, wlLog)
+		if !planned {
+			if err := r.cancelMaintenance(wlCtx, &wl, node.Name); err != nil {
+				workloadProcessingErrors = append(workloadProcessingErrors, err)
+			}
+			continue
+		}
+		wlRequeueAfter, err := r.handlePlannedMaintenance(wlCtx, &wl, node.Name, maintenanceStart)
+		if err != nil {
+			workloadProcessingErrors = append(workloadProcessingErrors, err)
+			continue
+		}
+		if wlRequeueAfter > 0 && (requeueAfter == 0 || wlRequeueAfter < requeueAfter) {
+			requeueAfter = wlRequeueAfter
+		}
+	}
+	if len(workloadProcessingErrors) > 0 {
+		return ctrl.Result{}, errors.Join(workloadProcessingErrors...)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// plannedMaintenance returns the planned start of the maintenance, if the node
+// is cordoned and it announces the maintenance with the label or the taint.
+func (r *nodeDrainReconciler) plannedMaintenance(log logr.Logger, node *corev1.Node) (time.Time, bool) {
+	if !node.Spec.Unschedulable {
+		return time.Time{}, false
+	}
+	value, found := node.Labels[r.maintenanceLabel]
+	if !found {
+		taintIdx := slices.IndexFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+			return taint.Key == r.maintenanceTaintKey
+		})
+		if taintIdx < 0 {
+			return time.Time{}, false
+		}
+		value = node.Spec.Taints[taintIdx].Value
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.V(3).Info("Ignoring the invalid maintenance start of the node", "node", klog.KObj(node), "value", value)
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
+
+// handlePlannedMaintenance notifies the workload about the planned maintenance,
+// and once the migration lead time is reached, it marks the node as unhealthy
+// so that TAS finds its replacement. It returns after how long the workload
+// needs to be processed again.
+func (r *nodeDrainReconciler) handlePlannedMaintenance(ctx context.Context, wl *kueue.Workload, nodeName string, maintenanceStart time.Time) (time.Duration, error) {
+	log := ctrl.LoggerFrom(ctx)
+	migrateAt := maintenanceStart.Add(-r.migrationLeadTime)
+	now := r.clock.Now()
+	if now.Before(migrateAt) {
+		if !hasMaintenancePendingCondition(wl, kueue.WorkloadNodeMaintenanceScheduled) {
+			msg := fmt.Sprintf("Node %s is scheduled for maintenance at %s, the Workload will be moved to a replacement node at %s. %s",
+				nodeName, maintenanceStart.UTC().Format(time.RFC3339), migrateAt.UTC().Format(time.RFC3339), r.replacementSummary(ctx, wl, nodeName))
+			log.V(3).Info("Notifying workload about the planned node maintenance", "node", nodeName, "maintenanceStart", maintenanceStart)
+			if err := r.setMaintenancePendingCondition(ctx, wl, metav1.ConditionTrue, kueue.WorkloadNodeMaintenanceScheduled, msg); err != nil {
+				return 0, err
+			}
+			r.recorder.Event(wl, corev1.EventTypeNormal, kueue.WorkloadNodeMaintenanceScheduled, api.TruncateEventMessage(msg))
+		}
+		return migrateAt.Sub(now), nil
+	}
+	if workload.HasUnhealthyNode(wl, nodeName) {
+		return nodeDrainRetryPeriod, nil
+	}
+	if workload.HasUnhealthyNodes(wl) {
+		// The workload is already being moved away from another node, wait for
+		// the replacement to complete rather than evicting the workload due to
+		// multiple node failures.
+		log.V(3).Info("Delaying the migration of the workload, waiting for the ongoing node replacement", "unhealthyNodes", workload.UnhealthyNodeNames(wl))
+		return nodeDrainRetryPeriod, nil
+	}
+	log.V(3).Info("Moving workload away from the node planned for maintenance", "node", nodeName)
+	if err := workload.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func(wl *kueue.Workload) (bool, error) {
+		wl.Status.UnhealthyNodes = append(wl.Status.UnhealthyNodes, kueue.UnhealthyNode{Name: nodeName})
+		return true, nil
+	}); err != nil {
+		return 0, err
+	}
+	msg := nodeReplacementRequestedMessage(nodeName)
+	if err := r.setMaintenancePendingCondition(ctx, wl, metav1.ConditionFalse, kueue.WorkloadNodeReplacementRequested, msg); err != nil {
+		return 0, err
+	}
+	r.recorder.Event(wl, corev1.EventTypeNormal, kueue.WorkloadNodeReplacementRequested, msg)
+	// Check back for the completion of the replacement.
+	return nodeDrainRetryPeriod, nil
+}
+
+// completeReplacements marks the replacement of the node as completed for the
+// workloads which no longer have it among their unhealthy nodes, because they
+// were moved to the replacement node or evicted. It returns true if some
+// replacements of the node are still pending.
+func (r *nodeDrainReconciler) completeReplacements(ctx context.Context, nodeName string) (bool, error) {
+	var workloads kueue.WorkloadList
+	if err := r.client.List(ctx, &workloads); err != nil {
+		return false, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	pending := false
+	var workloadProcessingErrors []error
+	for i := range workloads.Items {
+		wl := &workloads.Items[i]
+		if !isNodeReplacementRequested(wl, nodeName) {
+			continue
+		}
+		if workload.HasUnhealthyNode(wl, nodeName) {
+			pending = true
+			continue
+		}
+		ctrl.LoggerFrom(ctx).V(3).Info("Node replacement completed", "workload", klog.KObj(wl), "node", nodeName)
+		msg := fmt.Sprintf("Workload was moved away from node %s", nodeName)
+		if err := r.setMaintenancePendingCondition(ctx, wl, metav1.ConditionFalse, kueue.WorkloadNodeReplacementCompleted, msg); err != nil {
+			workloadProcessingErrors = append(workloadProcessingErrors, client.IgnoreNotFound(err))
+		}
+	}
+	return pending, errors.Join(workloadProcessingErrors...)
+}
+
+// cancelMaintenance resets the NodeMaintenancePending condition when the node
+// is no longer planned to be drained, before the workload was moved.
+func (r *nodeDrainReconciler) cancelMaintenance(ctx context.Context, wl *kueue.Workload, nodeName string) error {
+	if !hasMaintenancePendingCondition(wl, kueue.WorkloadNodeMaintenanceScheduled) {
+		return nil
+	}
+	ctrl.LoggerFrom(ctx).V(3).Info("Node maintenance cancelled", "node", nodeName)
+	msg := fmt.Sprintf("Maintenance of node %s is no longer planned", nodeName)
+	return r.setMaintenancePendingCondition(ctx, wl, metav1.ConditionFalse, kueue.WorkloadNodeMaintenanceCancelled, msg)
+}
+
+// replacementSummary describes the replacement nodes computed for the PodSets
+// of the workload which are assigned to the node.
+func (r *nodeDrainReconciler) replacementSummary(ctx context.Context, wl *kueue.Workload, nodeName string) string {
+	if wl.Status.Admission == nil {
+		return ""
+	}
+	var summary []string
+	for i := range wl.Status.Admission.PodSetAssignments {
+		psa := &wl.Status.Admission.PodSetAssignments[i]
+		if !slices.Contains(slices.Collect(utiltas.LowestLevelValues(psa.TopologyAssignment)), nodeName) || len(psa.Flavors) == 0 {
+			continue
+		}
+		flavor := psa.Flavors[slices.Sorted(maps.Keys(psa.Flavors))[0]]
+		tasFlavorCache := r.cache.TASCache().Get(flavor)
+		if tasFlavorCache == nil {
+			summary = append(summary, fmt.Sprintf("No TAS information for flavor %q of podSet %q.", flavor, psa.Name))
+			continue
+		}
+		nodes, reason, err := tasFlavorCache.FindNodeReplacement(ctx, wl, psa, nodeName)
+		switch {
+		case err != nil:
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to compute the node replacement", "podSet", psa.Name)
+			summary = append(summary, fmt.Sprintf("Failed to compute the replacement for podSet %q.", psa.Name))
+		case reason != "":
+			summary = append(summary, fmt.Sprintf("No replacement currently available for podSet %q: %s.", psa.Name, reason))
+		default:
+			summary = append(summary, fmt.Sprintf("Replacement for podSet %q: %s.", psa.Name, strings.Join(nodes, ", ")))
+		}
+	}
+	return strings.Join(summary, " ")
+}
+
+func (r *nodeDrainReconciler) setMaintenancePendingCondition(ctx context.Context, wl *kueue.Workload, status metav1.ConditionStatus, reason, msg string) error {
+	return workload.PatchStatus(ctx, r.client, wl, constants.TASNodeDrainControllerName, func(wl *kueue.Workload) (bool, error) {
+		return apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+			Type:               kueue.WorkloadNodeMaintenancePending,
+			Status:             status,
+			LastTransitionTime: metav1.NewTime(r.clock.Now()),
+			Reason:             reason,
+			Message:            api.TruncateConditionMessage(msg),
+			ObservedGeneration: wl.Generation,
+		}), nil
+	})
+}
+
+func hasMaintenancePendingCondition(wl *kueue.Workload, reason string) bool {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadNodeMaintenancePending)
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.Reason == reason
+}
+
+// isNodeReplacementRequestedForDrain returns true if the replacement of the
+// node was requested for the workload due to a planned node drain, and it is
+// still pending.
+func isNodeReplacementRequestedForDrain(wl *kueue.Workload, nodeName string) bool {
+	return features.Enabled(features.TASProactiveNodeDrain) &&
+		isNodeReplacementRequested(wl, nodeName) && workload.HasUnhealthyNode(wl, nodeName)
+}
+
+func isNodeReplacementRequested(wl *kueue.Workload, nodeName string) bool {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadNodeMaintenancePending)
+	return cond != nil && cond.Reason == kueue.WorkloadNodeReplacementRequested &&
+		cond.Message == nodeReplacementRequestedMessage(nodeName)
+}
+
+// nodeReplacementRequestedMessage returns the message of the
+// NodeReplacementRequested reason, which identifies the drained node.
+func nodeReplacementRequestedMessage(nodeName string) string {
+	return fmt.Sprintf("Node %s is going to be drained, requested its replacement", nodeName)
+}
@@ -0,0 +1,305 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestNodeDrainReconciler(t *testing.T) {
+	testStartTime := time.Now().Truncate(time.Second)
+	nodeName := "x1"
+	wlName := "test-workload"
+	nsName := "default"
+	flavorName := "tas-flavor"
+	wlKey := types.NamespacedName{Name: wlName, Namespace: nsName}
+	leadTime := configapi.DefaultTASNodeDrainMigrationLeadTime
+
+	maintenanceAt := func(d time.Duration) string {
+		return strconv.FormatInt(testStartTime.Add(d).Unix(), 10)
+	}
+
+	baseWorkload := utiltestingapi.MakeWorkload(wlName, nsName).
+		Finalizers(kueue.ResourceInUseFinalizerName).
+		PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 1).Request(corev1.ResourceCPU, "1").Obj()).
+		ReserveQuotaAt(
+			utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, kueue.ResourceFlavorReference(flavorName), "1").
+					TopologyAssignment(utiltestingapi.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+						Domains(utiltestingapi.MakeTopologyDomainAssignment([]string{nodeName}, 1).Obj()).
+						Obj()).
+					Obj()).
+				Obj(), testStartTime,
+		).
+		AdmittedAt(true, testStartTime).
+		Obj()
+
+	scheduledWorkload := baseWorkload.DeepCopy()
+	apimeta.SetStatusCondition(&scheduledWorkload.Status.Conditions, metav1.Condition{
+		Type:   kueue.WorkloadNodeMaintenancePending,
+		Status: metav1.ConditionTrue,
+		Reason: kueue.WorkloadNodeMaintenanceScheduled,
+	})
+
+	workloadWithOtherUnhealthyNode := baseWorkload.DeepCopy()
+	workloadWithOtherUnhealthyNode.Status.UnhealthyNodes = []kueue.UnhealthyNode{{Name: "x3"}}
+
+	replacementRequestedCondition := metav1.Condition{
+		Type:    kueue.WorkloadNodeMaintenancePending,
+		Status:  metav1.ConditionFalse,
+		Reason:  kueue.WorkloadNodeReplacementRequested,
+		Message: "Node x1 is going to be drained, requested its replacement",
+	}
+	replacingWorkload := baseWorkload.DeepCopy()
+	replacingWorkload.Status.UnhealthyNodes = []kueue.UnhealthyNode{{Name: nodeName}}
+	apimeta.SetStatusCondition(&replacingWorkload.Status.Conditions, replacementRequestedCondition)
+
+	replacedWorkload := baseWorkload.DeepCopy()
+	replacedWorkload.Status.Admission.PodSetAssignments[0].TopologyAssignment = utiltestingapi.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+		Domains(utiltestingapi.MakeTopologyDomainAssignment([]string{"x2"}, 1).Obj()).
+		Obj()
+	apimeta.SetStatusCondition(&replacedWorkload.Status.Conditions, replacementRequestedCondition)
+
+	baseNode := testingnode.MakeNode(nodeName).
+		Label(corev1.LabelHostname, nodeName).
+		StatusAllocatable(corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("1"),
+			corev1.ResourcePods: resource.MustParse("10"),
+		}).
+		Ready()
+	replacementNode := testingnode.MakeNode("x2").
+		Label(corev1.LabelHostname, "x2").
+		StatusAllocatable(corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("1"),
+			corev1.ResourcePods: resource.MustParse("10"),
+		}).
+		Ready().
+		Obj()
+
+	tests := map[string]struct {
+		cfg                *configapi.TopologyAwareScheduling
+		initObjs           []client.Object
+		wantUnhealthyNodes []kueue.UnhealthyNode
+		wantRequeue        time.Duration
+		wantCondition      *metav1.Condition
+	}{
+		"node not cordoned": {
+			initObjs: []client.Object{
+				baseNode.Clone().Label(configapi.DefaultTASNodeMaintenanceKey, maintenanceAt(time.Hour)).Obj(),
+				baseWorkload.DeepCopy(),
+			},
+		},
+		"node cordoned without planned maintenance": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Obj(),
+				baseWorkload.DeepCopy(),
+			},
+		},
+		"invalid maintenance start is ignored": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label(configapi.DefaultTASNodeMaintenanceKey, "tomorrow").Obj(),
+				baseWorkload.DeepCopy(),
+			},
+		},
+		"planned maintenance announced with label; workload notified": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label(configapi.DefaultTASNodeMaintenanceKey, maintenanceAt(time.Hour)).Obj(),
+				replacementNode,
+				baseWorkload.DeepCopy(),
+			},
+			wantRequeue: time.Hour - leadTime,
+			wantCondition: &metav1.Condition{
+				Type:   kueue.WorkloadNodeMaintenancePending,
+				Status: metav1.ConditionTrue,
+				Reason: kueue.WorkloadNodeMaintenanceScheduled,
+				Message: "Node x1 is scheduled for maintenance at " + testStartTime.Add(time.Hour).UTC().Format(time.RFC3339) +
+					", the Workload will be moved to a replacement node at " + testStartTime.Add(time.Hour-leadTime).UTC().Format(time.RFC3339) +
+					`. Replacement for podSet "main": x2.`,
+			},
+		},
+		"planned maintenance announced with taint; no replacement available": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Taints(corev1.Taint{
+					Key:    configapi.DefaultTASNodeMaintenanceKey,
+					Value:  maintenanceAt(time.Hour),
+					Effect: corev1.TaintEffectNoSchedule,
+				}).Obj(),
+				baseWorkload.DeepCopy(),
+			},
+			wantRequeue: time.Hour - leadTime,
+			wantCondition: &metav1.Condition{
+				Type:   kueue.WorkloadNodeMaintenancePending,
+				Status: metav1.ConditionTrue,
+				Reason: kueue.WorkloadNodeMaintenanceScheduled,
+				Message: "Node x1 is scheduled for maintenance at " + testStartTime.Add(time.Hour).UTC().Format(time.RFC3339) +
+					", the Workload will be moved to a replacement node at " + testStartTime.Add(time.Hour-leadTime).UTC().Format(time.RFC3339) +
+					`. No replacement currently available for podSet "main": no topology domains at level: kubernetes.io/hostname.`,
+			},
+		},
+		"custom maintenance label": {
+			cfg: &configapi.TopologyAwareScheduling{
+				NodeDrain: &configapi.TASNodeDrain{
+					MaintenanceLabel:  ptr.To("example.com/maintenance"),
+					MigrationLeadTime: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label("example.com/maintenance", maintenanceAt(time.Hour)).Obj(),
+				replacementNode,
+				baseWorkload.DeepCopy(),
+			},
+			wantRequeue: time.Hour - time.Minute,
+			wantCondition: &metav1.Condition{
+				Type:   kueue.WorkloadNodeMaintenancePending,
+				Status: metav1.ConditionTrue,
+				Reason: kueue.WorkloadNodeMaintenanceScheduled,
+				Message: "Node x1 is scheduled for maintenance at " + testStartTime.Add(time.Hour).UTC().Format(time.RFC3339) +
+					", the Workload will be moved to a replacement node at " + testStartTime.Add(time.Hour-time.Minute).UTC().Format(time.RFC3339) +
+					`. Replacement for podSet "main": x2.`,
+			},
+		},
+		"migration lead time reached; node marked for replacement": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label(configapi.DefaultTASNodeMaintenanceKey, maintenanceAt(time.Minute)).Obj(),
+				scheduledWorkload.DeepCopy(),
+			},
+			wantUnhealthyNodes: []kueue.UnhealthyNode{{Name: nodeName}},
+			wantRequeue:        nodeDrainRetryPeriod,
+			wantCondition:      &replacementRequestedCondition,
+		},
+		"node replacement pending": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label(configapi.DefaultTASNodeMaintenanceKey, maintenanceAt(time.Minute)).Obj(),
+				replacingWorkload.DeepCopy(),
+			},
+			wantUnhealthyNodes: []kueue.UnhealthyNode{{Name: nodeName}},
+			wantRequeue:        nodeDrainRetryPeriod,
+			wantCondition:      &replacementRequestedCondition,
+		},
+		"node replacement completed": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label(configapi.DefaultTASNodeMaintenanceKey, maintenanceAt(time.Minute)).Obj(),
+				replacedWorkload.DeepCopy(),
+			},
+			wantCondition: &metav1.Condition{
+				Type:    kueue.WorkloadNodeMaintenancePending,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.WorkloadNodeReplacementCompleted,
+				Message: "Workload was moved away from node x1",
+			},
+		},
+		"node replacement completed after the node was deleted": {
+			initObjs: []client.Object{
+				replacedWorkload.DeepCopy(),
+			},
+			wantCondition: &metav1.Condition{
+				Type:    kueue.WorkloadNodeMaintenancePending,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.WorkloadNodeReplacementCompleted,
+				Message: "Workload was moved away from node x1",
+			},
+		},
+		"migration delayed while another node is being replaced": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().Label(configapi.DefaultTASNodeMaintenanceKey, maintenanceAt(time.Minute)).Obj(),
+				workloadWithOtherUnhealthyNode.DeepCopy(),
+			},
+			wantUnhealthyNodes: []kueue.UnhealthyNode{{Name: "x3"}},
+			wantRequeue:        nodeDrainRetryPeriod,
+		},
+		"maintenance cancelled before the migration": {
+			initObjs: []client.Object{
+				baseNode.Clone().Obj(),
+				scheduledWorkload.DeepCopy(),
+			},
+			wantCondition: &metav1.Condition{
+				Type:    kueue.WorkloadNodeMaintenancePending,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.WorkloadNodeMaintenanceCancelled,
+				Message: "Maintenance of node x1 is no longer planned",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASProactiveNodeDrain, true)
+			fakeClock := testingclock.NewFakeClock(testStartTime)
+
+			clientBuilder := utiltesting.NewClientBuilder().
+				WithObjects(tc.initObjs...).
+				WithStatusSubresource(tc.initObjs...).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge})
+			ctx, log := utiltesting.ContextWithLog(t)
+			if err := indexer.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Failed to setup indexes: %v", err)
+			}
+			cl := clientBuilder.Build()
+
+			cache := schdcache.New(cl)
+			cache.AddOrUpdateTopology(log, utiltestingapi.MakeTopology("default").Levels(corev1.LabelHostname).Obj())
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor(flavorName).TopologyName("default").Obj())
+
+			r := newNodeDrainReconciler(cl, cache, &utiltesting.EventRecorder{}, tc.cfg, nil)
+			r.clock = fakeClock
+
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: nodeName}})
+			if err != nil {
+				t.Errorf("Reconcile() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantRequeue, result.RequeueAfter); diff != "" {
+				t.Errorf("Unexpected RequeueAfter (-want/+got):\n%s", diff)
+			}
+
+			wl := &kueue.Workload{}
+			if err := cl.Get(ctx, wlKey, wl); err != nil {
+				t.Fatalf("Failed to get workload %q: %v", wlName, err)
+			}
+			if diff := cmp.Diff(tc.wantUnhealthyNodes, wl.Status.UnhealthyNodes, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected unhealthyNodes in status (-want/+got):\n%s", diff)
+			}
+			gotCondition := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadNodeMaintenancePending)
+			if diff := cmp.Diff(tc.wantCondition, gotCondition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("Unexpected NodeMaintenancePending condition (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -105,7 +105,7 @@ func (r *nodeFailureReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 	nodeExists := err == nil
 
-	affectedWorkloads, err := r.getWorkloadsOnNode(ctx, req.Name)
+	affectedWorkloads, err := tasWorkloadsOnNode(ctx, r.client, req.Name)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -198,10 +198,10 @@ func (r *nodeFailureReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.C
 		Complete(core.WithLeadingManager(mgr, r, &corev1.Node{}, cfg))
 }
 
-// getWorkloadsOnNode gets all workloads that have the given node assigned in TAS topology assignment
-func (r *nodeFailureReconciler) getWorkloadsOnNode(ctx context.Context, nodeName string) (sets.Set[types.NamespacedName], error) {
+// tasWorkloadsOnNode gets all workloads that have the given node assigned in TAS topology assignment
+func tasWorkloadsOnNode(ctx context.Context, c client.Client, nodeName string) (sets.Set[types.NamespacedName], error) {
 	var allWorkloads kueue.WorkloadList
-	if err := r.client.List(ctx, &allWorkloads); err != nil {
+	if err := c.List(ctx, &allWorkloads); err != nil {
 		return nil, fmt.Errorf("failed to list workloads: %w", err)
 	}
 	tasWorkloadsOnNode := sets.New[types.NamespacedName]()
@@ -372,6 +372,10 @@ func (r *nodeFailureReconciler) reconcileWorkloadsOnNode(ctx context.Context, no
 			hasWaitingWorkloads = true
 			notUnhealthyWorkloads.Insert(wlKey)
 		case workloadHealthy:
+			if node.Spec.Unschedulable && isNodeReplacementRequestedForDrain(&wl, nodeName) {
+				// The node is being drained, so keep it marked for replacement.
+				continue
+			}
 			notUnhealthyWorkloads.Insert(wlKey)
 		}
 	}
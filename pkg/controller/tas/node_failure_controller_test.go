@@ -121,6 +121,17 @@ func TestNodeFailureReconciler(t *testing.T) {
 		},
 	}
 
+	drainingWorkload := func(drainedNodeName string) *kueue.Workload {
+		wl := workloadWithUnhealthyNode.DeepCopy()
+		apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+			Type:    kueue.WorkloadNodeMaintenancePending,
+			Status:  metav1.ConditionFalse,
+			Reason:  kueue.WorkloadNodeReplacementRequested,
+			Message: nodeReplacementRequestedMessage(drainedNodeName),
+		})
+		return wl
+	}
+
 	baseNode := testingnode.MakeNode(nodeName)
 
 	tests := map[string]struct {
@@ -507,6 +518,32 @@ func TestNodeFailureReconciler(t *testing.T) {
 				features.TASReplaceNodeOnPodTermination: false,
 			},
 		},
+		"Cordoned healthy node drained for the workload is kept for replacement": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().StatusConditions(corev1.NodeCondition{
+					Type:               corev1.NodeReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: now}).Obj(),
+				drainingWorkload(nodeName),
+				basePod.DeepCopy(),
+			},
+			reconcileRequests:  []reconcile.Request{{NamespacedName: types.NamespacedName{Name: nodeName}}},
+			wantUnhealthyNodes: []kueue.UnhealthyNode{{Name: nodeName}},
+			featureGates:       map[featuregate.Feature]bool{features.TASProactiveNodeDrain: true},
+		},
+		"Cordoned healthy node is removed when another node was drained for the workload": {
+			initObjs: []client.Object{
+				baseNode.Clone().Unschedulable().StatusConditions(corev1.NodeCondition{
+					Type:               corev1.NodeReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: now}).Obj(),
+				drainingWorkload(nodeName2),
+				basePod.DeepCopy(),
+			},
+			reconcileRequests:  []reconcile.Request{{NamespacedName: types.NamespacedName{Name: nodeName}}},
+			wantUnhealthyNodes: nil,
+			featureGates:       map[featuregate.Feature]bool{features.TASProactiveNodeDrain: true},
+		},
 		"Node has NoExecute taint and pods missing -> Unhealthy": {
 			initObjs: []client.Object{
 				testingnode.MakeNode(nodeName).
@@ -17,27 +17,44 @@ limitations under the License.
 package dispatcher
 
 import (
+	"cmp"
+
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 )
 
-func SetupControllers(mgr ctrl.Manager, cfg *configapi.Configuration, roleTracker *roletracker.RoleTracker) (string, error) {
-	if *cfg.MultiKueue.DispatcherName != configapi.MultiKueueDispatcherModeIncremental {
-		return "", nil
-	}
-
-	helper, err := admissioncheck.NewMultiKueueStoreHelper(mgr.GetClient())
-	if err != nil {
-		return "", err
-	}
-
-	idRec := NewIncrementalDispatcherReconciler(mgr.GetClient(), helper, roleTracker)
-	err = idRec.SetupWithManager(mgr, cfg)
-	if err != nil {
-		return "multikueue-incremental-dispatcher", err
+func SetupControllers(mgr ctrl.Manager, cfg *configapi.Configuration, roleTracker *roletracker.RoleTracker, capacityCache *capacity.Cache) (string, error) {
+	switch *cfg.MultiKueue.DispatcherName {
+	case configapi.MultiKueueDispatcherModeIncremental:
+		helper, err := admissioncheck.NewMultiKueueStoreHelper(mgr.GetClient())
+		if err != nil {
+			return "", err
+		}
+
+		idRec := NewIncrementalDispatcherReconciler(mgr.GetClient(), helper, roleTracker)
+		err = idRec.SetupWithManager(mgr, cfg)
+		if err != nil {
+			return "multikueue-incremental-dispatcher", err
+		}
+	case configapi.MultiKueueDispatcherModeScoring:
+		helper, err := admissioncheck.NewMultiKueueStoreHelper(mgr.GetClient())
+		if err != nil {
+			return "", err
+		}
+
+		sdCfg := cmp.Or(cfg.MultiKueue.ScoringDispatcher, &configapi.MultiKueueScoringDispatcher{})
+		sdRec, err := NewScoringDispatcherReconciler(mgr.GetClient(), helper, capacityCache, sdCfg, roleTracker)
+		if err != nil {
+			return "multikueue-scoring-dispatcher", err
+		}
+		err = sdRec.SetupWithManager(mgr, cfg)
+		if err != nil {
+			return "multikueue-scoring-dispatcher", err
+		}
 	}
 
 	return "", nil
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -123,11 +124,27 @@ func (r *IncrementalDispatcherReconciler) Reconcile(ctx context.Context, req ctr
 		return reconcile.Result{}, nil
 	}
 
+	remoteClusters, err = admissioncheck.ExcludeCordonedClusters(ctx, r.client, remoteClusters)
+	if err != nil {
+		log.Error(err, "Can not get cordoned worker clusters")
+		return reconcile.Result{}, err
+	}
+
+	remoteClusters, preferredClusters, err := admissioncheck.SelectClusters(ctx, r.client, wl, remoteClusters)
+	if errors.Is(err, admissioncheck.ErrInvalidClusterSelector) {
+		log.V(3).Info("The workload has an invalid cluster selector, skip the reconciliation", "reason", err.Error())
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		log.Error(err, "Can not select worker clusters")
+		return reconcile.Result{}, err
+	}
+
 	log.V(3).Info("Nominate Worker Clusters with Incremental Dispatcher")
-	return r.nominateWorkers(ctx, wl, remoteClusters, log)
+	return r.nominateWorkers(ctx, wl, admissioncheck.ExcludeMigratedFromCluster(wl, remoteClusters), preferredClusters, log)
 }
 
-func (r *IncrementalDispatcherReconciler) nominateWorkers(ctx context.Context, wl *kueue.Workload, remoteClusters sets.Set[string], log logr.Logger) (reconcile.Result, error) {
+func (r *IncrementalDispatcherReconciler) nominateWorkers(ctx context.Context, wl *kueue.Workload, remoteClusters, preferredClusters sets.Set[string], log logr.Logger) (reconcile.Result, error) {
 	key := client.ObjectKeyFromObject(wl)
 	roundStart, found := r.getRoundStartTime(key)
 	now := r.clock.Now()
@@ -138,7 +155,7 @@ func (r *IncrementalDispatcherReconciler) nominateWorkers(ctx context.Context, w
 		return reconcile.Result{RequeueAfter: remainingWaitTime}, nil
 	}
 
-	nextNominatedWorkers, err := getNextNominatedWorkers(log, wl, remoteClusters)
+	nextNominatedWorkers, err := getNextNominatedWorkers(log, wl, remoteClusters, preferredClusters)
 	log.V(5).Info("revoke outdated nomination and nominate new worker clusters", "revokedWorkerClusters", wl.Status.NominatedClusterNames, "nominatedWorkerClusters", nextNominatedWorkers)
 	if err != nil {
 		log.Error(err, "Failed to nominate next worker clusters")
@@ -160,8 +177,9 @@ func (r *IncrementalDispatcherReconciler) nominateWorkers(ctx context.Context, w
 }
 
 // getNextNominatedWorkers returns the next set of nominated workers for incremental dispatching.
-// It nominates up to 3 remotes that have not yet been nominated, in sorted order.
-func getNextNominatedWorkers(log logr.Logger, wl *kueue.Workload, remoteClusters sets.Set[string]) ([]string, error) {
+// It nominates up to 3 remotes that have not yet been nominated, in sorted order,
+// the preferred remotes first.
+func getNextNominatedWorkers(log logr.Logger, wl *kueue.Workload, remoteClusters, preferredClusters sets.Set[string]) ([]string, error) {
 	alreadyNominated := sets.New(wl.Status.NominatedClusterNames...)
 
 	workers := make([]string, 0, len(remoteClusters))
@@ -170,7 +188,15 @@ func getNextNominatedWorkers(log logr.Logger, wl *kueue.Workload, remoteClusters
 			workers = append(workers, remoteWorker)
 		}
 	}
-	slices.Sort(workers)
+	slices.SortFunc(workers, func(a, b string) int {
+		if preferredClusters.Has(a) != preferredClusters.Has(b) {
+			if preferredClusters.Has(a) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
 
 	log.V(5).Info("proceeding worker clusters nomination", "alreadyNominatedClusterNames", alreadyNominated, "remainingClusterNames", workers)
 
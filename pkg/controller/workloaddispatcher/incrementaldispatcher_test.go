@@ -179,6 +179,7 @@ func TestIncrementalDispatcherNominateWorkers(t *testing.T) {
 
 	testCases := map[string]struct {
 		remoteClusters             sets.Set[string]
+		preferredClusters          sets.Set[string]
 		workload                   *kueue.Workload
 		wantNominatedClustersCount int
 		wantErr                    error
@@ -257,6 +258,15 @@ func TestIncrementalDispatcherNominateWorkers(t *testing.T) {
 			advanceRoundTime:           true,
 			wantNominatedClusters:      []string{"A", "B", "C", "D", "E", "F", "G", "H"},
 		},
+		"preferred remotes nominated first": {
+			remoteClusters:             sets.New("A", "B", "C", "D", "E", "F"),
+			preferredClusters:          sets.New("D", "E"),
+			workload:                   baseWl.Clone().Obj(),
+			wantNominatedClustersCount: 3,
+			wantErr:                    nil,
+			advanceRoundTime:           true,
+			wantNominatedClusters:      []string{"D", "E", "A"},
+		},
 		"no remotes": {
 			remoteClusters:             make(sets.Set[string]),
 			workload:                   baseWl.Clone().Obj(),
@@ -299,7 +309,7 @@ func TestIncrementalDispatcherNominateWorkers(t *testing.T) {
 			previousRoundNominatedClusters := tc.workload.Status.NominatedClusterNames
 
 			ctx, log := utiltesting.ContextWithLog(t)
-			_, gotErr := reconciler.nominateWorkers(ctx, tc.workload, tc.remoteClusters, log)
+			_, gotErr := reconciler.nominateWorkers(ctx, tc.workload, tc.remoteClusters, tc.preferredClusters, log)
 
 			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.EquateErrors()); diff != "" {
 				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
@@ -0,0 +1,268 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueueconfig "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
+	"sigs.k8s.io/kueue/pkg/controller/core"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	scoringDispatcherRoundTimeout = 5 * time.Minute
+	scoringDispatcherBatchSize    = 3
+)
+
+const ScoringDispatcherControllerName = "multikueue_scoring_dispatcher"
+
+type clusterPreference struct {
+	selector labels.Selector
+	weight   float64
+}
+
+// ScoringDispatcherReconciler nominates the worker clusters for a Workload in
+// the order of their scores, computed from the cached capacity of the workers.
+type ScoringDispatcherReconciler struct {
+	client                 client.Client
+	helper                 *admissioncheck.MultiKueueStoreHelper
+	capacityCache          *capacity.Cache
+	clock                  clock.Clock
+	roundStartTimes        *utilmaps.SyncMap[types.NamespacedName, time.Time]
+	roleTracker            *roletracker.RoleTracker
+	freeCapacityWeight     float64
+	pendingWorkloadsWeight float64
+	clusterPreferences     []clusterPreference
+}
+
+var _ reconcile.Reconciler = (*ScoringDispatcherReconciler)(nil)
+
+func (r *ScoringDispatcherReconciler) SetupWithManager(mgr ctrl.Manager, cfg *kueueconfig.Configuration) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(ScoringDispatcherControllerName).
+		For(&kueue.Workload{}).
+		WithLogConstructor(roletracker.NewLogConstructor(r.roleTracker, ScoringDispatcherControllerName)).
+		Complete(core.WithLeadingManager(mgr, r, &kueue.Workload{}, cfg))
+}
+
+func NewScoringDispatcherReconciler(c client.Client, helper *admissioncheck.MultiKueueStoreHelper, capacityCache *capacity.Cache, cfg *kueueconfig.MultiKueueScoringDispatcher, roleTracker *roletracker.RoleTracker) (*ScoringDispatcherReconciler, error) {
+	r := &ScoringDispatcherReconciler{
+		client:                 c,
+		helper:                 helper,
+		capacityCache:          capacityCache,
+		clock:                  realClock,
+		roundStartTimes:        utilmaps.NewSyncMap[types.NamespacedName, time.Time](0),
+		roleTracker:            roleTracker,
+		freeCapacityWeight:     float64(ptr.Deref(cfg.FreeCapacityWeight, kueueconfig.DefaultMultiKueueFreeCapacityWeight)),
+		pendingWorkloadsWeight: float64(ptr.Deref(cfg.PendingWorkloadsWeight, kueueconfig.DefaultMultiKueuePendingWorkloadsWeight)),
+	}
+	for _, preference := range cfg.ClusterPreferences {
+		selector, err := metav1.LabelSelectorAsSelector(&preference.Selector)
+		if err != nil {
+			return nil, err
+		}
+		r.clusterPreferences = append(r.clusterPreferences, clusterPreference{selector: selector, weight: float64(preference.Weight)})
+	}
+	return r, nil
+}
+
+func (r *ScoringDispatcherReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	wl := &kueue.Workload{}
+	err := r.client.Get(ctx, req.NamespacedName, wl)
+	if err != nil {
+		log.Error(err, "Failed to retrieve Workload, skip the reconciliation")
+		if apierrors.IsNotFound(err) {
+			r.roundStartTimes.Delete(req.NamespacedName)
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !wl.DeletionTimestamp.IsZero() {
+		log.V(3).Info("Workload is deleted, skip the reconciliation")
+		r.roundStartTimes.Delete(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	mkAc, err := admissioncheck.GetMultiKueueAdmissionCheck(ctx, r.client, wl)
+	if err != nil {
+		log.Error(err, "Can not get MultiKueue AdmissionCheckState")
+		return reconcile.Result{}, err
+	}
+
+	if mkAc == nil || mkAc.State != kueue.CheckStatePending {
+		log.V(3).Info("AdmissionCheckState is not in Pending, skip the reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	// The workload is already assigned to a cluster, no need to nominate workers.
+	if wl.Status.ClusterName != nil {
+		log.V(3).Info("The workload is already assigned to a cluster, no need to nominate workers")
+		return reconcile.Result{}, nil
+	}
+
+	remoteClusters, err := admissioncheck.GetRemoteClusters(ctx, r.helper, mkAc.Name)
+	if err != nil {
+		log.Error(err, "Can not get workload group")
+		return reconcile.Result{}, err
+	}
+
+	if workload.IsFinished(wl) || !workload.HasQuotaReservation(wl) {
+		log.V(3).Info("Workload is already finished or has no quota reserved, skip the reconciliation")
+		r.roundStartTimes.Delete(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	remoteClusters, err = admissioncheck.ExcludeCordonedClusters(ctx, r.client, remoteClusters)
+	if err != nil {
+		log.Error(err, "Can not get cordoned worker clusters")
+		return reconcile.Result{}, err
+	}
+
+	remoteClusters, preferredClusters, err := admissioncheck.SelectClusters(ctx, r.client, wl, remoteClusters)
+	if errors.Is(err, admissioncheck.ErrInvalidClusterSelector) {
+		log.V(3).Info("The workload has an invalid cluster selector, skip the reconciliation", "reason", err.Error())
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		log.Error(err, "Can not select worker clusters")
+		return reconcile.Result{}, err
+	}
+
+	log.V(3).Info("Nominate Worker Clusters with Scoring Dispatcher")
+	return r.nominateWorkers(ctx, wl, admissioncheck.ExcludeMigratedFromCluster(wl, remoteClusters), preferredClusters, log)
+}
+
+func (r *ScoringDispatcherReconciler) nominateWorkers(ctx context.Context, wl *kueue.Workload, remoteClusters, preferredClusters sets.Set[string], log logr.Logger) (reconcile.Result, error) {
+	key := client.ObjectKeyFromObject(wl)
+	roundStart, found := r.roundStartTimes.Get(key)
+	now := r.clock.Now()
+	if found && now.Sub(roundStart) <= scoringDispatcherRoundTimeout {
+		remainingWaitTime := scoringDispatcherRoundTimeout - now.Sub(roundStart)
+		log.V(5).Info("Scoring Dispatcher nomination round still in progress", "remainingWaitTime", remainingWaitTime)
+		return reconcile.Result{RequeueAfter: remainingWaitTime}, nil
+	}
+
+	alreadyNominated := sets.New(wl.Status.NominatedClusterNames...)
+	candidates := remoteClusters.Difference(alreadyNominated)
+	if candidates.Len() == 0 {
+		log.Error(ErrNoMoreWorkers, "Failed to nominate next worker clusters")
+		return reconcile.Result{}, ErrNoMoreWorkers
+	}
+
+	ranked, err := r.rankWorkers(ctx, wl, candidates, preferredClusters)
+	if err != nil {
+		log.Error(err, "Failed to rank worker clusters")
+		return reconcile.Result{}, err
+	}
+	nextNominatedWorkers := ranked[:min(len(ranked), scoringDispatcherBatchSize)]
+	log.V(5).Info("nominate the best-ranked worker clusters", "rankedWorkerClusters", ranked, "nominatedWorkerClusters", nextNominatedWorkers)
+
+	nominatedWorkers := append(wl.Status.NominatedClusterNames, nextNominatedWorkers...)
+	if err = workload.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func(wl *kueue.Workload) (bool, error) {
+		wl.Status.NominatedClusterNames = nominatedWorkers
+		return true, nil
+	}); err != nil {
+		log.V(2).Error(err, "Failed to patch nominated clusters")
+		return reconcile.Result{}, err
+	}
+	r.roundStartTimes.Add(key, now)
+
+	return reconcile.Result{}, nil
+}
+
+// rankWorkers returns the worker clusters sorted by their scores, from the
+// highest one, the preferred clusters first. The clusters with equal scores
+// are sorted by name.
+func (r *ScoringDispatcherReconciler) rankWorkers(ctx context.Context, wl *kueue.Workload, workers, preferred sets.Set[string]) ([]string, error) {
+	requests := workload.NewInfo(wl).FlavorResourceUsage().FlattenFlavors()
+	scores := make(map[string]float64, workers.Len())
+	for workerName := range workers {
+		cluster := &kueue.MultiKueueCluster{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: workerName}, cluster); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		cluster.Name = workerName
+		scores[workerName] = r.score(wl, requests, cluster)
+	}
+	ranked := sets.List(workers)
+	slices.SortStableFunc(ranked, func(a, b string) int {
+		if preferred.Has(a) != preferred.Has(b) {
+			if preferred.Has(a) {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(scores[b], scores[a])
+	})
+	return ranked, nil
+}
+
+// score returns the score of the worker cluster for the Workload. The capacity
+// of the worker cluster is only taken into account if it is already cached.
+func (r *ScoringDispatcherReconciler) score(wl *kueue.Workload, requests resources.Requests, cluster *kueue.MultiKueueCluster) float64 {
+	var score float64
+	if worker, found := r.capacityCache.Get(cluster.Name); found {
+		if cq, found := worker.ClusterQueueFor(wl); found {
+			score += r.freeCapacityWeight * fittingFraction(requests, cq.FreeQuota())
+			score += r.pendingWorkloadsWeight / float64(1+cq.PendingWorkloads)
+		}
+	}
+	clusterLabels := labels.Set(cluster.Labels)
+	for _, preference := range r.clusterPreferences {
+		if preference.selector.Matches(clusterLabels) {
+			score += preference.weight
+		}
+	}
+	return score
+}
+
+// fittingFraction returns the fraction of the requests which fit in the free
+// quota, for the most constrained resource.
+func fittingFraction(requests, free resources.Requests) float64 {
+	fraction := 1.0
+	for name, request := range requests {
+		if request <= 0 {
+			continue
+		}
+		fraction = min(fraction, float64(max(free[name], 0))/float64(request))
+	}
+	return fraction
+}
@@ -0,0 +1,236 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/capacity"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestScoringDispatcherNominateWorkers(t *testing.T) {
+	const testName = "test-wl"
+	now := time.Now()
+	fakeClock := testingclock.NewFakeClock(now)
+	baseWl := utiltestingapi.MakeWorkload(testName, metav1.NamespaceDefault).
+		Queue("lq").
+		Request(corev1.ResourceCPU, "4").
+		AdmissionCheck(kueue.AdmissionCheckState{
+			Name:  "ac1",
+			State: kueue.CheckStatePending,
+		})
+
+	makeWorker := func(nominalCPU, reservedCPU int64, pending int32) *capacity.Worker {
+		fr := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+		return &capacity.Worker{
+			ClusterQueues: map[kueue.ClusterQueueReference]*capacity.ClusterQueue{
+				"cq": {
+					NominalQuota:     resources.FlavorResourceQuantities{fr: nominalCPU * 1000},
+					Reservation:      resources.FlavorResourceQuantities{fr: reservedCPU * 1000},
+					PendingWorkloads: pending,
+				},
+			},
+			LocalQueues: map[utilqueue.LocalQueueReference]kueue.ClusterQueueReference{
+				utilqueue.NewLocalQueueReference(metav1.NamespaceDefault, "lq"): "cq",
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		remoteClusters        sets.Set[string]
+		preferredClusters     sets.Set[string]
+		clusters              []kueue.MultiKueueCluster
+		workers               map[string]*capacity.Worker
+		config                configapi.MultiKueueScoringDispatcher
+		workload              *kueue.Workload
+		advanceRoundTime      bool
+		wantErr               error
+		wantNominatedClusters []string
+	}{
+		"no capacity cached, nominate in the order of names": {
+			remoteClusters:        sets.New("A", "B", "C", "D"),
+			workload:              baseWl.Clone().Obj(),
+			wantNominatedClusters: []string{"A", "B", "C"},
+		},
+		"nominate the clusters with the most free capacity first": {
+			remoteClusters: sets.New("A", "B", "C", "D"),
+			workers: map[string]*capacity.Worker{
+				"A": makeWorker(4, 4, 0),
+				"B": makeWorker(8, 6, 0),
+				"C": makeWorker(8, 0, 0),
+				"D": makeWorker(8, 2, 0),
+			},
+			workload:              baseWl.Clone().Obj(),
+			wantNominatedClusters: []string{"C", "D", "B"},
+		},
+		"prefer the clusters with shorter queues when the capacity is equal": {
+			remoteClusters: sets.New("A", "B", "C"),
+			workers: map[string]*capacity.Worker{
+				"A": makeWorker(8, 0, 5),
+				"B": makeWorker(8, 0, 0),
+				"C": makeWorker(8, 0, 1),
+			},
+			workload:              baseWl.Clone().Obj(),
+			wantNominatedClusters: []string{"B", "C", "A"},
+		},
+		"cluster without the LocalQueue is ranked last": {
+			remoteClusters: sets.New("A", "B"),
+			workers: map[string]*capacity.Worker{
+				"A": {},
+				"B": makeWorker(2, 0, 0),
+			},
+			workload:              baseWl.Clone().Obj(),
+			wantNominatedClusters: []string{"B", "A"},
+		},
+		"cluster preference outweighs the free capacity": {
+			remoteClusters: sets.New("A", "B"),
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("A").Label("region", "us-east").Obj(),
+				*utiltestingapi.MakeMultiKueueCluster("B").Label("region", "us-west").Obj(),
+			},
+			workers: map[string]*capacity.Worker{
+				"A": makeWorker(2, 0, 0),
+				"B": makeWorker(8, 0, 0),
+			},
+			config: configapi.MultiKueueScoringDispatcher{
+				ClusterPreferences: []configapi.MultiKueueClusterPreference{{
+					Selector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "us-east"}},
+					Weight:   200,
+				}},
+			},
+			workload:              baseWl.Clone().Obj(),
+			wantNominatedClusters: []string{"A", "B"},
+		},
+		"preferred clusters of the workload are nominated first": {
+			remoteClusters:    sets.New("A", "B", "C", "D"),
+			preferredClusters: sets.New("A", "B"),
+			workers: map[string]*capacity.Worker{
+				"A": makeWorker(8, 6, 0),
+				"B": makeWorker(8, 2, 0),
+				"C": makeWorker(8, 0, 0),
+				"D": makeWorker(8, 4, 0),
+			},
+			workload:              baseWl.Clone().Obj(),
+			wantNominatedClusters: []string{"B", "A", "C"},
+		},
+		"round expired, nominate the best of the remaining clusters": {
+			remoteClusters: sets.New("A", "B", "C", "D", "E"),
+			workers: map[string]*capacity.Worker{
+				"D": makeWorker(8, 8, 0),
+				"E": makeWorker(8, 0, 0),
+			},
+			workload:              baseWl.Clone().NominatedClusterNames("A", "B", "C").Obj(),
+			advanceRoundTime:      true,
+			wantNominatedClusters: []string{"A", "B", "C", "E", "D"},
+		},
+		"round in progress, keep current": {
+			remoteClusters:        sets.New("A", "B", "C", "D"),
+			workload:              baseWl.Clone().NominatedClusterNames("A", "B", "C").Obj(),
+			wantNominatedClusters: []string{"A", "B", "C"},
+		},
+		"all already nominated": {
+			remoteClusters:        sets.New("A", "B"),
+			workload:              baseWl.Clone().NominatedClusterNames("A", "B").Obj(),
+			advanceRoundTime:      true,
+			wantErr:               ErrNoMoreWorkers,
+			wantNominatedClusters: []string{"A", "B"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := kueue.AddToScheme(scheme); err != nil {
+				t.Fatalf("Fail to add to scheme %s", err)
+			}
+
+			objs := []client.Object{tc.workload}
+			for i := range tc.clusters {
+				objs = append(objs, &tc.clusters[i])
+			}
+			cl := fake.NewClientBuilder().WithScheme(scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(ctx context.Context, client client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						tc.workload.Status.NominatedClusterNames = obj.(*kueue.Workload).Status.NominatedClusterNames
+						return utiltesting.TreatSSAAsStrategicMerge(ctx, client, subResourceName, obj, patch, opts...)
+					},
+				}).WithObjects(objs...).WithStatusSubresource(tc.workload).Build()
+
+			capacityCache := capacity.NewCache()
+			for workerName, worker := range tc.workers {
+				capacityCache.Add(workerName, worker)
+			}
+			reconciler, err := NewScoringDispatcherReconciler(cl, nil, capacityCache, &tc.config, nil)
+			if err != nil {
+				t.Fatalf("Failed to create the reconciler: %v", err)
+			}
+			reconciler.clock = fakeClock
+
+			key := types.NamespacedName{Namespace: tc.workload.Namespace, Name: tc.workload.Name}
+			if tc.advanceRoundTime {
+				reconciler.roundStartTimes.Add(key, fakeClock.Now().Add(-scoringDispatcherRoundTimeout-time.Second))
+			} else if tc.workload.Status.NominatedClusterNames != nil {
+				reconciler.roundStartTimes.Add(key, fakeClock.Now())
+			}
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			_, gotErr := reconciler.nominateWorkers(ctx, tc.workload, tc.remoteClusters, tc.preferredClusters, log)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantNominatedClusters, tc.workload.Status.NominatedClusterNames); diff != "" {
+				t.Errorf("Unexpected nominated clusters (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScoringDispatcherDefaultWeights(t *testing.T) {
+	reconciler, err := NewScoringDispatcherReconciler(nil, nil, capacity.NewCache(), &configapi.MultiKueueScoringDispatcher{
+		PendingWorkloadsWeight: ptr.To[int32](0),
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create the reconciler: %v", err)
+	}
+	if reconciler.freeCapacityWeight != configapi.DefaultMultiKueueFreeCapacityWeight {
+		t.Errorf("Unexpected free capacity weight: %v", reconciler.freeCapacityWeight)
+	}
+	if reconciler.pendingWorkloadsWeight != 0 {
+		t.Errorf("Unexpected pending workloads weight: %v", reconciler.pendingWorkloadsWeight)
+	}
+}
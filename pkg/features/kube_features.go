@@ -263,6 +263,88 @@ const (
 	// issue: https://github.com/kubernetes-sigs/kueue/issues/9156
 	// Enables pod labeling with corresponding cluster and local queue names
 	AssignQueueLabelsForPods featuregate.Feature = "AssignQueueLabelsForPods"
+
+	// owner: @doridoridoriand
+	//
+	// Enable proactive handling of planned node drains in TAS. Workloads running on a
+	// cordoned node with a scheduled maintenance are notified and moved to a
+	// replacement node before the maintenance starts.
+	TASProactiveNodeDrain featuregate.Feature = "TASProactiveNodeDrain"
+
+	// owner: @mimowo
+	//
+	// Enable joint TAS placement of the PodSets assigned to different flavors
+	// which share the same Topology, so that all of them land within a common
+	// topology domain at the required level.
+	TASMultiFlavorPlacement featuregate.Feature = "TASMultiFlavorPlacement"
+
+	// owner: @mimowo
+	//
+	// Enable the TAS spreading of the pods of a PodSet across the topology
+	// domains at a given level, requested with the spread annotations.
+	TASSpreadTopology featuregate.Feature = "TASSpreadTopology"
+
+	// owner: @mszadkow
+	//
+	// Enable publishing the quota, usage and stats of the worker clusters in the
+	// status of their MultiKueueClusters and in metrics.
+	MultiKueueClusterStats featuregate.Feature = "MultiKueueClusterStats"
+
+	// owner: @mszadkow
+	//
+	// Enable the migration of MultiKueue Workloads from their worker cluster
+	// to another one, on request, when their pods are not ready in time or
+	// when their worker cluster is drained.
+	MultiKueueWorkloadMigration featuregate.Feature = "MultiKueueWorkloadMigration"
+
+	// owner: @mszadkow
+	//
+	// Enable the enforcement of the quota of the manager ClusterQueues over
+	// the worker clusters, accounting for the quota reserved by the Workloads
+	// which were not dispatched by the manager.
+	MultiKueueQuotaFederation featuregate.Feature = "MultiKueueQuotaFederation"
+
+	// owner: @mszadkow
+	//
+	// Enable the credentials providers of the MultiKueue configuration, minting
+	// the credentials of the worker clusters selecting them by annotation.
+	MultiKueueCredentialsProviders featuregate.Feature = "MultiKueueCredentialsProviders"
+
+	// owner: @mszadkow
+	//
+	// Enable recording events on the manager Workloads with the exit codes,
+	// reasons and last log lines of the containers which failed in the worker cluster.
+	MultiKueueRemoteTerminationEvents featuregate.Feature = "MultiKueueRemoteTerminationEvents"
+
+	// owner: @mszadkow
+	//
+	// Enable the dispatching of MultiKueue Workloads to the worker clusters
+	// selected by their required and preferred cluster selectors.
+	MultiKueueClusterAffinity featuregate.Feature = "MultiKueueClusterAffinity"
+
+	// owner: @mimowo
+	//
+	// Enable the integrations of custom resources declared in the configuration
+	// by the paths of their fields, without a dedicated controller.
+	DeclarativeIntegrations featuregate.Feature = "DeclarativeIntegrations"
+
+	// owner: @mimowo
+	//
+	// Enable the jobs opted in with the checkpoint grace period annotation to
+	// checkpoint before they are stopped on eviction.
+	CheckpointAwareEviction featuregate.Feature = "CheckpointAwareEviction"
+
+	// owner: @mimowo
+	//
+	// Enable the Workloads to declare dependencies on other Workloads which
+	// need to finish before they can be admitted.
+	WorkloadDependencies featuregate.Feature = "WorkloadDependencies"
+
+	// owner: @mimowo
+	//
+	// Enable the bulk Workloads which track many independent tasks, admitted
+	// incrementally as the quota allows.
+	BulkWorkloads featuregate.Feature = "BulkWorkloads"
 )
 
 func init() {
@@ -413,6 +495,45 @@ var defaultVersionedFeatureGates = map[featuregate.Feature]featuregate.Versioned
 	AssignQueueLabelsForPods: {
 		{Version: version.MustParse("0.17"), Default: true, PreRelease: featuregate.Beta},
 	},
+	TASProactiveNodeDrain: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASMultiFlavorPlacement: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASSpreadTopology: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MultiKueueClusterStats: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MultiKueueWorkloadMigration: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MultiKueueQuotaFederation: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MultiKueueCredentialsProviders: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MultiKueueRemoteTerminationEvents: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MultiKueueClusterAffinity: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	DeclarativeIntegrations: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	CheckpointAwareEviction: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadDependencies: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	BulkWorkloads: {
+		{Version: version.MustParse("0.17"), Default: false, PreRelease: featuregate.Alpha},
+	},
 }
 
 func SetFeatureGateDuringTest(tb testing.TB, f featuregate.Feature, value bool) {
@@ -615,6 +615,68 @@ If zero, it means that the usage of the Cohort is below the nominal quota.
 If the Cohort has a weight of zero and is borrowing, this will return NaN.`,
 		}, []string{"cohort", "replica_role"},
 	)
+
+	// Metrics tied to the MultiKueue worker clusters.
+
+	// +metricsdoc:group=multikueue
+	// +metricsdoc:labels=cluster="the name of the MultiKueueCluster",kueue_version="the version of Kueue in the worker cluster",replica_role="one of `leader`, `follower`, or `standalone`"
+	MultiKueueClusterInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "multikueue_cluster_info",
+			Help:      "MultiKueue worker cluster information. 1 labeled by the version of Kueue running in the worker cluster, empty if unknown",
+		}, []string{"cluster", "kueue_version", "replica_role"},
+	)
+
+	// +metricsdoc:group=multikueue
+	// +metricsdoc:labels=cluster="the name of the MultiKueueCluster",replica_role="one of `leader`, `follower`, or `standalone`"
+	MultiKueueClusterClusterQueues = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "multikueue_cluster_cluster_queues",
+			Help:      "The number of ClusterQueues reachable in the MultiKueue worker 'cluster'",
+		}, []string{"cluster", "replica_role"},
+	)
+
+	// +metricsdoc:group=multikueue
+	// +metricsdoc:labels=cluster="the name of the MultiKueueCluster",flavor="the resource flavor name",resource="the resource name",replica_role="one of `leader`, `follower`, or `standalone`"
+	MultiKueueClusterNominalQuota = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "multikueue_cluster_nominal_quota",
+			Help:      "Reports the nominal quota of the ClusterQueues in the MultiKueue worker 'cluster', summed per flavor and resource",
+		}, []string{"cluster", "flavor", "resource", "replica_role"},
+	)
+
+	// +metricsdoc:group=multikueue
+	// +metricsdoc:labels=cluster="the name of the MultiKueueCluster",flavor="the resource flavor name",resource="the resource name",replica_role="one of `leader`, `follower`, or `standalone`"
+	MultiKueueClusterResourceUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "multikueue_cluster_resource_usage",
+			Help:      "Reports the resource usage of the ClusterQueues in the MultiKueue worker 'cluster', summed per flavor and resource",
+		}, []string{"cluster", "flavor", "resource", "replica_role"},
+	)
+
+	// +metricsdoc:group=multikueue
+	// +metricsdoc:labels=cluster="the name of the MultiKueueCluster",replica_role="one of `leader`, `follower`, or `standalone`"
+	MultiKueueClusterMirroredWorkloads = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "multikueue_cluster_mirrored_workloads",
+			Help:      "The number of Workloads created by this manager in the MultiKueue worker 'cluster'",
+		}, []string{"cluster", "replica_role"},
+	)
+
+	// +metricsdoc:group=multikueue
+	// +metricsdoc:labels=cluster="the name of the MultiKueueCluster",replica_role="one of `leader`, `follower`, or `standalone`"
+	MultiKueueClusterSyncLatency = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "multikueue_cluster_sync_latency_seconds",
+			Help:      "The time it took to read the status of the MultiKueue worker 'cluster' during the last successful sync",
+		}, []string{"cluster", "replica_role"},
+	)
 )
 
 func init() {
@@ -908,6 +970,44 @@ func ClearClusterQueueResourceReservations(cqName, flavor, resource string) {
 	ClusterQueueResourceReservations.DeletePartialMatch(lbls)
 }
 
+func ReportMultiKueueClusterInfo(cluster, kueueVersion string, tracker *roletracker.RoleTracker) {
+	MultiKueueClusterInfo.DeletePartialMatch(prometheus.Labels{"cluster": cluster})
+	MultiKueueClusterInfo.WithLabelValues(cluster, kueueVersion, roletracker.GetRole(tracker)).Set(1)
+}
+
+func ReportMultiKueueClusterClusterQueues(cluster string, count int, tracker *roletracker.RoleTracker) {
+	MultiKueueClusterClusterQueues.WithLabelValues(cluster, roletracker.GetRole(tracker)).Set(float64(count))
+}
+
+func ReportMultiKueueClusterResources(cluster, flavor, resource string, nominal, usage float64, tracker *roletracker.RoleTracker) {
+	role := roletracker.GetRole(tracker)
+	MultiKueueClusterNominalQuota.WithLabelValues(cluster, flavor, resource, role).Set(nominal)
+	MultiKueueClusterResourceUsage.WithLabelValues(cluster, flavor, resource, role).Set(usage)
+}
+
+func ReportMultiKueueClusterMirroredWorkloads(cluster string, count int, tracker *roletracker.RoleTracker) {
+	MultiKueueClusterMirroredWorkloads.WithLabelValues(cluster, roletracker.GetRole(tracker)).Set(float64(count))
+}
+
+func ReportMultiKueueClusterSyncLatency(cluster string, latency time.Duration, tracker *roletracker.RoleTracker) {
+	MultiKueueClusterSyncLatency.WithLabelValues(cluster, roletracker.GetRole(tracker)).Set(latency.Seconds())
+}
+
+func ClearMultiKueueClusterResourceMetrics(cluster string) {
+	lbls := prometheus.Labels{"cluster": cluster}
+	MultiKueueClusterNominalQuota.DeletePartialMatch(lbls)
+	MultiKueueClusterResourceUsage.DeletePartialMatch(lbls)
+}
+
+func ClearMultiKueueClusterMetrics(cluster string) {
+	lbls := prometheus.Labels{"cluster": cluster}
+	MultiKueueClusterInfo.DeletePartialMatch(lbls)
+	MultiKueueClusterClusterQueues.DeletePartialMatch(lbls)
+	MultiKueueClusterMirroredWorkloads.DeletePartialMatch(lbls)
+	MultiKueueClusterSyncLatency.DeletePartialMatch(lbls)
+	ClearMultiKueueClusterResourceMetrics(cluster)
+}
+
 func Register() {
 	metrics.Registry.MustRegister(
 		buildInfo,
@@ -938,6 +1038,12 @@ func Register() {
 		ClusterQueueResourceLendingLimit,
 		ClusterQueueWeightedShare,
 		CohortWeightedShare,
+		MultiKueueClusterInfo,
+		MultiKueueClusterClusterQueues,
+		MultiKueueClusterNominalQuota,
+		MultiKueueClusterResourceUsage,
+		MultiKueueClusterMirroredWorkloads,
+		MultiKueueClusterSyncLatency,
 	)
 	if features.Enabled(features.LocalQueueMetrics) {
 		RegisterLQMetrics()
@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/prometheus/client_golang/prometheus"
@@ -147,6 +148,37 @@ func TestReportAndCleanupClusterQueueUsage(t *testing.T) {
 	expectFilteredMetricsCount(t, ClusterQueueResourceUsage, 0, "cluster_queue", "queue", "flavor", "flavor", "resource", "res2")
 }
 
+func TestReportAndCleanupMultiKueueClusterMetrics(t *testing.T) {
+	ReportMultiKueueClusterInfo("worker", "v0.16.0", nil)
+	ReportMultiKueueClusterInfo("worker", "v0.17.0", nil)
+	ReportMultiKueueClusterClusterQueues("worker", 2, nil)
+	ReportMultiKueueClusterResources("worker", "flavor", "res", 5, 3, nil)
+	ReportMultiKueueClusterResources("worker", "flavor2", "res", 1, 0, nil)
+	ReportMultiKueueClusterMirroredWorkloads("worker", 4, nil)
+	ReportMultiKueueClusterSyncLatency("worker", time.Second, nil)
+
+	expectFilteredMetricsCount(t, MultiKueueClusterInfo, 1, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterInfo, 1, "cluster", "worker", "kueue_version", "v0.17.0")
+	expectFilteredMetricsCount(t, MultiKueueClusterClusterQueues, 1, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterNominalQuota, 2, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterResourceUsage, 2, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterMirroredWorkloads, 1, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterSyncLatency, 1, "cluster", "worker")
+
+	ClearMultiKueueClusterResourceMetrics("worker")
+
+	expectFilteredMetricsCount(t, MultiKueueClusterNominalQuota, 0, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterResourceUsage, 0, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterMirroredWorkloads, 1, "cluster", "worker")
+
+	ClearMultiKueueClusterMetrics("worker")
+
+	expectFilteredMetricsCount(t, MultiKueueClusterInfo, 0, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterClusterQueues, 0, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterMirroredWorkloads, 0, "cluster", "worker")
+	expectFilteredMetricsCount(t, MultiKueueClusterSyncLatency, 0, "cluster", "worker")
+}
+
 func TestReportAndCleanupClusterQueueEvictedNumber(t *testing.T) {
 	ReportEvictedWorkloads("cluster_queue1", "Preempted", "", "", nil)
 	ReportEvictedWorkloads("cluster_queue1", "Evicted", "", "", nil)
@@ -66,7 +66,8 @@ func FromAssignment(ctx context.Context, client client.Client, assignment *kueue
 		// For implicit TAS we inject the "unconstrained" topology by default, even if unspecified.
 		if podSet.TopologyRequest == nil || (podSet.TopologyRequest.Preferred == nil &&
 			podSet.TopologyRequest.Required == nil &&
-			podSet.TopologyRequest.Unconstrained == nil) {
+			podSet.TopologyRequest.Unconstrained == nil &&
+			podSet.TopologyRequest.SpreadTopology == nil) {
 			info.Annotations[kueue.PodSetUnconstrainedTopologyAnnotation] = "true"
 		}
 		info.SchedulingGates = append(info.SchedulingGates, corev1.PodSchedulingGate{
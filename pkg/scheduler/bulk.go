@@ -0,0 +1,130 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// bulkGrowthAssignment returns the assignment extending the admission of the
+// bulk workload to as many additional tasks as fit in the unused nominal quota
+// of the ClusterQueue. The additional tasks use the flavors already assigned
+// to the workload, and never borrow nor preempt, so that they don't delay the
+// pending workloads.
+func bulkGrowthAssignment(wl *workload.Info, cq *schdcache.ClusterQueueSnapshot) flavorassigner.Assignment {
+	psa := &wl.Obj.Status.Admission.PodSetAssignments[0]
+	admitted := workload.AdmittedTasks(wl.Obj)
+	singlePodRequests := resources.NewRequests(psa.ResourceUsage).ScaledDown(int64(admitted))
+
+	usageFor := func(tasks int32) workload.Usage {
+		usage := workload.Usage{Quota: make(resources.FlavorResourceQuantities, len(psa.Flavors))}
+		for res, flv := range psa.Flavors {
+			if q := singlePodRequests[res]; q > 0 {
+				usage.Quota[resources.FlavorResource{Flavor: flv, Resource: res}] = q * int64(tasks)
+			}
+		}
+		return usage
+	}
+	fits := func(tasks int32) bool {
+		usage := usageFor(tasks)
+		for fr, q := range usage.Quota {
+			if cq.BorrowingWith(fr, q) {
+				return false
+			}
+		}
+		return cq.Fits(usage)
+	}
+
+	maxAdditional := int(workload.MaxAdmittedTasks(wl.Obj) - admitted)
+	additional := int32(sort.Search(maxAdditional, func(i int) bool { return !fits(int32(i) + 1) }))
+	if additional == 0 {
+		return flavorassigner.Assignment{
+			PodSets: []flavorassigner.PodSetAssignment{{
+				Name:   psa.Name,
+				Status: *flavorassigner.NewStatus("insufficient unused quota to admit more tasks"),
+			}},
+		}
+	}
+
+	flavors := make(flavorassigner.ResourceAssignment, len(psa.Flavors))
+	for res, flv := range psa.Flavors {
+		flavors[res] = &flavorassigner.FlavorAssignment{Name: flv, Mode: flavorassigner.Fit}
+	}
+	count := admitted + additional
+	return flavorassigner.Assignment{
+		PodSets: []flavorassigner.PodSetAssignment{{
+			Name:     psa.Name,
+			Flavors:  flavors,
+			Requests: singlePodRequests.ScaledUp(int64(count)).ToResourceList(),
+			Count:    count,
+		}},
+		Usage: usageFor(additional),
+	}
+}
+
+// admitBulkTasks extends the admission of the bulk workload of the entry to
+// the additional tasks, and asynchronously updates the object in the apiserver
+// after assuming the new usage in the cache.
+func (s *Scheduler) admitBulkTasks(ctx context.Context, e *entry) error {
+	log := ctrl.LoggerFrom(ctx)
+	admission := e.Obj.Status.Admission.DeepCopy()
+	admission.PodSetAssignments = e.assignment.ToAPI()
+	admittedTasks := e.assignment.PodSets[0].Count
+
+	cacheWl := e.Obj.DeepCopy()
+	cacheWl.Status.Admission = admission
+	if added := s.cache.AddOrUpdateWorkload(log, cacheWl); !added {
+		return fmt.Errorf("workload %s/%s could not be updated in the cache", cacheWl.Namespace, cacheWl.Name)
+	}
+	e.status = assumed
+	log.V(2).Info("Additional tasks of the workload assumed in the cache", "admittedTasks", admittedTasks)
+
+	newWorkload := e.Obj.DeepCopy()
+	s.admissionRoutineWrapper.Run(func() {
+		err := workload.PatchAdmissionStatus(ctx, s.client, newWorkload, s.clock, func(wl *kueue.Workload) (bool, error) {
+			wl.Status.Admission = admission
+			return true, nil
+		}, workload.WithLooseOnApply(), workload.WithRetryOnConflictForPatch())
+		if err == nil {
+			log.V(2).Info("Additional tasks of the workload admitted", "admittedTasks", admittedTasks)
+			s.recorder.Eventf(newWorkload, corev1.EventTypeNormal, "TasksAdmitted", "Admitted to run %d tasks at once", admittedTasks)
+			return
+		}
+		// Restore the previous admission in the cache, ignoring the errors
+		// because the workload or clusterQueue could have been deleted.
+		s.cache.AddOrUpdateWorkload(log, e.Obj)
+		if apierrors.IsNotFound(err) {
+			log.V(2).Info("Additional tasks not admitted because the workload was deleted")
+			return
+		}
+		log.Error(err, "Could not admit additional tasks of the workload in apiserver")
+		s.requeueAndUpdate(ctx, *e)
+	})
+	return nil
+}
@@ -51,6 +51,11 @@ func (a *Assignment) WorkloadsTopologyRequests(wl *workload.Info, cq *schdcache.
 			}
 			isTASImplied := isTASImplied(&podSet, cq)
 
+			if podSet.TopologyRequest != nil && podSet.TopologyRequest.SpreadTopology != nil && !features.Enabled(features.TASSpreadTopology) {
+				psAssignment.error(errors.New("spread topology requires the TASSpreadTopology feature gate"))
+				continue
+			}
+
 			// Only unconstrained topology is supported with elastic workload slices.
 			var previousAssignment *kueue.TopologyAssignment
 			if features.Enabled(features.ElasticJobsViaWorkloadSlicesWithTAS) {
@@ -62,6 +67,10 @@ func (a *Assignment) WorkloadsTopologyRequests(wl *workload.Info, cq *schdcache.
 					psAssignment.error(errors.New("preferred topology is not supported with ElasticJobsViaWorkloadSlices"))
 					continue
 				}
+				if podSet.TopologyRequest != nil && podSet.TopologyRequest.SpreadTopology != nil {
+					psAssignment.error(errors.New("spread topology is not supported with ElasticJobsViaWorkloadSlices"))
+					continue
+				}
 				previousAssignment = getPreviousTopologyAssignment(a.replaceWorkloadSlice, podSet.Name)
 			}
 
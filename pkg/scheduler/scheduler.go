@@ -247,6 +247,12 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 		ctx := ctrl.LoggerInto(ctx, log)
 		log.V(2).Info("Attempting to schedule workload")
 
+		if workload.NeedsBulkGrowth(e.Obj) {
+			// The unused quota could have been taken by the workloads
+			// admitted earlier in this cycle.
+			e.assignment = bulkGrowthAssignment(&e.Info, cq)
+		}
+
 		mode := e.assignment.RepresentativeMode()
 
 		if features.Enabled(features.TASFailedNodeReplacementFailFast) && workload.HasTopologyAssignmentWithUnhealthyNode(e.Obj) && mode != flavorassigner.Fit {
@@ -299,6 +305,14 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 		preemptedWorkloads.Insert(e.preemptionTargets)
 		cq.AddUsage(usage)
 
+		if workload.NeedsBulkGrowth(e.Obj) {
+			e.status = nominated
+			if err := s.admitBulkTasks(ctx, e); err != nil {
+				e.inadmissibleMsg = fmt.Sprintf("Failed to admit more tasks: %v", err)
+			}
+			continue
+		}
+
 		// Filter out the old workload slice from the preemption targets.
 		// The old workload slice is initially included in the preemption targets because it is treated
 		// as a preemptible target during flavor assignment. However, it should be evicted rather than preempted.
@@ -447,6 +461,8 @@ func (s *Scheduler) nominate(ctx context.Context, workloads []workload.Info, sna
 		} else if !e.clusterQueueSnapshot.NamespaceSelector.Matches(labels.Set(ns.Labels)) {
 			e.inadmissibleMsg = "Workload namespace doesn't match ClusterQueue selector"
 			e.requeueReason = qcache.RequeueReasonNamespaceMismatch
+		} else if workload.IsBulk(w.Obj) && (len(e.clusterQueueSnapshot.AdmissionChecks) > 0 || len(e.clusterQueueSnapshot.TASFlavors) > 0) {
+			e.inadmissibleMsg = fmt.Sprintf("Bulk workloads are not supported by ClusterQueue %s, which uses admission checks or Topology-Aware Scheduling", w.ClusterQueue)
 		} else if err := workload.ValidateResources(&w); err != nil {
 			e.inadmissibleMsg = fmt.Sprintf("%s: %v", errInvalidWLResources, err.ToAggregate())
 		} else if err := workload.ValidateLimitRange(ctx, s.client, &w); err != nil {
@@ -484,7 +500,7 @@ func netUsage(e *entry, netQuota resources.FlavorResourceQuantities) workload.Us
 	if features.Enabled(features.TopologyAwareScheduling) {
 		result.TAS = e.assignment.ComputeTASNetUsage(e.Obj.Status.Admission)
 	}
-	if !workload.HasQuotaReservation(e.Obj) {
+	if !workload.HasQuotaReservation(e.Obj) || workload.NeedsBulkGrowth(e.Obj) {
 		result.Quota = netQuota
 	}
 	return result
@@ -516,6 +532,9 @@ type partialAssignment struct {
 }
 
 func (s *Scheduler) getAssignments(log logr.Logger, wl *workload.Info, snap *schdcache.Snapshot) (flavorassigner.Assignment, []*preemption.Target) {
+	if workload.NeedsBulkGrowth(wl.Obj) {
+		return bulkGrowthAssignment(wl, snap.ClusterQueue(wl.ClusterQueue)), nil
+	}
 	assignment, targets := s.getInitialAssignments(log, wl, snap)
 	cq := snap.ClusterQueue(wl.ClusterQueue)
 	updateAssignmentForTAS(snap, cq, wl, &assignment, targets)
@@ -535,8 +554,9 @@ func (s *Scheduler) getAssignments(log logr.Logger, wl *workload.Info, snap *sch
 //     - preemption (if needed and possible).
 //  3. If direct assignment isn't possible but preemption is enabled and viable, it includes any additional
 //     preemption targets obtained through the configured preemptor.
-//  4. If partial admission is enabled and the workload allows it, the function attempts to reduce pod counts
-//     across PodSets to find an assignable configuration—again checking for preemption if needed.
+//  4. If partial admission is enabled and the workload allows it, or the workload is a bulk workload,
+//     the function attempts to reduce pod counts across PodSets to find an assignable configuration—again
+//     checking for preemption if needed.
 //
 // Returns:
 //   - A flavorassigner.Assignment representing the selected (possibly reduced) flavor allocation.
@@ -564,8 +584,8 @@ func (s *Scheduler) getInitialAssignments(log logr.Logger, wl *workload.Info, sn
 		}
 	}
 
-	if features.Enabled(features.PartialAdmission) && wl.CanBePartiallyAdmitted() {
-		reducer := flavorassigner.NewPodSetReducer(wl.Obj.Spec.PodSets, func(nextCounts []int32) (*partialAssignment, bool) {
+	if (features.Enabled(features.PartialAdmission) || workload.IsBulk(wl.Obj)) && wl.CanBePartiallyAdmitted() {
+		reducer := flavorassigner.NewPodSetReducer(workload.PodSetsForPartialAdmission(wl.Obj), func(nextCounts []int32) (*partialAssignment, bool) {
 			assignment := flvAssigner.Assign(log, nextCounts)
 			mode := assignment.RepresentativeMode()
 			if mode == flavorassigner.Fit {
@@ -722,6 +742,14 @@ func (e entryOrdering) Less(i, j int) bool {
 	a := e.entries[i]
 	b := e.entries[j]
 
+	// Process the bulk workloads which can admit more tasks last, so that they
+	// only take the quota left by the other workloads.
+	aGrows := workload.NeedsBulkGrowth(a.Obj)
+	bGrows := workload.NeedsBulkGrowth(b.Obj)
+	if aGrows != bGrows {
+		return bGrows
+	}
+
 	// First process workloads which already have quota reserved. Such workload
 	// may be considered if this is their second pass.
 	aHasQuota := workload.HasQuotaReservation(a.Obj)
@@ -804,7 +832,11 @@ func (s *Scheduler) requeueAndUpdate(ctx context.Context, e entry) {
 
 	if s.queues.QueueSecondPassIfNeeded(ctx, e.Obj, e.SecondPassIteration) {
 		log.V(2).Info("Workload re-queued for second pass", "workload", klog.KObj(e.Obj), "clusterQueue", klog.KRef("", string(e.ClusterQueue)), "queue", klog.KRef(e.Obj.Namespace, string(e.Obj.Spec.QueueName)), "requeueReason", e.requeueReason, "status", e.status)
-		s.recorder.Eventf(e.Obj, corev1.EventTypeWarning, "SecondPassFailed", api.TruncateEventMessage(e.inadmissibleMsg))
+		// The bulk workloads keep running the admitted tasks while waiting
+		// for the quota for more tasks, which is not a failure.
+		if !workload.NeedsBulkGrowth(e.Obj) {
+			s.recorder.Eventf(e.Obj, corev1.EventTypeWarning, "SecondPassFailed", api.TruncateEventMessage(e.inadmissibleMsg))
+		}
 		return
 	}
 
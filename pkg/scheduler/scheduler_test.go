@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -10084,3 +10085,283 @@ func TestSchedulerWhenWorkloadModifiedConcurrently(t *testing.T) {
 		}
 	}
 }
+
+func TestScheduleBulkWorkloads(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	resourceFlavors := []*kueue.ResourceFlavor{
+		utiltestingapi.MakeResourceFlavor("default").Obj(),
+		utiltestingapi.MakeResourceFlavor("spot").Obj(),
+	}
+	clusterQueue := utiltestingapi.MakeClusterQueue("cq").
+		Cohort("cohort").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10", "10").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "10").Obj(),
+		).
+		Obj()
+	lenderQueue := utiltestingapi.MakeClusterQueue("lender").
+		Cohort("cohort").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Obj()
+	localQueue := utiltestingapi.MakeLocalQueue("main", "default").ClusterQueue("cq").Obj()
+	bulkWorkload := func(admittedTasks int32, usage string) *utiltestingapi.WorkloadWrapper {
+		return utiltestingapi.MakeWorkload("bulk", "default").
+			Queue("main").
+			PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 8).Request(corev1.ResourceCPU, "1").Obj()).
+			Tasks(20).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", usage).
+					Count(admittedTasks).
+					Obj()).
+				Obj(), now).
+			AdmittedAt(true, now)
+	}
+	otherWorkload := utiltestingapi.MakeWorkload("other", "default").
+		Queue("main").
+		Request(corev1.ResourceCPU, "3").
+		ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").
+			PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+				Assignment(corev1.ResourceCPU, "default", "3").
+				Obj()).
+			Obj(), now).
+		AdmittedAt(true, now).
+		Obj()
+
+	cases := map[string]struct {
+		workloads       []kueue.Workload
+		wantAssignments map[workload.Reference]kueue.Admission
+		wantEvents      []utiltesting.EventRecord
+	}{
+		"admits the additional tasks within the unused nominal quota": {
+			workloads: []kueue.Workload{*bulkWorkload(2, "2").Obj(), *otherWorkload},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"default/bulk": *utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "7").
+						Count(7).
+						Obj()).
+					Obj(),
+				"default/other": *otherWorkload.Status.Admission,
+			},
+			wantEvents: []utiltesting.EventRecord{
+				utiltesting.MakeEventRecord("default", "bulk", "TasksAdmitted", corev1.EventTypeNormal).
+					Message("Admitted to run 7 tasks at once").
+					Obj(),
+			},
+		},
+		"admits the remaining tasks": {
+			workloads: []kueue.Workload{
+				*bulkWorkload(2, "2").TasksStatus(kueue.WorkloadTasksStatus{Admitted: 2, Active: 2, Succeeded: 16}).Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"default/bulk": *utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "4").
+						Count(4).
+						Obj()).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				utiltesting.MakeEventRecord("default", "bulk", "TasksAdmitted", corev1.EventTypeNormal).
+					Message("Admitted to run 4 tasks at once").
+					Obj(),
+			},
+		},
+		"doesn't borrow to admit more tasks": {
+			workloads: []kueue.Workload{
+				*bulkWorkload(2, "2").Obj(),
+				*utiltestingapi.MakeWorkload("other", "default").
+					Queue("main").
+					Request(corev1.ResourceCPU, "8").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment(corev1.ResourceCPU, "default", "8").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"default/bulk": *bulkWorkload(2, "2").Obj().Status.Admission,
+				"default/other": *utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "8").
+						Obj()).
+					Obj(),
+			},
+		},
+		"admits the pending workload before the additional tasks": {
+			workloads: []kueue.Workload{
+				*bulkWorkload(2, "2").Obj(),
+				*otherWorkload,
+				*utiltestingapi.MakeWorkload("pending", "default").
+					Queue("main").
+					Request(corev1.ResourceCPU, "4").
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"default/bulk": *utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "3").
+						Count(3).
+						Obj()).
+					Obj(),
+				"default/other": *otherWorkload.Status.Admission,
+				"default/pending": *utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "4").
+						Obj()).
+					Obj(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.BulkWorkloads, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithLists(&kueue.WorkloadList{Items: tc.workloads}, &kueue.LocalQueueList{Items: []kueue.LocalQueue{*localQueue}}).
+				WithObjects(utiltesting.MakeNamespace("default")).
+				WithStatusSubresource(&kueue.Workload{}).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			recorder := &utiltesting.EventRecorder{}
+			cqCache := schdcache.New(cl)
+			fakeClock := testingclock.NewFakeClock(now)
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache, qcache.WithClock(fakeClock))
+			for i := range resourceFlavors {
+				cqCache.AddOrUpdateResourceFlavor(log, resourceFlavors[i])
+			}
+			for _, cq := range []*kueue.ClusterQueue{clusterQueue, lenderQueue} {
+				if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+				}
+				if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+				}
+			}
+			if err := qManager.AddLocalQueue(ctx, localQueue); err != nil {
+				t.Fatalf("Inserting queue %s/%s in manager: %v", localQueue.Namespace, localQueue.Name, err)
+			}
+			for _, w := range tc.workloads {
+				if qManager.QueueSecondPassIfNeeded(ctx, &w, 0) {
+					fakeClock.Step(time.Second)
+				}
+			}
+
+			scheduler := New(qManager, cqCache, cl, recorder, WithClock(t, fakeClock))
+			wg := sync.WaitGroup{}
+			scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+				func() { wg.Add(1) },
+				func() { wg.Done() },
+			))
+
+			ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+			go qManager.CleanUpOnContext(ctx)
+			defer cancel()
+
+			scheduler.schedule(ctx)
+			wg.Wait()
+
+			gotAssignments := make(map[workload.Reference]kueue.Admission)
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			for _, c := range snapshot.ClusterQueues() {
+				for name, w := range c.Workloads {
+					gotAssignments[name] = *w.Obj.Status.Admission
+				}
+			}
+			if diff := cmp.Diff(tc.wantAssignments, gotAssignments); diff != "" {
+				t.Errorf("Unexpected assigned clusterQueues in cache (-want,+got):\n%s", diff)
+			}
+
+			gotWorkload := &kueue.Workload{}
+			if err := cl.Get(ctx, client.ObjectKey{Namespace: "default", Name: "bulk"}, gotWorkload); err != nil {
+				t.Fatalf("Unexpected get workload error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantAssignments["default/bulk"], *gotWorkload.Status.Admission); diff != "" {
+				t.Errorf("Unexpected admission of the bulk workload (-want,+got):\n%s", diff)
+			}
+
+			if len(tc.wantEvents) > 0 {
+				gotEvents := slices.DeleteFunc(recorder.RecordedEvents, func(e utiltesting.EventRecord) bool {
+					return e.Reason != "TasksAdmitted"
+				})
+				if diff := cmp.Diff(tc.wantEvents, gotEvents); diff != "" {
+					t.Errorf("unexpected events (-want/+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleBulkWorkloadsUnsupportedClusterQueue(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	clusterQueue := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		AdmissionChecks("check").
+		Obj()
+	localQueue := utiltestingapi.MakeLocalQueue("main", "default").ClusterQueue("cq").Obj()
+	bulkWorkload := utiltestingapi.MakeWorkload("bulk", "default").
+		Queue("main").
+		PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 8).Request(corev1.ResourceCPU, "1").Obj()).
+		Tasks(20).
+		Obj()
+
+	features.SetFeatureGateDuringTest(t, features.BulkWorkloads, true)
+	ctx, log := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().
+		WithLists(&kueue.WorkloadList{Items: []kueue.Workload{*bulkWorkload}}, &kueue.LocalQueueList{Items: []kueue.LocalQueue{*localQueue}}).
+		WithObjects(utiltesting.MakeNamespace("default")).
+		WithStatusSubresource(&kueue.Workload{}).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+		Build()
+	recorder := &utiltesting.EventRecorder{}
+	cqCache := schdcache.New(cl)
+	fakeClock := testingclock.NewFakeClock(now)
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache, qcache.WithClock(fakeClock))
+	cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	cqCache.AddOrUpdateAdmissionCheck(log, utiltestingapi.MakeAdmissionCheck("check").Active(metav1.ConditionTrue).Obj())
+	if err := cqCache.AddClusterQueue(ctx, clusterQueue); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in cache: %v", clusterQueue.Name, err)
+	}
+	if err := qManager.AddClusterQueue(ctx, clusterQueue); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in manager: %v", clusterQueue.Name, err)
+	}
+	if err := qManager.AddLocalQueue(ctx, localQueue); err != nil {
+		t.Fatalf("Inserting queue %s/%s in manager: %v", localQueue.Namespace, localQueue.Name, err)
+	}
+
+	scheduler := New(qManager, cqCache, cl, recorder, WithClock(t, fakeClock))
+	wg := sync.WaitGroup{}
+	scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+
+	ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+	go qManager.CleanUpOnContext(ctx)
+	defer cancel()
+
+	scheduler.schedule(ctx)
+	wg.Wait()
+
+	gotWorkload := &kueue.Workload{}
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(bulkWorkload), gotWorkload); err != nil {
+		t.Fatalf("Unexpected get workload error: %v", err)
+	}
+	if gotWorkload.Status.Admission != nil {
+		t.Errorf("Unexpected admission of the bulk workload: %v", gotWorkload.Status.Admission)
+	}
+	wantEvents := []utiltesting.EventRecord{
+		utiltesting.MakeEventRecord("default", "bulk", "Pending", corev1.EventTypeWarning).
+			Message("Bulk workloads are not supported by ClusterQueue cq, which uses admission checks or Topology-Aware Scheduling").
+			Obj(),
+	}
+	if diff := cmp.Diff(wantEvents, recorder.RecordedEvents); diff != "" {
+		t.Errorf("unexpected events (-want/+got):\n%s", diff)
+	}
+}
@@ -22,14 +22,18 @@ import (
 	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 var (
@@ -244,3 +248,102 @@ func GetRemoteClusters(ctx context.Context, helper *MultiKueueStoreHelper, acNam
 
 	return sets.New(cfg.Spec.Clusters...), nil
 }
+
+// ExcludeMigratedFromCluster returns the remote clusters without the one the
+// Workload was last migrated from, unless it is the only remote cluster.
+func ExcludeMigratedFromCluster(wl *kueue.Workload, remoteClusters sets.Set[string]) sets.Set[string] {
+	migratedFrom, found := wl.Annotations[kueue.MultiKueueMigratedFromAnnotation]
+	if !found || !remoteClusters.Has(migratedFrom) || remoteClusters.Len() == 1 {
+		return remoteClusters
+	}
+	return remoteClusters.Clone().Delete(migratedFrom)
+}
+
+// CordonPolicies returns the cordon policies of the remote clusters which are
+// cordoned or drained. Missing MultiKueueClusters are not reported.
+func CordonPolicies(ctx context.Context, c client.Client, remoteClusters sets.Set[string]) (map[string]kueue.MultiKueueClusterCordonPolicy, error) {
+	policies := make(map[string]kueue.MultiKueueClusterCordonPolicy)
+	for clusterName := range remoteClusters {
+		cluster := &kueue.MultiKueueCluster{}
+		if err := c.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if policy := ptr.Deref(cluster.Spec.CordonPolicy, kueue.MultiKueueClusterCordonPolicyNone); policy != kueue.MultiKueueClusterCordonPolicyNone {
+			policies[clusterName] = policy
+		}
+	}
+	return policies, nil
+}
+
+// ExcludeCordonedClusters returns the remote clusters which are neither cordoned
+// nor drained.
+func ExcludeCordonedClusters(ctx context.Context, c client.Client, remoteClusters sets.Set[string]) (sets.Set[string], error) {
+	policies, err := CordonPolicies(ctx, c, remoteClusters)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return remoteClusters, nil
+	}
+	return remoteClusters.Difference(sets.KeySet(policies)), nil
+}
+
+// ErrInvalidClusterSelector is returned when a cluster selector annotation of
+// the Workload cannot be parsed.
+var ErrInvalidClusterSelector = errors.New("invalid cluster selector")
+
+// ClusterSelectors returns the required and preferred cluster selectors of the
+// Workload, nil if not set.
+func ClusterSelectors(wl *kueue.Workload) (required, preferred labels.Selector, err error) {
+	if value, found := wl.Annotations[kueue.MultiKueueRequiredClusterSelectorAnnotation]; found {
+		if required, err = labels.Parse(value); err != nil {
+			return nil, nil, fmt.Errorf("%w in the %s annotation: %w", ErrInvalidClusterSelector, kueue.MultiKueueRequiredClusterSelectorAnnotation, err)
+		}
+	}
+	if value, found := wl.Annotations[kueue.MultiKueuePreferredClusterSelectorAnnotation]; found {
+		if preferred, err = labels.Parse(value); err != nil {
+			return nil, nil, fmt.Errorf("%w in the %s annotation: %w", ErrInvalidClusterSelector, kueue.MultiKueuePreferredClusterSelectorAnnotation, err)
+		}
+	}
+	return required, preferred, nil
+}
+
+// SelectClusters returns the remote clusters whose labels match the required
+// cluster selector of the Workload, and the ones among them which match its
+// preferred cluster selector. The remote clusters are returned unchanged if the
+// MultiKueueClusterAffinity feature gate is disabled or the Workload has no
+// cluster selectors. Missing MultiKueueClusters only match if there is no
+// required cluster selector.
+func SelectClusters(ctx context.Context, c client.Client, wl *kueue.Workload, remoteClusters sets.Set[string]) (selected, preferred sets.Set[string], err error) {
+	if !features.Enabled(features.MultiKueueClusterAffinity) {
+		return remoteClusters, nil, nil
+	}
+	required, preferredSelector, err := ClusterSelectors(wl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if required == nil && preferredSelector == nil {
+		return remoteClusters, nil, nil
+	}
+
+	selected = sets.New[string]()
+	preferred = sets.New[string]()
+	for clusterName := range remoteClusters {
+		cluster := &kueue.MultiKueueCluster{}
+		if err := c.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); client.IgnoreNotFound(err) != nil {
+			return nil, nil, err
+		}
+		clusterLabels := labels.Set(cluster.Labels)
+		if required != nil && !required.Matches(clusterLabels) {
+			continue
+		}
+		selected.Insert(clusterName)
+		if preferredSelector != nil && preferredSelector.Matches(clusterLabels) {
+			preferred.Insert(clusterName)
+		}
+	}
+	return selected, preferred, nil
+}
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 )
@@ -359,3 +360,179 @@ func TestGetRemoteClusters(t *testing.T) {
 		})
 	}
 }
+
+func TestExcludeMigratedFromCluster(t *testing.T) {
+	cases := map[string]struct {
+		workload       *kueue.Workload
+		remoteClusters sets.Set[string]
+		want           sets.Set[string]
+	}{
+		"not migrated": {
+			workload:       utiltestingapi.MakeWorkload("wl", "ns").Obj(),
+			remoteClusters: sets.New("cluster1", "cluster2"),
+			want:           sets.New("cluster1", "cluster2"),
+		},
+		"migrated from one of the clusters": {
+			workload:       utiltestingapi.MakeWorkload("wl", "ns").Annotation(kueue.MultiKueueMigratedFromAnnotation, "cluster1").Obj(),
+			remoteClusters: sets.New("cluster1", "cluster2"),
+			want:           sets.New("cluster2"),
+		},
+		"migrated from the only cluster": {
+			workload:       utiltestingapi.MakeWorkload("wl", "ns").Annotation(kueue.MultiKueueMigratedFromAnnotation, "cluster1").Obj(),
+			remoteClusters: sets.New("cluster1"),
+			want:           sets.New("cluster1"),
+		},
+		"migrated from an unknown cluster": {
+			workload:       utiltestingapi.MakeWorkload("wl", "ns").Annotation(kueue.MultiKueueMigratedFromAnnotation, "cluster3").Obj(),
+			remoteClusters: sets.New("cluster1", "cluster2"),
+			want:           sets.New("cluster1", "cluster2"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ExcludeMigratedFromCluster(tc.workload, tc.remoteClusters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected result (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExcludeCordonedClusters(t *testing.T) {
+	cases := map[string]struct {
+		clusters       []kueue.MultiKueueCluster
+		remoteClusters sets.Set[string]
+		wantPolicies   map[string]kueue.MultiKueueClusterCordonPolicy
+		want           sets.Set[string]
+	}{
+		"no cordoned clusters": {
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("cluster1").Obj(),
+				*utiltestingapi.MakeMultiKueueCluster("cluster2").CordonPolicy(kueue.MultiKueueClusterCordonPolicyNone).Obj(),
+			},
+			remoteClusters: sets.New("cluster1", "cluster2"),
+			wantPolicies:   map[string]kueue.MultiKueueClusterCordonPolicy{},
+			want:           sets.New("cluster1", "cluster2"),
+		},
+		"cordoned and drained clusters": {
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("cluster1").CordonPolicy(kueue.MultiKueueClusterCordonPolicyCordon).Obj(),
+				*utiltestingapi.MakeMultiKueueCluster("cluster2").CordonPolicy(kueue.MultiKueueClusterCordonPolicyDrain).Obj(),
+				*utiltestingapi.MakeMultiKueueCluster("cluster3").Obj(),
+			},
+			remoteClusters: sets.New("cluster1", "cluster2", "cluster3"),
+			wantPolicies: map[string]kueue.MultiKueueClusterCordonPolicy{
+				"cluster1": kueue.MultiKueueClusterCordonPolicyCordon,
+				"cluster2": kueue.MultiKueueClusterCordonPolicyDrain,
+			},
+			want: sets.New("cluster3"),
+		},
+		"missing cluster": {
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("cluster1").CordonPolicy(kueue.MultiKueueClusterCordonPolicyCordon).Obj(),
+			},
+			remoteClusters: sets.New("cluster1", "cluster2"),
+			wantPolicies: map[string]kueue.MultiKueueClusterCordonPolicy{
+				"cluster1": kueue.MultiKueueClusterCordonPolicyCordon,
+			},
+			want: sets.New("cluster2"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := utiltesting.NewClientBuilder().WithLists(&kueue.MultiKueueClusterList{Items: tc.clusters}).Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			gotPolicies, err := CordonPolicies(ctx, c, tc.remoteClusters)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPolicies, gotPolicies); diff != "" {
+				t.Errorf("unexpected policies (-want/+got):\n%s", diff)
+			}
+
+			got, err := ExcludeCordonedClusters(ctx, c, tc.remoteClusters)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected result (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectClusters(t *testing.T) {
+	clusters := []kueue.MultiKueueCluster{
+		*utiltestingapi.MakeMultiKueueCluster("us-east1").Label("region", "us-east1").Label("dataset", "imagenet").Obj(),
+		*utiltestingapi.MakeMultiKueueCluster("us-west1").Label("region", "us-west1").Label("dataset", "imagenet").Obj(),
+		*utiltestingapi.MakeMultiKueueCluster("eu-west1").Label("region", "eu-west1").Obj(),
+	}
+	cases := map[string]struct {
+		featureDisabled bool
+		annotations     map[string]string
+		remoteClusters  sets.Set[string]
+		wantSelected    sets.Set[string]
+		wantPreferred   sets.Set[string]
+		wantErr         error
+	}{
+		"no cluster selectors": {
+			remoteClusters: sets.New("us-east1", "us-west1", "eu-west1"),
+			wantSelected:   sets.New("us-east1", "us-west1", "eu-west1"),
+		},
+		"feature disabled": {
+			featureDisabled: true,
+			annotations:     map[string]string{kueue.MultiKueueRequiredClusterSelectorAnnotation: "dataset=imagenet"},
+			remoteClusters:  sets.New("us-east1", "us-west1", "eu-west1"),
+			wantSelected:    sets.New("us-east1", "us-west1", "eu-west1"),
+		},
+		"required and preferred cluster selectors": {
+			annotations: map[string]string{
+				kueue.MultiKueueRequiredClusterSelectorAnnotation:  "dataset=imagenet",
+				kueue.MultiKueuePreferredClusterSelectorAnnotation: "region in (us-west1,eu-west1)",
+			},
+			remoteClusters: sets.New("us-east1", "us-west1", "eu-west1"),
+			wantSelected:   sets.New("us-east1", "us-west1"),
+			wantPreferred:  sets.New("us-west1"),
+		},
+		"missing cluster with preferred cluster selector": {
+			annotations:    map[string]string{kueue.MultiKueuePreferredClusterSelectorAnnotation: "region=eu-west1"},
+			remoteClusters: sets.New("eu-west1", "missing"),
+			wantSelected:   sets.New("eu-west1", "missing"),
+			wantPreferred:  sets.New("eu-west1"),
+		},
+		"missing cluster with required cluster selector": {
+			annotations:    map[string]string{kueue.MultiKueueRequiredClusterSelectorAnnotation: "region"},
+			remoteClusters: sets.New("eu-west1", "missing"),
+			wantSelected:   sets.New("eu-west1"),
+			wantPreferred:  sets.New[string](),
+		},
+		"invalid cluster selector": {
+			annotations:    map[string]string{kueue.MultiKueueRequiredClusterSelectorAnnotation: "region in us-east1"},
+			remoteClusters: sets.New("us-east1"),
+			wantErr:        ErrInvalidClusterSelector,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.MultiKueueClusterAffinity, !tc.featureDisabled)
+			c := utiltesting.NewClientBuilder().WithLists(&kueue.MultiKueueClusterList{Items: clusters}).Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wl := utiltestingapi.MakeWorkload("wl", "ns").Annotations(tc.annotations).Obj()
+
+			gotSelected, gotPreferred, err := SelectClusters(ctx, c, wl, tc.remoteClusters)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Fatalf("unexpected error (-want/+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantSelected, gotSelected); diff != "" {
+				t.Errorf("unexpected selected clusters (-want/+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantPreferred, gotPreferred); diff != "" {
+				t.Errorf("unexpected preferred clusters (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Path is the path of a field of an unstructured object.
+type Path []string
+
+// Parse parses a path in the JSONPath dot notation, for example
+// `.spec.managedBy`. The keys containing dots can be quoted with brackets,
+// for example `.metadata.annotations['example.com/key']`.
+// Wildcards, array indexes and filters are not supported.
+func Parse(path string) (Path, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, errors.New("must start with '.'")
+	}
+	var fields Path
+	rest := path
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end < 0 {
+				return nil, errors.New("unterminated quoted key")
+			}
+			fields = append(fields, rest[2:end])
+			rest = rest[end+2:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			fields = append(fields, rest[:end])
+			rest = rest[end:]
+		case strings.HasPrefix(rest, "["):
+			return nil, errors.New("wildcards, array indexes and filters are not supported")
+		default:
+			return nil, fmt.Errorf("unexpected character %q", rest[0])
+		}
+		if fields[len(fields)-1] == "" {
+			return nil, errors.New("empty key")
+		}
+		if strings.ContainsAny(fields[len(fields)-1], "*[]") {
+			return nil, errors.New("wildcards, array indexes and filters are not supported")
+		}
+	}
+	return fields, nil
+}
+
+// HasPrefix returns true if the path is prefix or a path below it.
+func (p Path) HasPrefix(prefix ...string) bool {
+	return len(p) >= len(prefix) && slices.Equal(p[:len(prefix)], prefix)
+}
+
+func (p Path) String() string {
+	var b strings.Builder
+	for _, f := range p {
+		if strings.Contains(f, ".") {
+			fmt.Fprintf(&b, "['%s']", f)
+		} else {
+			b.WriteString("." + f)
+		}
+	}
+	return b.String()
+}
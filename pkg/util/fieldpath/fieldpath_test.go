@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		path    string
+		want    Path
+		wantErr bool
+	}{
+		"nested field": {
+			path: ".spec.managedBy",
+			want: Path{"spec", "managedBy"},
+		},
+		"quoted key": {
+			path: ".metadata.annotations['example.com/owner']",
+			want: Path{"metadata", "annotations", "example.com/owner"},
+		},
+		"missing leading dot": {
+			path:    "spec.managedBy",
+			wantErr: true,
+		},
+		"empty key": {
+			path:    ".spec..managedBy",
+			wantErr: true,
+		},
+		"unterminated quoted key": {
+			path:    ".metadata.labels['example.com/owner",
+			wantErr: true,
+		},
+		"array index": {
+			path:    ".status.conditions[0]",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tc.path)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected path (-want,+got):\n%s", diff)
+			}
+			if !tc.wantErr && got.String() != tc.path {
+				t.Errorf("Unexpected string, want: %q, got: %q", tc.path, got.String())
+			}
+		})
+	}
+}
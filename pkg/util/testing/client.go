@@ -19,15 +19,20 @@ package testing
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
+	"testing"
 
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -153,3 +158,22 @@ func TreatSSAAsStrategicMerge(ctx context.Context, clnt client.Client, subResour
 	}
 	return clnt.SubResource(subResourceName).Patch(ctx, obj, wrapSSAPatch(patch), filteredOpts...)
 }
+
+// NewManager returns a manager using the client, to set up controllers and
+// webhooks in unit tests. The manager is not meant to be started.
+func NewManager(t testing.TB, c client.Client) manager.Manager {
+	t.Helper()
+	mgr, err := manager.New(&rest.Config{}, manager.Options{
+		Scheme: c.Scheme(),
+		NewClient: func(*rest.Config, client.Options) (client.Client, error) {
+			return c, nil
+		},
+		MapperProvider: func(*rest.Config, *http.Client) (apimeta.RESTMapper, error) {
+			return apimeta.NewDefaultRESTMapper(nil), nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create the manager: %v", err)
+	}
+	return mgr
+}
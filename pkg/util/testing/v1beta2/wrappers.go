@@ -382,6 +382,21 @@ func (w *WorkloadWrapper) MaximumExecutionTimeSeconds(v int32) *WorkloadWrapper
 	return w
 }
 
+func (w *WorkloadWrapper) Dependency(d kueue.WorkloadDependency) *WorkloadWrapper {
+	w.Spec.Dependencies = append(w.Spec.Dependencies, d)
+	return w
+}
+
+func (w *WorkloadWrapper) Tasks(v int32) *WorkloadWrapper {
+	w.Spec.Tasks = &v
+	return w
+}
+
+func (w *WorkloadWrapper) TasksStatus(tasks kueue.WorkloadTasksStatus) *WorkloadWrapper {
+	w.Status.Tasks = &tasks
+	return w
+}
+
 func (w *WorkloadWrapper) PastAdmittedTime(v int32) *WorkloadWrapper {
 	w.Status.AccumulatedPastExecutionTimeSeconds = &v
 	return w
@@ -508,6 +523,16 @@ func (p *PodSetWrapper) UnconstrainedTopologyRequest() *PodSetWrapper {
 	return p
 }
 
+func (p *PodSetWrapper) SpreadTopologyRequest(level string, minDomains, maxPodsPerDomain *int32) *PodSetWrapper {
+	if p.TopologyRequest == nil {
+		p.TopologyRequest = &kueue.PodSetTopologyRequest{}
+	}
+	p.TopologyRequest.SpreadTopology = &level
+	p.TopologyRequest.SpreadMinDomains = minDomains
+	p.TopologyRequest.SpreadMaxPodsPerDomain = maxPodsPerDomain
+	return p
+}
+
 func (p *PodSetWrapper) PodIndexLabel(label *string) *PodSetWrapper {
 	if p.TopologyRequest == nil {
 		p.TopologyRequest = &kueue.PodSetTopologyRequest{}
@@ -1473,6 +1498,30 @@ func (mkc *MultiKueueClusterWrapper) Generation(num int64) *MultiKueueClusterWra
 	return mkc
 }
 
+// CordonPolicy sets the cordon policy of the MultiKueueCluster.
+func (mkc *MultiKueueClusterWrapper) CordonPolicy(policy kueue.MultiKueueClusterCordonPolicy) *MultiKueueClusterWrapper {
+	mkc.Spec.CordonPolicy = &policy
+	return mkc
+}
+
+// Label sets the label of the MultiKueueCluster.
+func (mkc *MultiKueueClusterWrapper) Label(k, v string) *MultiKueueClusterWrapper {
+	if mkc.Labels == nil {
+		mkc.Labels = make(map[string]string)
+	}
+	mkc.Labels[k] = v
+	return mkc
+}
+
+// Annotation sets the annotation of the MultiKueueCluster.
+func (mkc *MultiKueueClusterWrapper) Annotation(k, v string) *MultiKueueClusterWrapper {
+	if mkc.Annotations == nil {
+		mkc.Annotations = make(map[string]string)
+	}
+	mkc.Annotations[k] = v
+	return mkc
+}
+
 // ProvisioningRequestConfigWrapper wraps a ProvisioningRequestConfig
 type ProvisioningRequestConfigWrapper struct {
 	kueue.ProvisioningRequestConfig
@@ -0,0 +1,244 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// GVK is the GroupVersionKind of the Argo Workflow.
+var GVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"}
+
+// WorkflowWrapper wraps an Argo Workflow.
+type WorkflowWrapper struct{ unstructured.Unstructured }
+
+// Step describes a step of a steps template, or a task of a DAG template.
+type Step struct {
+	Name     string
+	Template string
+	// Items is the number of items the step is fanned out to.
+	Items int
+	// Param is the parameter the step is fanned out with.
+	Param string
+	// Depends is the dependencies expression of a DAG task.
+	Depends string
+}
+
+// MakeWorkflow creates a wrapper for a suspended Workflow, with a "main"
+// entrypoint.
+func MakeWorkflow(name, ns string) *WorkflowWrapper {
+	w := &WorkflowWrapper{unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"entrypoint": "main",
+			"suspend":    true,
+		},
+	}}}
+	w.SetGroupVersionKind(GVK)
+	w.SetName(name)
+	w.SetNamespace(ns)
+	return w
+}
+
+// Obj returns the inner Workflow.
+func (w *WorkflowWrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+// Clone returns deep copy of the WorkflowWrapper.
+func (w *WorkflowWrapper) Clone() *WorkflowWrapper {
+	return &WorkflowWrapper{*w.DeepCopy()}
+}
+
+// Label sets the label key and value
+func (w *WorkflowWrapper) Label(key, value string) *WorkflowWrapper {
+	labels := w.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[key] = value
+	w.SetLabels(labels)
+	return w
+}
+
+// Annotation sets the annotation key and value
+func (w *WorkflowWrapper) Annotation(key, value string) *WorkflowWrapper {
+	annotations := w.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[key] = value
+	w.SetAnnotations(annotations)
+	return w
+}
+
+// Queue updates the queue name of the Workflow
+func (w *WorkflowWrapper) Queue(queue string) *WorkflowWrapper {
+	return w.Label(constants.QueueLabel, queue)
+}
+
+// UID updates the uid of the Workflow.
+func (w *WorkflowWrapper) UID(uid string) *WorkflowWrapper {
+	w.SetUID(types.UID(uid))
+	return w
+}
+
+// Suspend updates the suspend status of the Workflow
+func (w *WorkflowWrapper) Suspend(s bool) *WorkflowWrapper {
+	return w.set(s, "spec", "suspend")
+}
+
+// Entrypoint updates the entrypoint of the Workflow.
+func (w *WorkflowWrapper) Entrypoint(name string) *WorkflowWrapper {
+	return w.set(name, "spec", "entrypoint")
+}
+
+// OnExit updates the exit handler of the Workflow.
+func (w *WorkflowWrapper) OnExit(name string) *WorkflowWrapper {
+	return w.set(name, "spec", "onExit")
+}
+
+// WorkflowTemplateRef makes the Workflow reference a WorkflowTemplate.
+func (w *WorkflowWrapper) WorkflowTemplateRef(name string) *WorkflowWrapper {
+	return w.set(map[string]any{"name": name}, "spec", "workflowTemplateRef")
+}
+
+// NodeSelector sets a node selector of the Workflow pods.
+func (w *WorkflowWrapper) NodeSelector(k, v string) *WorkflowWrapper {
+	return w.set(v, "spec", "nodeSelector", k)
+}
+
+// Toleration adds a toleration to the Workflow pods.
+func (w *WorkflowWrapper) Toleration(toleration corev1.Toleration) *WorkflowWrapper {
+	tolerations, _, _ := unstructured.NestedSlice(w.Object, "spec", "tolerations")
+	return w.set(append(tolerations, toUnstructured(&toleration)), "spec", "tolerations")
+}
+
+// PodLabel sets a label of the Workflow pods.
+func (w *WorkflowWrapper) PodLabel(k, v string) *WorkflowWrapper {
+	return w.set(v, "spec", "podMetadata", "labels", k)
+}
+
+// PodAnnotation sets an annotation of the Workflow pods.
+func (w *WorkflowWrapper) PodAnnotation(k, v string) *WorkflowWrapper {
+	return w.set(v, "spec", "podMetadata", "annotations", k)
+}
+
+// ContainerTemplate adds a container template with the given requests.
+func (w *WorkflowWrapper) ContainerTemplate(name string, requests corev1.ResourceList) *WorkflowWrapper {
+	container := &corev1.Container{
+		Name:  "main",
+		Image: "busybox",
+		Resources: corev1.ResourceRequirements{
+			Requests: requests,
+		},
+	}
+	return w.template(map[string]any{
+		"name":      name,
+		"container": toUnstructured(container),
+	})
+}
+
+// StepsTemplate adds a steps template. The steps of a group run in parallel,
+// and the groups run one after the other.
+func (w *WorkflowWrapper) StepsTemplate(name string, groups ...[]Step) *WorkflowWrapper {
+	stepGroups := make([]any, 0, len(groups))
+	for _, group := range groups {
+		steps := make([]any, 0, len(group))
+		for _, step := range group {
+			steps = append(steps, stepToUnstructured(step))
+		}
+		stepGroups = append(stepGroups, steps)
+	}
+	return w.template(map[string]any{
+		"name":  name,
+		"steps": stepGroups,
+	})
+}
+
+// DAGTemplate adds a DAG template.
+func (w *WorkflowWrapper) DAGTemplate(name string, tasks ...Step) *WorkflowWrapper {
+	dagTasks := make([]any, 0, len(tasks))
+	for _, task := range tasks {
+		dagTasks = append(dagTasks, stepToUnstructured(task))
+	}
+	return w.template(map[string]any{
+		"name": name,
+		"dag":  map[string]any{"tasks": dagTasks},
+	})
+}
+
+// Phase sets the phase of the Workflow.
+func (w *WorkflowWrapper) Phase(phase string) *WorkflowWrapper {
+	return w.set(phase, "status", "phase")
+}
+
+// Message sets the message of the Workflow.
+func (w *WorkflowWrapper) Message(msg string) *WorkflowWrapper {
+	return w.set(msg, "status", "message")
+}
+
+// Node sets the status of a node of the Workflow.
+func (w *WorkflowWrapper) Node(id, nodeType, phase string) *WorkflowWrapper {
+	return w.set(map[string]any{"id": id, "type": nodeType, "phase": phase}, "status", "nodes", id)
+}
+
+func (w *WorkflowWrapper) template(t map[string]any) *WorkflowWrapper {
+	templates, _, _ := unstructured.NestedSlice(w.Object, "spec", "templates")
+	return w.set(append(templates, t), "spec", "templates")
+}
+
+func (w *WorkflowWrapper) set(value any, fields ...string) *WorkflowWrapper {
+	if err := unstructured.SetNestedField(w.Object, value, fields...); err != nil {
+		panic(err)
+	}
+	return w
+}
+
+func stepToUnstructured(step Step) map[string]any {
+	s := map[string]any{
+		"name":     step.Name,
+		"template": step.Template,
+	}
+	if step.Items > 0 {
+		items := make([]any, 0, step.Items)
+		for i := range step.Items {
+			items = append(items, int64(i))
+		}
+		s["withItems"] = items
+	}
+	if step.Param != "" {
+		s["withParam"] = step.Param
+	}
+	if step.Depends != "" {
+		s["depends"] = step.Depends
+	}
+	return s
+}
+
+func toUnstructured(obj any) map[string]any {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
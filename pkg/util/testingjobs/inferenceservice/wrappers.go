@@ -0,0 +1,119 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// GVK is the GroupVersionKind of the KServe InferenceService.
+var GVK = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}
+
+// InferenceServiceWrapper wraps a KServe InferenceService.
+type InferenceServiceWrapper struct{ unstructured.Unstructured }
+
+// MakeInferenceService creates a wrapper for an InferenceService with a
+// sklearn predictor.
+func MakeInferenceService(name, ns string) *InferenceServiceWrapper {
+	w := &InferenceServiceWrapper{unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"predictor": map[string]any{
+				"model": map[string]any{
+					"modelFormat": map[string]any{"name": "sklearn"},
+				},
+			},
+		},
+	}}}
+	w.SetGroupVersionKind(GVK)
+	w.SetName(name)
+	w.SetNamespace(ns)
+	return w
+}
+
+// Obj returns the inner InferenceService.
+func (w *InferenceServiceWrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+// Clone returns deep copy of the InferenceServiceWrapper.
+func (w *InferenceServiceWrapper) Clone() *InferenceServiceWrapper {
+	return &InferenceServiceWrapper{*w.DeepCopy()}
+}
+
+// Label sets the label key and value
+func (w *InferenceServiceWrapper) Label(key, value string) *InferenceServiceWrapper {
+	labels := w.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[key] = value
+	w.SetLabels(labels)
+	return w
+}
+
+// Annotation sets the annotation key and value
+func (w *InferenceServiceWrapper) Annotation(key, value string) *InferenceServiceWrapper {
+	annotations := w.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[key] = value
+	w.SetAnnotations(annotations)
+	return w
+}
+
+// Queue updates the queue name of the InferenceService
+func (w *InferenceServiceWrapper) Queue(queue string) *InferenceServiceWrapper {
+	return w.Label(constants.QueueLabel, queue)
+}
+
+// Transformer adds a transformer component to the InferenceService.
+func (w *InferenceServiceWrapper) Transformer() *InferenceServiceWrapper {
+	return w.set(map[string]any{
+		"containers": []any{map[string]any{"name": "transformer", "image": "transformer"}},
+	}, "spec", "transformer")
+}
+
+// MinReplicas sets the min replicas of the component.
+func (w *InferenceServiceWrapper) MinReplicas(component string, replicas int64) *InferenceServiceWrapper {
+	return w.set(replicas, "spec", component, "minReplicas")
+}
+
+// ComponentLabel sets a label of the pods of the component.
+func (w *InferenceServiceWrapper) ComponentLabel(component, key, value string) *InferenceServiceWrapper {
+	return w.set(value, "spec", component, "labels", key)
+}
+
+// ComponentAnnotation sets an annotation of the pods of the component.
+func (w *InferenceServiceWrapper) ComponentAnnotation(component, key, value string) *InferenceServiceWrapper {
+	return w.set(value, "spec", component, "annotations", key)
+}
+
+// Ready sets the Ready condition of the InferenceService.
+func (w *InferenceServiceWrapper) Ready() *InferenceServiceWrapper {
+	return w.set([]any{map[string]any{"type": "Ready", "status": "True"}}, "status", "conditions")
+}
+
+func (w *InferenceServiceWrapper) set(value any, fields ...string) *InferenceServiceWrapper {
+	if err := unstructured.SetNestedField(w.Object, value, fields...); err != nil {
+		panic(err)
+	}
+	return w
+}
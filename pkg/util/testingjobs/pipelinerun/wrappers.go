@@ -0,0 +1,266 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+var (
+	// GVK is the GroupVersionKind of the Tekton PipelineRun.
+	GVK         = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "PipelineRun"}
+	pipelineGVK = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "Pipeline"}
+	taskGVK     = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "Task"}
+	taskRunGVK  = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "TaskRun"}
+)
+
+// PipelineRunWrapper wraps a Tekton PipelineRun.
+type PipelineRunWrapper struct{ unstructured.Unstructured }
+
+// Task describes a pipeline task.
+type Task struct {
+	Name string
+	// Requests are the requests of the single step of the embedded task.
+	Requests corev1.ResourceList
+	// TaskRef is the name of the referenced Task, used instead of the
+	// embedded task when set.
+	TaskRef  string
+	RunAfter []string
+	// Matrix is the number of values of the matrix parameter of the task.
+	Matrix int
+	// Params are the parameters of the task.
+	Params map[string]string
+}
+
+// MakePipelineRun creates a wrapper for a pending PipelineRun.
+func MakePipelineRun(name, ns string) *PipelineRunWrapper {
+	p := &PipelineRunWrapper{unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"status": "PipelineRunPending",
+		},
+	}}}
+	p.SetGroupVersionKind(GVK)
+	p.SetName(name)
+	p.SetNamespace(ns)
+	return p
+}
+
+// Obj returns the inner PipelineRun.
+func (p *PipelineRunWrapper) Obj() *unstructured.Unstructured {
+	return &p.Unstructured
+}
+
+// Clone returns deep copy of the PipelineRunWrapper.
+func (p *PipelineRunWrapper) Clone() *PipelineRunWrapper {
+	return &PipelineRunWrapper{*p.DeepCopy()}
+}
+
+// Label sets the label key and value
+func (p *PipelineRunWrapper) Label(key, value string) *PipelineRunWrapper {
+	labels := p.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[key] = value
+	p.SetLabels(labels)
+	return p
+}
+
+// Queue updates the queue name of the PipelineRun
+func (p *PipelineRunWrapper) Queue(queue string) *PipelineRunWrapper {
+	return p.Label(constants.QueueLabel, queue)
+}
+
+// UID updates the uid of the PipelineRun.
+func (p *PipelineRunWrapper) UID(uid string) *PipelineRunWrapper {
+	p.SetUID(types.UID(uid))
+	return p
+}
+
+// Status sets the spec.status of the PipelineRun, or removes it when empty.
+func (p *PipelineRunWrapper) Status(status string) *PipelineRunWrapper {
+	if status == "" {
+		unstructured.RemoveNestedField(p.Object, "spec", "status")
+		return p
+	}
+	return p.set(status, "spec", "status")
+}
+
+// PipelineRef makes the PipelineRun reference a Pipeline.
+func (p *PipelineRunWrapper) PipelineRef(name string) *PipelineRunWrapper {
+	return p.set(map[string]any{"name": name}, "spec", "pipelineRef")
+}
+
+// PipelineRefResolver makes the PipelineRun reference a pipeline through a
+// resolver.
+func (p *PipelineRunWrapper) PipelineRefResolver(resolver string) *PipelineRunWrapper {
+	return p.set(map[string]any{"resolver": resolver}, "spec", "pipelineRef")
+}
+
+// Task adds a task to the embedded pipeline.
+func (p *PipelineRunWrapper) Task(task Task) *PipelineRunWrapper {
+	return p.appendTo(taskToUnstructured(task), "spec", "pipelineSpec", "tasks")
+}
+
+// Finally adds a finally task to the embedded pipeline.
+func (p *PipelineRunWrapper) Finally(task Task) *PipelineRunWrapper {
+	return p.appendTo(taskToUnstructured(task), "spec", "pipelineSpec", "finally")
+}
+
+// NodeSelector sets a node selector of the taskRunTemplate.
+func (p *PipelineRunWrapper) NodeSelector(k, v string) *PipelineRunWrapper {
+	return p.set(v, "spec", "taskRunTemplate", "podTemplate", "nodeSelector", k)
+}
+
+// TaskRunSpec adds a taskRunSpecs entry.
+func (p *PipelineRunWrapper) TaskRunSpec(entry map[string]any) *PipelineRunWrapper {
+	return p.appendTo(entry, "spec", "taskRunSpecs")
+}
+
+// StartTime marks the PipelineRun as started.
+func (p *PipelineRunWrapper) StartTime() *PipelineRunWrapper {
+	return p.set("2026-01-01T00:00:00Z", "status", "startTime")
+}
+
+// CompletionTime marks the PipelineRun as completed.
+func (p *PipelineRunWrapper) CompletionTime() *PipelineRunWrapper {
+	return p.set("2026-01-01T01:00:00Z", "status", "completionTime")
+}
+
+// Condition sets the Succeeded condition of the PipelineRun.
+func (p *PipelineRunWrapper) Condition(status, message string) *PipelineRunWrapper {
+	return p.set([]any{map[string]any{"type": "Succeeded", "status": status, "message": message}}, "status", "conditions")
+}
+
+// SkippedTask marks a task as skipped.
+func (p *PipelineRunWrapper) SkippedTask(name string) *PipelineRunWrapper {
+	return p.appendTo(map[string]any{"name": name}, "status", "skippedTasks")
+}
+
+func (p *PipelineRunWrapper) appendTo(value any, fields ...string) *PipelineRunWrapper {
+	values, _, _ := unstructured.NestedSlice(p.Object, fields...)
+	return p.set(append(values, value), fields...)
+}
+
+func (p *PipelineRunWrapper) set(value any, fields ...string) *PipelineRunWrapper {
+	if err := unstructured.SetNestedField(p.Object, value, fields...); err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MakePipeline creates a Pipeline with the given tasks.
+func MakePipeline(name, ns string, tasks ...Task) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(pipelineGVK)
+	obj.SetName(name)
+	obj.SetNamespace(ns)
+	pipelineTasks := make([]any, 0, len(tasks))
+	for _, task := range tasks {
+		pipelineTasks = append(pipelineTasks, taskToUnstructured(task))
+	}
+	obj.Object["spec"] = map[string]any{"tasks": pipelineTasks}
+	return obj
+}
+
+// MakeTask creates a Task with a single step with the given requests.
+func MakeTask(name, ns string, requests corev1.ResourceList) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(taskGVK)
+	obj.SetName(name)
+	obj.SetNamespace(ns)
+	obj.Object["spec"] = taskSpec(requests)
+	return obj
+}
+
+// MakeTaskRun creates a TaskRun of a pipeline task, with the given status
+// of its Succeeded condition.
+func MakeTaskRun(name, ns, pipelineRun, pipelineTask, succeeded string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(taskRunGVK)
+	obj.SetName(name)
+	obj.SetNamespace(ns)
+	obj.SetLabels(map[string]string{
+		"tekton.dev/pipelineRun":  pipelineRun,
+		"tekton.dev/pipelineTask": pipelineTask,
+	})
+	obj.Object["status"] = map[string]any{
+		"conditions": []any{map[string]any{"type": "Succeeded", "status": succeeded}},
+	}
+	return obj
+}
+
+func taskToUnstructured(task Task) map[string]any {
+	t := map[string]any{"name": task.Name}
+	if task.TaskRef != "" {
+		t["taskRef"] = map[string]any{"name": task.TaskRef}
+	} else {
+		t["taskSpec"] = taskSpec(task.Requests)
+	}
+	if len(task.RunAfter) > 0 {
+		runAfter := make([]any, 0, len(task.RunAfter))
+		for _, name := range task.RunAfter {
+			runAfter = append(runAfter, name)
+		}
+		t["runAfter"] = runAfter
+	}
+	if task.Matrix > 0 {
+		values := make([]any, 0, task.Matrix)
+		for i := range task.Matrix {
+			values = append(values, string(rune('a'+i)))
+		}
+		t["matrix"] = map[string]any{
+			"params": []any{map[string]any{"name": "item", "value": values}},
+		}
+	}
+	if len(task.Params) > 0 {
+		params := make([]any, 0, len(task.Params))
+		for _, name := range slices.Sorted(maps.Keys(task.Params)) {
+			params = append(params, map[string]any{"name": name, "value": task.Params[name]})
+		}
+		t["params"] = params
+	}
+	return t
+}
+
+func taskSpec(requests corev1.ResourceList) map[string]any {
+	step := map[string]any{
+		"name":  "step",
+		"image": "busybox",
+	}
+	if len(requests) > 0 {
+		step["computeResources"] = toUnstructured(&corev1.ResourceRequirements{Requests: requests})
+	}
+	return map[string]any{"steps": []any{step}}
+}
+
+func toUnstructured(obj any) map[string]any {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
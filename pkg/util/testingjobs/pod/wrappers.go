@@ -288,6 +288,12 @@ func (p *PodWrapper) StatusMessage(msg string) *PodWrapper {
 	return p
 }
 
+// StatusContainerStatuses updates the container statuses of the Pod.
+func (p *PodWrapper) StatusContainerStatuses(statuses ...corev1.ContainerStatus) *PodWrapper {
+	p.Status.ContainerStatuses = statuses
+	return p
+}
+
 // CreationTimestamp sets a creation timestamp for the pod object
 func (p *PodWrapper) CreationTimestamp(t time.Time) *PodWrapper {
 	timestamp := metav1.NewTime(t).Rfc3339Copy()
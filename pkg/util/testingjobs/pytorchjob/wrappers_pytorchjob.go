@@ -143,6 +143,15 @@ func (j *PyTorchJobWrapper) Label(key, value string) *PyTorchJobWrapper {
 	return j
 }
 
+// Annotation sets the annotation key and value
+func (j *PyTorchJobWrapper) Annotation(key, value string) *PyTorchJobWrapper {
+	if j.Annotations == nil {
+		j.Annotations = make(map[string]string)
+	}
+	j.Annotations[key] = value
+	return j
+}
+
 // PriorityClass updates job priorityclass.
 func (j *PyTorchJobWrapper) PriorityClass(pc string) *PyTorchJobWrapper {
 	if j.Spec.RunPolicy.SchedulingPolicy == nil {
@@ -228,6 +237,13 @@ func (j *PyTorchJobWrapper) PodLabel(replicaType kftraining.ReplicaType, k, v st
 	return j
 }
 
+// SchedulingGates appends the scheduling gates at the pod template level
+func (j *PyTorchJobWrapper) SchedulingGates(replicaType kftraining.ReplicaType, gates ...corev1.PodSchedulingGate) *PyTorchJobWrapper {
+	podSpec := &j.Spec.PyTorchReplicaSpecs[replicaType].Template.Spec
+	podSpec.SchedulingGates = append(podSpec.SchedulingGates, gates...)
+	return j
+}
+
 // StatusConditions adds a condition.
 func (j *PyTorchJobWrapper) StatusConditions(c kftraining.JobCondition) *PyTorchJobWrapper {
 	j.Status.Conditions = append(j.Status.Conditions, c)
@@ -0,0 +1,208 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// GVK is the GroupVersionKind of the SparkApplication.
+var GVK = schema.GroupVersionKind{Group: "sparkoperator.k8s.io", Version: "v1beta2", Kind: "SparkApplication"}
+
+// SparkApplicationWrapper wraps a SparkApplication.
+type SparkApplicationWrapper struct{ unstructured.Unstructured }
+
+// MakeSparkApplication creates a wrapper for a suspended SparkApplication with
+// one driver and one executor.
+func MakeSparkApplication(name, ns string) *SparkApplicationWrapper {
+	w := &SparkApplicationWrapper{unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"type":                "Scala",
+			"mode":                "cluster",
+			"image":               "spark:3.5.3",
+			"mainClass":           "org.apache.spark.examples.SparkPi",
+			"mainApplicationFile": "local:///opt/spark/examples/jars/spark-examples.jar",
+			"sparkVersion":        "3.5.3",
+			"suspend":             true,
+			"driver": map[string]any{
+				"cores":  int64(1),
+				"memory": "512m",
+			},
+			"executor": map[string]any{
+				"instances": int64(1),
+				"cores":     int64(1),
+				"memory":    "512m",
+			},
+		},
+	}}}
+	w.SetGroupVersionKind(GVK)
+	w.SetName(name)
+	w.SetNamespace(ns)
+	return w
+}
+
+// Obj returns the inner SparkApplication.
+func (w *SparkApplicationWrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+// Clone returns deep copy of the SparkApplicationWrapper.
+func (w *SparkApplicationWrapper) Clone() *SparkApplicationWrapper {
+	return &SparkApplicationWrapper{*w.DeepCopy()}
+}
+
+// Label sets the label key and value
+func (w *SparkApplicationWrapper) Label(key, value string) *SparkApplicationWrapper {
+	labels := w.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[key] = value
+	w.SetLabels(labels)
+	return w
+}
+
+// Queue updates the queue name of the SparkApplication
+func (w *SparkApplicationWrapper) Queue(queue string) *SparkApplicationWrapper {
+	return w.Label(constants.QueueLabel, queue)
+}
+
+// UID updates the uid of the SparkApplication.
+func (w *SparkApplicationWrapper) UID(uid string) *SparkApplicationWrapper {
+	w.SetUID(types.UID(uid))
+	return w
+}
+
+// Suspend updates the suspend status of the SparkApplication
+func (w *SparkApplicationWrapper) Suspend(s bool) *SparkApplicationWrapper {
+	return w.set(s, "spec", "suspend")
+}
+
+// ManagedBy adds a managedby.
+func (w *SparkApplicationWrapper) ManagedBy(c string) *SparkApplicationWrapper {
+	return w.set(c, "spec", "managedBy")
+}
+
+// Type updates the application type of the SparkApplication.
+func (w *SparkApplicationWrapper) Type(t string) *SparkApplicationWrapper {
+	return w.set(t, "spec", "type")
+}
+
+// ExecutorInstances updates the number of executors of the SparkApplication.
+func (w *SparkApplicationWrapper) ExecutorInstances(n int64) *SparkApplicationWrapper {
+	return w.set(n, "spec", "executor", "instances")
+}
+
+// DynamicAllocation enables the dynamic allocation of executors.
+func (w *SparkApplicationWrapper) DynamicAllocation(initialExecutors, maxExecutors *int64) *SparkApplicationWrapper {
+	w.set(true, "spec", "dynamicAllocation", "enabled")
+	if initialExecutors != nil {
+		w.set(*initialExecutors, "spec", "dynamicAllocation", "initialExecutors")
+	}
+	if maxExecutors != nil {
+		w.set(*maxExecutors, "spec", "dynamicAllocation", "maxExecutors")
+	}
+	return w
+}
+
+// DriverCores updates the cores of the driver.
+func (w *SparkApplicationWrapper) DriverCores(cores int64) *SparkApplicationWrapper {
+	return w.set(cores, "spec", "driver", "cores")
+}
+
+// DriverCoreRequest updates the core request of the driver.
+func (w *SparkApplicationWrapper) DriverCoreRequest(q string) *SparkApplicationWrapper {
+	return w.set(q, "spec", "driver", "coreRequest")
+}
+
+// DriverMemory updates the memory of the driver.
+func (w *SparkApplicationWrapper) DriverMemory(memory string) *SparkApplicationWrapper {
+	return w.set(memory, "spec", "driver", "memory")
+}
+
+// DriverMemoryOverhead updates the memory overhead of the driver.
+func (w *SparkApplicationWrapper) DriverMemoryOverhead(memory string) *SparkApplicationWrapper {
+	return w.set(memory, "spec", "driver", "memoryOverhead")
+}
+
+// DriverNodeSelector sets a node selector of the driver.
+func (w *SparkApplicationWrapper) DriverNodeSelector(k, v string) *SparkApplicationWrapper {
+	return w.set(v, "spec", "driver", "nodeSelector", k)
+}
+
+// DriverLabel sets a label of the driver pod.
+func (w *SparkApplicationWrapper) DriverLabel(k, v string) *SparkApplicationWrapper {
+	return w.set(v, "spec", "driver", "labels", k)
+}
+
+// ExecutorCores updates the cores of the executors.
+func (w *SparkApplicationWrapper) ExecutorCores(cores int64) *SparkApplicationWrapper {
+	return w.set(cores, "spec", "executor", "cores")
+}
+
+// ExecutorMemory updates the memory of the executors.
+func (w *SparkApplicationWrapper) ExecutorMemory(memory string) *SparkApplicationWrapper {
+	return w.set(memory, "spec", "executor", "memory")
+}
+
+// ExecutorGPU sets the GPU resource of the executors.
+func (w *SparkApplicationWrapper) ExecutorGPU(name string, quantity int64) *SparkApplicationWrapper {
+	return w.set(map[string]any{"name": name, "quantity": quantity}, "spec", "executor", "gpu")
+}
+
+// ExecutorNodeSelector sets a node selector of the executors.
+func (w *SparkApplicationWrapper) ExecutorNodeSelector(k, v string) *SparkApplicationWrapper {
+	return w.set(v, "spec", "executor", "nodeSelector", k)
+}
+
+// ExecutorLabel sets a label of the executor pods.
+func (w *SparkApplicationWrapper) ExecutorLabel(k, v string) *SparkApplicationWrapper {
+	return w.set(v, "spec", "executor", "labels", k)
+}
+
+// ExecutorToleration adds a toleration to the executors.
+func (w *SparkApplicationWrapper) ExecutorToleration(toleration corev1.Toleration) *SparkApplicationWrapper {
+	t, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&toleration)
+	if err != nil {
+		panic(err)
+	}
+	tolerations, _, _ := unstructured.NestedSlice(w.Object, "spec", "executor", "tolerations")
+	return w.set(append(tolerations, t), "spec", "executor", "tolerations")
+}
+
+// ApplicationState sets the state of the SparkApplication.
+func (w *SparkApplicationWrapper) ApplicationState(state string) *SparkApplicationWrapper {
+	return w.set(state, "status", "applicationState", "state")
+}
+
+// ErrorMessage sets the error message of the SparkApplication.
+func (w *SparkApplicationWrapper) ErrorMessage(msg string) *SparkApplicationWrapper {
+	return w.set(msg, "status", "applicationState", "errorMessage")
+}
+
+func (w *SparkApplicationWrapper) set(value any, fields ...string) *SparkApplicationWrapper {
+	if err := unstructured.SetNestedField(w.Object, value, fields...); err != nil {
+		panic(err)
+	}
+	return w
+}
@@ -19,6 +19,7 @@ package visibility
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"strings"
 
@@ -42,6 +43,8 @@ import (
 	visibilityv1beta1 "sigs.k8s.io/kueue/apis/visibility/v1beta1"
 	visibilityv1beta2 "sigs.k8s.io/kueue/apis/visibility/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
 	"sigs.k8s.io/kueue/pkg/util/tlsconfig"
 	"sigs.k8s.io/kueue/pkg/visibility/storage"
 
@@ -73,8 +76,9 @@ func init() {
 // +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas,verbs=list;watch
 // +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas/status,verbs=patch
 
-// CreateAndStartVisibilityServer creates a visibility server injecting KueueManager and starts it
-func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manager, enableInternalCertManagement bool, kubeConfig *rest.Config, tlsOpts *tlsconfig.TLS) error {
+// CreateAndStartVisibilityServer creates a visibility server injecting KueueManager, the scheduler cache
+// and, if MultiKueue is enabled, the clients of the worker clusters, and starts it
+func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manager, cache *schdcache.Cache, workerClients *workers.Clients, enableInternalCertManagement bool, kubeConfig *rest.Config, tlsOpts *tlsconfig.TLS) error {
 	config := newVisibilityServerConfig(kubeConfig)
 	if err := applyVisibilityServerOptions(config, enableInternalCertManagement, tlsOpts); err != nil {
 		return fmt.Errorf("unable to apply VisibilityServerOptions: %w", err)
@@ -85,7 +89,7 @@ func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manage
 		return fmt.Errorf("unable to create visibility server: %w", err)
 	}
 
-	if err := install(visibilityServer, kueueMgr); err != nil {
+	if err := install(visibilityServer, kueueMgr, cache, workerClients); err != nil {
 		return fmt.Errorf("unable to install visibility.kueue.x-k8s.io API: %w", err)
 	}
 
@@ -148,11 +152,16 @@ func newVisibilityServerConfig(kubeConfig *rest.Config) *genericapiserver.Recomm
 }
 
 // install installs API scheme and registers storages
-func install(server *genericapiserver.GenericAPIServer, kueueMgr *qcache.Manager) error {
+func install(server *genericapiserver.GenericAPIServer, kueueMgr *qcache.Manager, cache *schdcache.Cache, workerClients *workers.Clients) error {
 	apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(visibilityv1beta2.GroupVersion.Group, scheme, parameterCodec, codecs)
-	storage := storage.NewStorage(kueueMgr)
-	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta2.GroupVersion.Version] = storage
-	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta1.GroupVersion.Version] = storage
+	queueStorage := storage.NewStorage(kueueMgr)
+	v1beta2Storage := maps.Clone(queueStorage)
+	maps.Copy(v1beta2Storage, storage.NewTopologyStorage(cache))
+	if workerClients != nil {
+		maps.Copy(v1beta2Storage, storage.NewMultiKueueStorage(kueueMgr, workerClients))
+	}
+	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta2.GroupVersion.Version] = v1beta2Storage
+	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta1.GroupVersion.Version] = queueStorage
 	apiGroupInfo.PrioritizedVersions = []schema.GroupVersion{visibilityv1beta2.GroupVersion, visibilityv1beta1.GroupVersion}
 	return server.InstallAPIGroups(&apiGroupInfo)
 }
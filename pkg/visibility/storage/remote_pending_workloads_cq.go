@@ -0,0 +1,121 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
+)
+
+type remotePendingWorkloadsInCqREST struct {
+	queueMgr      *qcache.Manager
+	workerClients *workers.Clients
+	log           logr.Logger
+}
+
+var _ rest.Storage = &remotePendingWorkloadsInCqREST{}
+var _ rest.GetterWithOptions = &remotePendingWorkloadsInCqREST{}
+var _ rest.Scoper = &remotePendingWorkloadsInCqREST{}
+
+func NewRemotePendingWorkloadsInCqREST(kueueMgr *qcache.Manager, workerClients *workers.Clients) *remotePendingWorkloadsInCqREST {
+	return &remotePendingWorkloadsInCqREST{
+		queueMgr:      kueueMgr,
+		workerClients: workerClients,
+		log:           ctrl.Log.WithName("remote-pending-workload-in-cq"),
+	}
+}
+
+// New implements rest.Storage interface
+func (m *remotePendingWorkloadsInCqREST) New() runtime.Object {
+	return &visibility.RemotePendingWorkloadsSummary{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *remotePendingWorkloadsInCqREST) Destroy() {}
+
+// Get implements rest.GetterWithOptions interface
+// It fetches information about the workloads dispatched by this manager which are pending
+// in the ClusterQueue with the same name in each MultiKueue worker cluster.
+// The query params are applied to the pending workloads of all the worker clusters,
+// ordered by their positions in the ClusterQueues.
+func (m *remotePendingWorkloadsInCqREST) Get(ctx context.Context, name string, opts runtime.Object) (runtime.Object, error) {
+	pendingWorkloadOpts, ok := opts.(*visibility.PendingWorkloadOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", opts)
+	}
+
+	cqName := kueue.ClusterQueueReference(name)
+	if m.queueMgr.PendingWorkloadsInfo(cqName) == nil {
+		return nil, errors.NewNotFound(visibility.Resource("clusterqueue"), name)
+	}
+
+	log := m.log.WithValues("clusterQueue", name)
+	positionInCq := func(wl *visibility.PendingWorkload) int32 { return wl.PositionInClusterQueue }
+	return remotePendingWorkloadsSummary(ctx, log, m.workerClients, pendingWorkloadOpts, positionInCq,
+		func(ctx context.Context, c *workers.Client) (*visibility.RemoteClusterQueueStatus, []visibility.PendingWorkload, error) {
+			return remotePendingWorkloadsInCq(ctx, c, cqName)
+		}), nil
+}
+
+// NewGetOptions creates a new options object
+func (m *remotePendingWorkloadsInCqREST) NewGetOptions() (runtime.Object, bool, string) {
+	// If no query parameters were passed the generated defaults function are not executed so it's necessary to set default values here as well
+	return &visibility.PendingWorkloadOptions{
+		Limit: constants.DefaultPendingWorkloadsLimit,
+	}, false, ""
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *remotePendingWorkloadsInCqREST) NamespaceScoped() bool {
+	return false
+}
+
+// remotePendingWorkloadsInCq reads, from the worker cluster, the status of the ClusterQueue
+// and the pending workloads dispatched by this manager to the ClusterQueue.
+// Only the pending workloads returned by the visibility API of the worker cluster with its
+// default limit are considered.
+func remotePendingWorkloadsInCq(ctx context.Context, c *workers.Client, cqName kueue.ClusterQueueReference) (*visibility.RemoteClusterQueueStatus, []visibility.PendingWorkload, error) {
+	cqStatus, err := remoteClusterQueueStatus(ctx, c, cqName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dispatched, err := dispatchedWorkloads(ctx, c)
+	if err != nil {
+		return cqStatus, nil, err
+	}
+
+	summary := &visibility.PendingWorkloadsSummary{}
+	remoteCq := &visibility.ClusterQueue{ObjectMeta: metav1.ObjectMeta{Name: string(cqName)}}
+	if err := c.SubResource("pendingworkloads").Get(ctx, remoteCq, summary); err != nil {
+		return cqStatus, nil, err
+	}
+	return cqStatus, filterDispatched(summary.Items, dispatched), nil
+}
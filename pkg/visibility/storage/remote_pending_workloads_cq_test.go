@@ -0,0 +1,190 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestRemotePendingWorkloadsInCQ(t *testing.T) {
+	const (
+		cqName = "cq"
+		origin = "origin"
+	)
+
+	defaultQueryParams := &visibility.PendingWorkloadOptions{
+		Offset: 0,
+		Limit:  constants.DefaultPendingWorkloadsLimit,
+	}
+
+	pendingWorkload := func(nsName, name string, positionInCq int32) visibility.PendingWorkload {
+		return visibility.PendingWorkload{
+			ObjectMeta:             metav1.ObjectMeta{Name: name, Namespace: nsName},
+			LocalQueueName:         "lq",
+			PositionInClusterQueue: positionInCq,
+		}
+	}
+	remotePendingWorkload := func(clusterName string, wl visibility.PendingWorkload) visibility.RemotePendingWorkload {
+		return visibility.RemotePendingWorkload{ClusterName: clusterName, PendingWorkload: wl}
+	}
+
+	worker1 := remoteWorker{
+		objs: []client.Object{
+			utiltestingapi.MakeClusterQueue(cqName).PendingWorkloads(3).Obj(),
+			utiltestingapi.MakeWorkload("a", "ns1").Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+			utiltestingapi.MakeWorkload("b", "ns2").Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+			utiltestingapi.MakeWorkload("b", "ns1").Obj(),
+		},
+		pending: []visibility.PendingWorkload{
+			pendingWorkload("ns1", "b", 0),
+			pendingWorkload("ns1", "a", 1),
+			pendingWorkload("ns2", "b", 2),
+		},
+	}
+	worker2 := remoteWorker{
+		objs: []client.Object{
+			utiltestingapi.MakeClusterQueue(cqName).PendingWorkloads(1).Obj(),
+			utiltestingapi.MakeWorkload("b", "ns2").Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+		},
+		pending: []visibility.PendingWorkload{
+			pendingWorkload("ns2", "b", 0),
+		},
+	}
+
+	cases := map[string]struct {
+		workers      map[string]remoteWorker
+		cqName       string
+		queryParams  *visibility.PendingWorkloadOptions
+		wantClusters []visibility.RemoteClusterPendingWorkloads
+		wantItems    []visibility.RemotePendingWorkload
+		wantErr      func(error) bool
+	}{
+		"workloads of all the worker clusters are ordered by their positions in the ClusterQueues": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+				"worker2": worker2,
+			},
+			cqName:      cqName,
+			queryParams: defaultQueryParams,
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{
+				{
+					Name:         "worker1",
+					ClusterQueue: &visibility.RemoteClusterQueueStatus{Name: cqName, PendingWorkloads: 3},
+				},
+				{
+					Name:         "worker2",
+					ClusterQueue: &visibility.RemoteClusterQueueStatus{Name: cqName, PendingWorkloads: 1},
+				},
+			},
+			wantItems: []visibility.RemotePendingWorkload{
+				remotePendingWorkload("worker2", pendingWorkload("ns2", "b", 0)),
+				remotePendingWorkload("worker1", pendingWorkload("ns1", "a", 1)),
+				remotePendingWorkload("worker1", pendingWorkload("ns2", "b", 2)),
+			},
+		},
+		"offset and limit are applied to the workloads of all the worker clusters": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+				"worker2": worker2,
+			},
+			cqName:      cqName,
+			queryParams: &visibility.PendingWorkloadOptions{Offset: 1, Limit: 1},
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{
+				{
+					Name:         "worker1",
+					ClusterQueue: &visibility.RemoteClusterQueueStatus{Name: cqName, PendingWorkloads: 3},
+				},
+				{
+					Name:         "worker2",
+					ClusterQueue: &visibility.RemoteClusterQueueStatus{Name: cqName, PendingWorkloads: 1},
+				},
+			},
+			wantItems: []visibility.RemotePendingWorkload{
+				remotePendingWorkload("worker1", pendingWorkload("ns1", "a", 1)),
+			},
+		},
+		"the error is reported for a worker without the ClusterQueue": {
+			workers: map[string]remoteWorker{
+				"worker1": {},
+			},
+			cqName:      cqName,
+			queryParams: defaultQueryParams,
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{
+				{
+					Name:  "worker1",
+					Error: `clusterqueues.kueue.x-k8s.io "cq" not found`,
+				},
+			},
+		},
+		"nonexistent ClusterQueue in the manager": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+			},
+			cqName:      "nonexistent",
+			queryParams: defaultQueryParams,
+			wantErr:     errors.IsNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			manager := qcache.NewManagerForUnitTests(utiltesting.NewFakeClient(), nil)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go manager.CleanUpOnContext(ctx)
+			if err := manager.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue(cqName).Obj()); err != nil {
+				t.Fatalf("Adding cluster queue: %v", err)
+			}
+
+			wantRemoteCq := &visibility.ClusterQueue{ObjectMeta: metav1.ObjectMeta{Name: cqName}}
+			workerClients := newRemoteWorkerClients(t, tc.workers, origin, wantRemoteCq)
+
+			remotePendingWorkloadsInCqRest := NewRemotePendingWorkloadsInCqREST(manager, workerClients)
+			info, err := remotePendingWorkloadsInCqRest.Get(ctx, tc.cqName, tc.queryParams)
+			switch {
+			case tc.wantErr != nil:
+				if !tc.wantErr(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			case err != nil:
+				t.Error(err)
+			default:
+				summary := info.(*visibility.RemotePendingWorkloadsSummary)
+				if diff := cmp.Diff(tc.wantClusters, summary.Clusters, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Remote clusters differ: (-want,+got):\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.wantItems, summary.Items, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Remote pending workloads differ: (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
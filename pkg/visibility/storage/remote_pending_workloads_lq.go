@@ -0,0 +1,229 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+)
+
+// remoteRequestTimeout is the maximum time spent reading the pending workloads
+// from the worker clusters.
+const remoteRequestTimeout = 10 * time.Second
+
+type remotePendingWorkloadsInLqREST struct {
+	queueMgr      *qcache.Manager
+	workerClients *workers.Clients
+	log           logr.Logger
+}
+
+var _ rest.Storage = &remotePendingWorkloadsInLqREST{}
+var _ rest.GetterWithOptions = &remotePendingWorkloadsInLqREST{}
+var _ rest.Scoper = &remotePendingWorkloadsInLqREST{}
+
+func NewRemotePendingWorkloadsInLqREST(kueueMgr *qcache.Manager, workerClients *workers.Clients) *remotePendingWorkloadsInLqREST {
+	return &remotePendingWorkloadsInLqREST{
+		queueMgr:      kueueMgr,
+		workerClients: workerClients,
+		log:           ctrl.Log.WithName("remote-pending-workload-in-lq"),
+	}
+}
+
+// New implements rest.Storage interface
+func (m *remotePendingWorkloadsInLqREST) New() runtime.Object {
+	return &visibility.RemotePendingWorkloadsSummary{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *remotePendingWorkloadsInLqREST) Destroy() {}
+
+// Get implements rest.GetterWithOptions interface
+// It fetches information about the workloads dispatched by this manager which are pending
+// in the LocalQueue with the same namespace and name in each MultiKueue worker cluster.
+// The query params are applied to the pending workloads of all the worker clusters,
+// ordered by their positions in the LocalQueues.
+func (m *remotePendingWorkloadsInLqREST) Get(ctx context.Context, name string, opts runtime.Object) (runtime.Object, error) {
+	pendingWorkloadOpts, ok := opts.(*visibility.PendingWorkloadOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", opts)
+	}
+
+	namespace := genericapirequest.NamespaceValue(ctx)
+	lqName := kueue.LocalQueueName(name)
+	if _, ok := m.queueMgr.ClusterQueueFromLocalQueue(utilqueue.NewLocalQueueReference(namespace, lqName)); !ok {
+		return nil, errors.NewNotFound(visibility.Resource("localqueue"), name)
+	}
+
+	log := m.log.WithValues("localQueue", klog.KRef(namespace, name))
+	positionInLq := func(wl *visibility.PendingWorkload) int32 { return wl.PositionInLocalQueue }
+	return remotePendingWorkloadsSummary(ctx, log, m.workerClients, pendingWorkloadOpts, positionInLq,
+		func(ctx context.Context, c *workers.Client) (*visibility.RemoteClusterQueueStatus, []visibility.PendingWorkload, error) {
+			return remotePendingWorkloadsInLq(ctx, c, namespace, lqName)
+		}), nil
+}
+
+// NewGetOptions creates a new options object
+func (m *remotePendingWorkloadsInLqREST) NewGetOptions() (runtime.Object, bool, string) {
+	// If no query parameters were passed the generated defaults function are not executed so it's necessary to set default values here as well
+	return &visibility.PendingWorkloadOptions{
+		Limit: constants.DefaultPendingWorkloadsLimit,
+	}, false, ""
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *remotePendingWorkloadsInLqREST) NamespaceScoped() bool {
+	return true
+}
+
+// remoteQueueReader reads, from a worker cluster, the status of the ClusterQueue
+// and the workloads dispatched by this manager which are pending in the queue.
+type remoteQueueReader func(ctx context.Context, c *workers.Client) (*visibility.RemoteClusterQueueStatus, []visibility.PendingWorkload, error)
+
+// remotePendingWorkloadsSummary reads the queue from all the worker clusters in
+// parallel, within remoteRequestTimeout. The pending workloads of all the worker
+// clusters are ordered by their positions, and by worker cluster name for the
+// same position, before the query params are applied.
+func remotePendingWorkloadsSummary(ctx context.Context, log logr.Logger, workerClients *workers.Clients, opts *visibility.PendingWorkloadOptions, position func(*visibility.PendingWorkload) int32, read remoteQueueReader) *visibility.RemotePendingWorkloadsSummary {
+	ctx, cancel := context.WithTimeout(ctx, remoteRequestTimeout)
+	defer cancel()
+
+	clusterNames := workerClients.Keys()
+	slices.Sort(clusterNames)
+	clusters := make([]visibility.RemoteClusterPendingWorkloads, len(clusterNames))
+	pending := make([][]visibility.PendingWorkload, len(clusterNames))
+	var wg sync.WaitGroup
+	for i, clusterName := range clusterNames {
+		clusters[i].Name = clusterName
+		workerClient, found := workerClients.Get(clusterName)
+		if !found {
+			clusters[i].Error = "worker cluster disconnected"
+			continue
+		}
+		wg.Go(func() {
+			cqStatus, wls, err := read(ctx, workerClient)
+			if err != nil {
+				log.V(3).Info("Unable to read the pending workloads in the worker cluster", "clusterName", clusterName, "error", err)
+				clusters[i].Error = err.Error()
+			}
+			clusters[i].ClusterQueue = cqStatus
+			pending[i] = wls
+		})
+	}
+	wg.Wait()
+
+	var items []visibility.RemotePendingWorkload
+	for i, wls := range pending {
+		for _, wl := range wls {
+			items = append(items, visibility.RemotePendingWorkload{ClusterName: clusterNames[i], PendingWorkload: wl})
+		}
+	}
+	// The items are already ordered by cluster name and, within a cluster, by position.
+	slices.SortStableFunc(items, func(a, b visibility.RemotePendingWorkload) int {
+		return cmp.Compare(position(&a.PendingWorkload), position(&b.PendingWorkload))
+	})
+	start := min(int(opts.Offset), len(items))
+	end := min(start+int(opts.Limit), len(items))
+
+	return &visibility.RemotePendingWorkloadsSummary{
+		Clusters: clusters,
+		Items:    items[start:end],
+	}
+}
+
+// remotePendingWorkloadsInLq reads, from the worker cluster, the status of the ClusterQueue
+// the LocalQueue points to and the pending workloads dispatched by this manager to the LocalQueue.
+// Only the pending workloads returned by the visibility API of the worker cluster with its
+// default limit are considered.
+func remotePendingWorkloadsInLq(ctx context.Context, c *workers.Client, namespace string, lqName kueue.LocalQueueName) (*visibility.RemoteClusterQueueStatus, []visibility.PendingWorkload, error) {
+	lq := &kueue.LocalQueue{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: string(lqName)}, lq); err != nil {
+		return nil, nil, err
+	}
+	cqStatus, err := remoteClusterQueueStatus(ctx, c, lq.Spec.ClusterQueue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dispatched, err := dispatchedWorkloads(ctx, c, client.InNamespace(namespace))
+	if err != nil {
+		return cqStatus, nil, err
+	}
+
+	summary := &visibility.PendingWorkloadsSummary{}
+	remoteLq := &visibility.LocalQueue{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: string(lqName)}}
+	if err := c.SubResource("pendingworkloads").Get(ctx, remoteLq, summary); err != nil {
+		return cqStatus, nil, err
+	}
+	return cqStatus, filterDispatched(summary.Items, dispatched), nil
+}
+
+func remoteClusterQueueStatus(ctx context.Context, c *workers.Client, cqName kueue.ClusterQueueReference) (*visibility.RemoteClusterQueueStatus, error) {
+	cq := &kueue.ClusterQueue{}
+	if err := c.Get(ctx, types.NamespacedName{Name: string(cqName)}, cq); err != nil {
+		return nil, err
+	}
+	return &visibility.RemoteClusterQueueStatus{
+		Name:               cqName,
+		PendingWorkloads:   cq.Status.PendingWorkloads,
+		ReservingWorkloads: cq.Status.ReservingWorkloads,
+		AdmittedWorkloads:  cq.Status.AdmittedWorkloads,
+	}, nil
+}
+
+// dispatchedWorkloads returns the keys of the workloads dispatched by this manager to the worker cluster.
+func dispatchedWorkloads(ctx context.Context, c *workers.Client, opts ...client.ListOption) (sets.Set[types.NamespacedName], error) {
+	remoteWls := &metav1.PartialObjectMetadataList{}
+	remoteWls.SetGroupVersionKind(kueue.GroupVersion.WithKind("WorkloadList"))
+	opts = append(opts, client.MatchingLabels{kueue.MultiKueueOriginLabel: c.Origin})
+	if err := c.List(ctx, remoteWls, opts...); err != nil {
+		return nil, err
+	}
+	dispatched := sets.New[types.NamespacedName]()
+	for _, wl := range remoteWls.Items {
+		dispatched.Insert(types.NamespacedName{Namespace: wl.Namespace, Name: wl.Name})
+	}
+	return dispatched, nil
+}
+
+func filterDispatched(wls []visibility.PendingWorkload, dispatched sets.Set[types.NamespacedName]) []visibility.PendingWorkload {
+	return slices.DeleteFunc(wls, func(wl visibility.PendingWorkload) bool {
+		return !dispatched.Has(types.NamespacedName{Namespace: wl.Namespace, Name: wl.Name})
+	})
+}
@@ -0,0 +1,275 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+type remoteWorker struct {
+	objs    []client.Object
+	pending []visibility.PendingWorkload
+}
+
+func newRemoteWorkerClients(t *testing.T, remoteWorkers map[string]remoteWorker, origin string, wantSubResourceOf client.Object) *workers.Clients {
+	t.Helper()
+	workerClients := workers.NewClients()
+	for workerName, w := range remoteWorkers {
+		c := utiltesting.NewClientBuilder().
+			WithObjects(w.objs...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceGet: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, subResource client.Object, _ ...client.SubResourceGetOption) error {
+					if diff := cmp.Diff(wantSubResourceOf, obj); diff != "" || subResourceName != "pendingworkloads" {
+						t.Errorf("Unexpected subresource get %q (-want,+got):\n%s", subResourceName, diff)
+					}
+					subResource.(*visibility.PendingWorkloadsSummary).Items = append([]visibility.PendingWorkload(nil), w.pending...)
+					return nil
+				},
+			}).
+			Build()
+		workerClients.Add(workerName, &workers.Client{Client: c, Origin: origin})
+	}
+	return workerClients
+}
+
+func TestRemotePendingWorkloadsInLQ(t *testing.T) {
+	const (
+		nsName = "ns"
+		cqName = "cq"
+		lqName = "lq"
+		origin = "origin"
+	)
+
+	defaultQueryParams := &visibility.PendingWorkloadOptions{
+		Offset: 0,
+		Limit:  constants.DefaultPendingWorkloadsLimit,
+	}
+
+	pendingWorkload := func(name string, positionInCq, positionInLq int32) visibility.PendingWorkload {
+		return visibility.PendingWorkload{
+			ObjectMeta:             metav1.ObjectMeta{Name: name, Namespace: nsName},
+			LocalQueueName:         lqName,
+			PositionInClusterQueue: positionInCq,
+			PositionInLocalQueue:   positionInLq,
+		}
+	}
+	remotePendingWorkload := func(clusterName string, wl visibility.PendingWorkload) visibility.RemotePendingWorkload {
+		return visibility.RemotePendingWorkload{ClusterName: clusterName, PendingWorkload: wl}
+	}
+
+	worker1 := remoteWorker{
+		objs: []client.Object{
+			utiltestingapi.MakeClusterQueue("worker-cq").PendingWorkloads(3).AdmittedWorkloads(1).Obj(),
+			utiltestingapi.MakeLocalQueue(lqName, nsName).ClusterQueue("worker-cq").Obj(),
+			utiltestingapi.MakeWorkload("a", nsName).Queue(lqName).Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+			utiltestingapi.MakeWorkload("b", nsName).Queue(lqName).Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+			utiltestingapi.MakeWorkload("c", nsName).Queue(lqName).Label(kueue.MultiKueueOriginLabel, "other-origin").Obj(),
+			utiltestingapi.MakeWorkload("local", nsName).Queue(lqName).Obj(),
+		},
+		pending: []visibility.PendingWorkload{
+			pendingWorkload("local", 0, 0),
+			pendingWorkload("a", 1, 1),
+			pendingWorkload("c", 2, 2),
+			pendingWorkload("b", 3, 3),
+		},
+	}
+	worker2 := remoteWorker{
+		objs: []client.Object{
+			utiltestingapi.MakeClusterQueue("worker-cq").PendingWorkloads(2).Obj(),
+			utiltestingapi.MakeLocalQueue(lqName, nsName).ClusterQueue("worker-cq").Obj(),
+			utiltestingapi.MakeWorkload("a", nsName).Queue(lqName).Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+			utiltestingapi.MakeWorkload("b", nsName).Queue(lqName).Label(kueue.MultiKueueOriginLabel, origin).Obj(),
+		},
+		pending: []visibility.PendingWorkload{
+			pendingWorkload("b", 0, 0),
+			pendingWorkload("a", 1, 1),
+		},
+	}
+	worker1Status := visibility.RemoteClusterPendingWorkloads{
+		Name: "worker1",
+		ClusterQueue: &visibility.RemoteClusterQueueStatus{
+			Name:              "worker-cq",
+			PendingWorkloads:  3,
+			AdmittedWorkloads: 1,
+		},
+	}
+	worker2Status := visibility.RemoteClusterPendingWorkloads{
+		Name: "worker2",
+		ClusterQueue: &visibility.RemoteClusterQueueStatus{
+			Name:             "worker-cq",
+			PendingWorkloads: 2,
+		},
+	}
+
+	cases := map[string]struct {
+		workers      map[string]remoteWorker
+		req          *req
+		wantClusters []visibility.RemoteClusterPendingWorkloads
+		wantItems    []visibility.RemotePendingWorkload
+		wantErr      func(error) bool
+	}{
+		"workloads dispatched by the manager are reported": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+			},
+			req: &req{
+				nsName:      nsName,
+				queueName:   lqName,
+				queryParams: defaultQueryParams,
+			},
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{worker1Status},
+			wantItems: []visibility.RemotePendingWorkload{
+				remotePendingWorkload("worker1", pendingWorkload("a", 1, 1)),
+				remotePendingWorkload("worker1", pendingWorkload("b", 3, 3)),
+			},
+		},
+		"workloads of all the worker clusters are ordered by their positions in the LocalQueues": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+				"worker2": worker2,
+			},
+			req: &req{
+				nsName:      nsName,
+				queueName:   lqName,
+				queryParams: defaultQueryParams,
+			},
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{worker1Status, worker2Status},
+			wantItems: []visibility.RemotePendingWorkload{
+				remotePendingWorkload("worker2", pendingWorkload("b", 0, 0)),
+				remotePendingWorkload("worker1", pendingWorkload("a", 1, 1)),
+				remotePendingWorkload("worker2", pendingWorkload("a", 1, 1)),
+				remotePendingWorkload("worker1", pendingWorkload("b", 3, 3)),
+			},
+		},
+		"offset and limit are applied to the workloads of all the worker clusters": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+				"worker2": worker2,
+			},
+			req: &req{
+				nsName:      nsName,
+				queueName:   lqName,
+				queryParams: &visibility.PendingWorkloadOptions{Offset: 1, Limit: 2},
+			},
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{worker1Status, worker2Status},
+			wantItems: []visibility.RemotePendingWorkload{
+				remotePendingWorkload("worker1", pendingWorkload("a", 1, 1)),
+				remotePendingWorkload("worker2", pendingWorkload("a", 1, 1)),
+			},
+		},
+		"offset beyond the workloads of all the worker clusters": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+				"worker2": worker2,
+			},
+			req: &req{
+				nsName:      nsName,
+				queueName:   lqName,
+				queryParams: &visibility.PendingWorkloadOptions{Offset: 10, Limit: 2},
+			},
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{worker1Status, worker2Status},
+		},
+		"the error is reported for a worker without the LocalQueue": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+				"worker2": {},
+			},
+			req: &req{
+				nsName:      nsName,
+				queueName:   lqName,
+				queryParams: defaultQueryParams,
+			},
+			wantClusters: []visibility.RemoteClusterPendingWorkloads{
+				worker1Status,
+				{
+					Name:  "worker2",
+					Error: `localqueues.kueue.x-k8s.io "lq" not found`,
+				},
+			},
+			wantItems: []visibility.RemotePendingWorkload{
+				remotePendingWorkload("worker1", pendingWorkload("a", 1, 1)),
+				remotePendingWorkload("worker1", pendingWorkload("b", 3, 3)),
+			},
+		},
+		"nonexistent LocalQueue in the manager": {
+			workers: map[string]remoteWorker{
+				"worker1": worker1,
+			},
+			req: &req{
+				nsName:      nsName,
+				queueName:   "nonexistent",
+				queryParams: defaultQueryParams,
+			},
+			wantErr: errors.IsNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			manager := qcache.NewManagerForUnitTests(utiltesting.NewFakeClient(), nil)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go manager.CleanUpOnContext(ctx)
+			if err := manager.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue(cqName).Obj()); err != nil {
+				t.Fatalf("Adding cluster queue: %v", err)
+			}
+			if err := manager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue(lqName, nsName).ClusterQueue(cqName).Obj()); err != nil {
+				t.Fatalf("Adding queue: %v", err)
+			}
+
+			wantRemoteLq := &visibility.LocalQueue{ObjectMeta: metav1.ObjectMeta{Namespace: nsName, Name: lqName}}
+			workerClients := newRemoteWorkerClients(t, tc.workers, origin, wantRemoteLq)
+
+			remotePendingWorkloadsInLqRest := NewRemotePendingWorkloadsInLqREST(manager, workerClients)
+			ctx = request.WithNamespace(ctx, tc.req.nsName)
+			info, err := remotePendingWorkloadsInLqRest.Get(ctx, tc.req.queueName, tc.req.queryParams)
+			switch {
+			case tc.wantErr != nil:
+				if !tc.wantErr(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			case err != nil:
+				t.Error(err)
+			default:
+				summary := info.(*visibility.RemotePendingWorkloadsSummary)
+				if diff := cmp.Diff(tc.wantClusters, summary.Clusters, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Remote clusters differ: (-want,+got):\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.wantItems, summary.Items, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Remote pending workloads differ: (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
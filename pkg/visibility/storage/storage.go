@@ -20,6 +20,8 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/workers"
 )
 
 func NewStorage(mgr *qcache.Manager) map[string]rest.Storage {
@@ -30,3 +32,21 @@ func NewStorage(mgr *qcache.Manager) map[string]rest.Storage {
 		"localqueues/pendingworkloads":   NewPendingWorkloadsInLqREST(mgr),
 	}
 }
+
+// NewTopologyStorage returns the storage for the Topology resources, which
+// are only served in the v1beta2 version of the API.
+func NewTopologyStorage(cache *schdcache.Cache) map[string]rest.Storage {
+	return map[string]rest.Storage{
+		"topologies":          NewTopologyREST(),
+		"topologies/capacity": NewTopologyCapacityREST(cache),
+	}
+}
+
+// NewMultiKueueStorage returns the storage for the pending workloads in the
+// MultiKueue worker clusters, which are only served in the v1beta2 version of the API.
+func NewMultiKueueStorage(mgr *qcache.Manager, workerClients *workers.Clients) map[string]rest.Storage {
+	return map[string]rest.Storage{
+		"clusterqueues/remotependingworkloads": NewRemotePendingWorkloadsInCqREST(mgr, workerClients),
+		"localqueues/remotependingworkloads":   NewRemotePendingWorkloadsInLqREST(mgr, workerClients),
+	}
+}
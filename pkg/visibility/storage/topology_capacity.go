@@ -0,0 +1,194 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type topologyCapacityREST struct {
+	cache *schdcache.Cache
+	log   logr.Logger
+}
+
+var _ rest.Storage = &topologyCapacityREST{}
+var _ rest.Getter = &topologyCapacityREST{}
+var _ rest.Scoper = &topologyCapacityREST{}
+
+func NewTopologyCapacityREST(cache *schdcache.Cache) *topologyCapacityREST {
+	return &topologyCapacityREST{
+		cache: cache,
+		log:   ctrl.Log.WithName("topology-capacity"),
+	}
+}
+
+// New implements rest.Storage interface
+func (m *topologyCapacityREST) New() runtime.Object {
+	return &visibility.TopologyCapacity{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *topologyCapacityREST) Destroy() {}
+
+// Get implements rest.Getter interface
+// It builds the tree of topology domains, with their capacity and usage,
+// for each ResourceFlavor referencing the Topology.
+func (m *topologyCapacityREST) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	tasCache := m.cache.TASCache()
+	levels, found := tasCache.TopologyLevels(kueue.TopologyReference(name))
+	if !found {
+		return nil, errors.NewNotFound(visibility.Resource("topology"), name)
+	}
+
+	ctx = ctrl.LoggerInto(ctx, m.log.WithValues("topology", name))
+	flavors := make([]visibility.FlavorTopologyCapacity, 0)
+	for flavorName, flavorCache := range tasCache.Clone() {
+		if flavorCache.Topology() != kueue.TopologyReference(name) {
+			continue
+		}
+		domainsUsage, err := flavorCache.DomainsUsage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("computing usage of topology domains for flavor %q: %w", flavorName, err)
+		}
+		flavors = append(flavors, visibility.FlavorTopologyCapacity{
+			Name:    flavorName,
+			Domains: newTopologyDomainsTree(levels, domainsUsage),
+		})
+	}
+	slices.SortFunc(flavors, func(a, b visibility.FlavorTopologyCapacity) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return &visibility.TopologyCapacity{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Levels:     levels,
+		Flavors:    flavors,
+	}, nil
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *topologyCapacityREST) NamespaceScoped() bool {
+	return false
+}
+
+// topologyDomainNode accumulates the capacity and usage of a topology domain
+// from the lowest-level domains below it.
+type topologyDomainNode struct {
+	value       string
+	capacity    resources.Requests
+	tasUsage    resources.Requests
+	nonTASUsage resources.Requests
+	workloads   []workload.Reference
+	children    []*topologyDomainNode
+}
+
+// newTopologyDomainsTree aggregates the usage of the lowest-level domains into
+// the tree of topology domains, returning the domains at the highest level.
+func newTopologyDomainsTree(levels []string, domainsUsage []schdcache.TopologyDomainUsage) []visibility.TopologyDomainCapacity {
+	root := &topologyDomainNode{}
+	nodes := make(map[utiltas.TopologyDomainID]*topologyDomainNode)
+	for _, domainUsage := range domainsUsage {
+		parent := root
+		for levelIdx := range domainUsage.Values {
+			id := utiltas.DomainID(domainUsage.Values[:levelIdx+1])
+			node, found := nodes[id]
+			if !found {
+				node = &topologyDomainNode{
+					value:       domainUsage.Values[levelIdx],
+					capacity:    resources.Requests{},
+					tasUsage:    resources.Requests{},
+					nonTASUsage: resources.Requests{},
+				}
+				nodes[id] = node
+				parent.children = append(parent.children, node)
+			}
+			node.capacity.Add(domainUsage.Capacity)
+			node.tasUsage.Add(domainUsage.TASUsage)
+			node.nonTASUsage.Add(domainUsage.NonTASUsage)
+			for _, wlKey := range domainUsage.Workloads {
+				if !slices.Contains(node.workloads, wlKey) {
+					node.workloads = append(node.workloads, wlKey)
+				}
+			}
+			parent = node
+		}
+	}
+	return root.toAPI(levels, 0)
+}
+
+func (n *topologyDomainNode) toAPI(levels []string, levelIdx int) []visibility.TopologyDomainCapacity {
+	if len(n.children) == 0 {
+		return nil
+	}
+	domains := make([]visibility.TopologyDomainCapacity, 0, len(n.children))
+	for _, child := range n.children {
+		slices.Sort(child.workloads)
+		wls := make([]visibility.TopologyDomainWorkload, 0, len(child.workloads))
+		for _, wlKey := range child.workloads {
+			namespace, name, _ := strings.Cut(string(wlKey), "/")
+			wls = append(wls, visibility.TopologyDomainWorkload{Namespace: namespace, Name: name})
+		}
+		domains = append(domains, visibility.TopologyDomainCapacity{
+			Level:       levels[levelIdx],
+			Value:       child.value,
+			Capacity:    child.capacity.ToResourceList(),
+			TASUsage:    nonEmptyResourceList(child.tasUsage),
+			NonTASUsage: nonEmptyResourceList(child.nonTASUsage),
+			Workloads:   wls,
+			Domains:     child.toAPI(levels, levelIdx+1),
+		})
+	}
+	slices.SortFunc(domains, func(a, b visibility.TopologyDomainCapacity) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return domains
+}
+
+// nonEmptyResourceList converts the requests to a ResourceList, skipping the
+// resources with no usage.
+func nonEmptyResourceList(r resources.Requests) corev1.ResourceList {
+	var rl corev1.ResourceList
+	for name, value := range r {
+		if value == 0 {
+			continue
+		}
+		if rl == nil {
+			rl = make(corev1.ResourceList)
+		}
+		rl[name] = resources.ResourceQuantity(name, value)
+	}
+	return rl
+}
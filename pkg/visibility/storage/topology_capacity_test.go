@@ -0,0 +1,281 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	tasBlockLabel = "cloud.com/topology-block"
+)
+
+func TestTopologyCapacity(t *testing.T) {
+	nodes := []client.Object{
+		testingnode.MakeNode("x1").
+			Label(tasBlockLabel, "b1").
+			Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("2"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj(),
+		testingnode.MakeNode("x2").
+			Label(tasBlockLabel, "b2").
+			Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("4"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj(),
+	}
+
+	cases := map[string]struct {
+		topologies   []*kueue.Topology
+		flavors      []*kueue.ResourceFlavor
+		topologyName string
+		wantResp     *visibility.TopologyCapacity
+		wantErrMatch func(error) bool
+	}{
+		"topology not found": {
+			topologyName: "missing",
+			wantErrMatch: errors.IsNotFound,
+		},
+		"topology without flavors": {
+			topologies: []*kueue.Topology{
+				utiltestingapi.MakeTopology("default").Levels(tasBlockLabel, corev1.LabelHostname).Obj(),
+			},
+			topologyName: "default",
+			wantResp: &visibility.TopologyCapacity{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Levels:     []string{tasBlockLabel, corev1.LabelHostname},
+				Flavors:    []visibility.FlavorTopologyCapacity{},
+			},
+		},
+		"topology with flavors": {
+			topologies: []*kueue.Topology{
+				utiltestingapi.MakeTopology("default").Levels(tasBlockLabel, corev1.LabelHostname).Obj(),
+				utiltestingapi.MakeTopology("other").Levels(tasBlockLabel).Obj(),
+			},
+			flavors: []*kueue.ResourceFlavor{
+				utiltestingapi.MakeResourceFlavor("tas-b").TopologyName("default").NodeLabel(tasBlockLabel, "b2").Obj(),
+				utiltestingapi.MakeResourceFlavor("tas-a").TopologyName("default").Obj(),
+				utiltestingapi.MakeResourceFlavor("tas-other").TopologyName("other").Obj(),
+			},
+			topologyName: "default",
+			wantResp: &visibility.TopologyCapacity{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Levels:     []string{tasBlockLabel, corev1.LabelHostname},
+				Flavors: []visibility.FlavorTopologyCapacity{
+					{
+						Name: "tas-a",
+						Domains: []visibility.TopologyDomainCapacity{
+							{
+								Level: tasBlockLabel,
+								Value: "b1",
+								Capacity: corev1.ResourceList{
+									corev1.ResourceCPU:  resource.MustParse("2"),
+									corev1.ResourcePods: resource.MustParse("10"),
+								},
+								Workloads: []visibility.TopologyDomainWorkload{},
+								Domains: []visibility.TopologyDomainCapacity{{
+									Level: corev1.LabelHostname,
+									Value: "x1",
+									Capacity: corev1.ResourceList{
+										corev1.ResourceCPU:  resource.MustParse("2"),
+										corev1.ResourcePods: resource.MustParse("10"),
+									},
+									Workloads: []visibility.TopologyDomainWorkload{},
+								}},
+							},
+							{
+								Level: tasBlockLabel,
+								Value: "b2",
+								Capacity: corev1.ResourceList{
+									corev1.ResourceCPU:  resource.MustParse("4"),
+									corev1.ResourcePods: resource.MustParse("10"),
+								},
+								Workloads: []visibility.TopologyDomainWorkload{},
+								Domains: []visibility.TopologyDomainCapacity{{
+									Level: corev1.LabelHostname,
+									Value: "x2",
+									Capacity: corev1.ResourceList{
+										corev1.ResourceCPU:  resource.MustParse("4"),
+										corev1.ResourcePods: resource.MustParse("10"),
+									},
+									Workloads: []visibility.TopologyDomainWorkload{},
+								}},
+							},
+						},
+					},
+					{
+						Name: "tas-b",
+						Domains: []visibility.TopologyDomainCapacity{{
+							Level: tasBlockLabel,
+							Value: "b2",
+							Capacity: corev1.ResourceList{
+								corev1.ResourceCPU:  resource.MustParse("4"),
+								corev1.ResourcePods: resource.MustParse("10"),
+							},
+							Workloads: []visibility.TopologyDomainWorkload{},
+							Domains: []visibility.TopologyDomainCapacity{{
+								Level: corev1.LabelHostname,
+								Value: "x2",
+								Capacity: corev1.ResourceList{
+									corev1.ResourceCPU:  resource.MustParse("4"),
+									corev1.ResourcePods: resource.MustParse("10"),
+								},
+								Workloads: []visibility.TopologyDomainWorkload{},
+							}},
+						}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder().WithObjects(nodes...)
+			if err := indexer.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Failed to setup indexes: %v", err)
+			}
+			cache := schdcache.New(clientBuilder.Build())
+			for _, topology := range tc.topologies {
+				cache.AddOrUpdateTopology(log, topology)
+			}
+			for _, flavor := range tc.flavors {
+				cache.AddOrUpdateResourceFlavor(log, flavor)
+			}
+
+			topologyCapacityREST := NewTopologyCapacityREST(cache)
+			resp, err := topologyCapacityREST.Get(ctx, tc.topologyName, &metav1.GetOptions{})
+			switch {
+			case tc.wantErrMatch != nil:
+				if !tc.wantErrMatch(err) {
+					t.Errorf("Error differs: (-want,+got):\n%s", cmp.Diff(tc.wantErrMatch, err))
+				}
+			case err != nil:
+				t.Error(err)
+			default:
+				if diff := cmp.Diff(tc.wantResp, resp); diff != "" {
+					t.Errorf("Topology capacity differs: (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNewTopologyDomainsTree(t *testing.T) {
+	levels := []string{tasBlockLabel, corev1.LabelHostname}
+	domainsUsage := []schdcache.TopologyDomainUsage{
+		{
+			Values:      []string{"b1", "x1"},
+			Capacity:    resources.Requests{corev1.ResourceCPU: 2000, corev1.ResourcePods: 10},
+			TASUsage:    resources.Requests{corev1.ResourceCPU: 1500, corev1.ResourcePods: 2},
+			NonTASUsage: resources.Requests{},
+			Workloads:   []workload.Reference{"default/wl-a", "default/wl-b"},
+		},
+		{
+			Values:      []string{"b1", "x2"},
+			Capacity:    resources.Requests{corev1.ResourceCPU: 2000, corev1.ResourcePods: 10},
+			TASUsage:    resources.Requests{corev1.ResourceCPU: 500, corev1.ResourcePods: 1},
+			NonTASUsage: resources.Requests{corev1.ResourceCPU: 500, corev1.ResourcePods: 1},
+			Workloads:   []workload.Reference{"default/wl-b"},
+		},
+	}
+	want := []visibility.TopologyDomainCapacity{{
+		Level: tasBlockLabel,
+		Value: "b1",
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("4"),
+			corev1.ResourcePods: resource.MustParse("20"),
+		},
+		TASUsage: corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("2"),
+			corev1.ResourcePods: resource.MustParse("3"),
+		},
+		NonTASUsage: corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("500m"),
+			corev1.ResourcePods: resource.MustParse("1"),
+		},
+		Workloads: []visibility.TopologyDomainWorkload{
+			{Namespace: "default", Name: "wl-a"},
+			{Namespace: "default", Name: "wl-b"},
+		},
+		Domains: []visibility.TopologyDomainCapacity{
+			{
+				Level: corev1.LabelHostname,
+				Value: "x1",
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse("2"),
+					corev1.ResourcePods: resource.MustParse("10"),
+				},
+				TASUsage: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse("1500m"),
+					corev1.ResourcePods: resource.MustParse("2"),
+				},
+				Workloads: []visibility.TopologyDomainWorkload{
+					{Namespace: "default", Name: "wl-a"},
+					{Namespace: "default", Name: "wl-b"},
+				},
+			},
+			{
+				Level: corev1.LabelHostname,
+				Value: "x2",
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse("2"),
+					corev1.ResourcePods: resource.MustParse("10"),
+				},
+				TASUsage: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse("500m"),
+					corev1.ResourcePods: resource.MustParse("1"),
+				},
+				NonTASUsage: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse("500m"),
+					corev1.ResourcePods: resource.MustParse("1"),
+				},
+				Workloads: []visibility.TopologyDomainWorkload{
+					{Namespace: "default", Name: "wl-b"},
+				},
+			},
+		},
+	}}
+	got := newTopologyDomainsTree(levels, domainsUsage)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Topology domains tree differs: (-want,+got):\n%s", diff)
+	}
+}
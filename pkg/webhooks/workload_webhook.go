@@ -19,16 +19,20 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -40,10 +44,12 @@ import (
 	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
-type WorkloadWebhook struct{}
+type WorkloadWebhook struct {
+	client client.Client
+}
 
 func setupWebhookForWorkload(mgr ctrl.Manager, roleTracker *roletracker.RoleTracker) error {
-	wh := &WorkloadWebhook{}
+	wh := &WorkloadWebhook{client: mgr.GetClient()}
 	return ctrl.NewWebhookManagedBy(mgr, &kueue.Workload{}).
 		WithDefaulter(wh).
 		WithValidator(wh).
@@ -78,14 +84,24 @@ var _ admission.Validator[*kueue.Workload] = &WorkloadWebhook{}
 func (w *WorkloadWebhook) ValidateCreate(ctx context.Context, wl *kueue.Workload) (admission.Warnings, error) {
 	log := ctrl.LoggerFrom(ctx).WithName("workload-webhook")
 	log.V(5).Info("Validating create")
-	return nil, ValidateWorkload(wl).ToAggregate()
+	allErrs := ValidateWorkload(wl)
+	allErrs = append(allErrs, validateClusterSelectors(wl)...)
+	allErrs = append(allErrs, w.validateDependencyCycle(ctx, wl)...)
+	return nil, allErrs.ToAggregate()
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
 func (w *WorkloadWebhook) ValidateUpdate(ctx context.Context, oldWL, newWL *kueue.Workload) (admission.Warnings, error) {
 	log := ctrl.LoggerFrom(ctx).WithName("workload-webhook")
 	log.V(5).Info("Validating update")
-	return nil, ValidateWorkloadUpdate(newWL, oldWL).ToAggregate()
+	allErrs := ValidateWorkloadUpdate(newWL, oldWL)
+	if !maps.Equal(newWL.Annotations, oldWL.Annotations) {
+		allErrs = append(allErrs, validateClusterSelectors(newWL)...)
+	}
+	if !maps.Equal(newWL.Labels, oldWL.Labels) {
+		allErrs = append(allErrs, w.validateDependencyCycle(ctx, newWL)...)
+	}
+	return nil, allErrs.ToAggregate()
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
@@ -110,6 +126,9 @@ func ValidateWorkload(obj *kueue.Workload) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("podSets"), variableCountPodSets, "at most one podSet can use minCount"))
 	}
 
+	allErrs = append(allErrs, validateDependencies(obj, specPath.Child("dependencies"))...)
+	allErrs = append(allErrs, validateTasks(obj, specPath.Child("tasks"))...)
+
 	statusPath := field.NewPath("status")
 	if workload.HasQuotaReservation(obj) {
 		allErrs = append(allErrs, validateAdmission(obj, statusPath.Child("admission"))...)
@@ -122,6 +141,67 @@ func ValidateWorkload(obj *kueue.Workload) field.ErrorList {
 	return allErrs
 }
 
+// validateClusterSelectors validates the MultiKueue cluster selector annotations,
+// which are usually copied from the job, already validated by its webhook.
+func validateClusterSelectors(obj *kueue.Workload) field.ErrorList {
+	var allErrs field.ErrorList
+	annotationsPath := field.NewPath("metadata", "annotations")
+	for _, key := range []string{kueue.MultiKueueRequiredClusterSelectorAnnotation, kueue.MultiKueuePreferredClusterSelectorAnnotation} {
+		if value, found := obj.Annotations[key]; found {
+			if _, err := labels.Parse(value); err != nil {
+				allErrs = append(allErrs, field.Invalid(annotationsPath.Key(key), value, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateDependencies(obj *kueue.Workload, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range obj.Spec.Dependencies {
+		dep := &obj.Spec.Dependencies[i]
+		depPath := path.Index(i)
+		if dep.Name != nil && *dep.Name == obj.Name {
+			allErrs = append(allErrs, field.Invalid(depPath.Child("name"), *dep.Name, "a workload cannot depend on itself"))
+		}
+		if dep.Selector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(dep.Selector, metav1validation.LabelSelectorValidationOptions{}, depPath.Child("selector"))...)
+		}
+	}
+	return allErrs
+}
+
+func validateTasks(obj *kueue.Workload, path *field.Path) field.ErrorList {
+	if obj.Spec.Tasks == nil || len(obj.Spec.PodSets) != 1 {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if obj.Spec.PodSets[0].MinCount != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set together with minCount"))
+	}
+	if obj.Spec.PodSets[0].TopologyRequest != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set together with a topology request"))
+	}
+	return allErrs
+}
+
+// validateDependencyCycle checks that the dependencies of the workload don't
+// form a cycle with the dependencies of the other Workloads in its namespace.
+func (w *WorkloadWebhook) validateDependencyCycle(ctx context.Context, wl *kueue.Workload) field.ErrorList {
+	if !features.Enabled(features.WorkloadDependencies) || len(wl.Spec.Dependencies) == 0 {
+		return nil
+	}
+	path := field.NewPath("spec", "dependencies")
+	cycle, err := workload.FindDependencyCycle(ctx, w.client, wl)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	if cycle != nil {
+		return field.ErrorList{field.Forbidden(path, fmt.Sprintf("the dependencies form a cycle: %s", strings.Join(cycle, " -> ")))}
+	}
+	return nil
+}
+
 func validatePodSet(ps *kueue.PodSet, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -276,18 +356,31 @@ func ValidateWorkloadUpdate(newObj, oldObj *kueue.Workload) field.ErrorList {
 	if workload.HasQuotaReservation(newObj) && workload.HasQuotaReservation(oldObj) {
 		allErrs = append(allErrs, validateReclaimablePodsUpdate(newObj, oldObj, field.NewPath("status", "reclaimablePods"))...)
 	}
-	allErrs = append(allErrs, validateAdmissionUpdate(newObj.Status.Admission, oldObj.Status.Admission, field.NewPath("status", "admission"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newObj.Spec.Dependencies, oldObj.Spec.Dependencies, specPath.Child("dependencies"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newObj.Spec.Tasks, oldObj.Spec.Tasks, specPath.Child("tasks"))...)
+	allErrs = append(allErrs, validateAdmissionUpdate(newObj.Status.Admission, oldObj.Status.Admission, workload.IsBulk(newObj), field.NewPath("status", "admission"))...)
 	allErrs = append(allErrs, validateImmutablePodSetUpdates(newObj, oldObj, statusPath.Child("admissionChecks"))...)
 	allErrs = append(allErrs, validateClusterNameUpdate(newObj, oldObj, statusPath)...)
 	return allErrs
 }
 
 // validateAdmissionUpdate validates that admission can be set or unset, but the
-// fields within can't change.
-func validateAdmissionUpdate(new, old *kueue.Admission, path *field.Path) field.ErrorList {
+// fields within can't change, except the count of tasks admitted for the bulk
+// workloads, which can only increase.
+func validateAdmissionUpdate(new, old *kueue.Admission, bulk bool, path *field.Path) field.ErrorList {
 	if old == nil || new == nil {
 		return nil
 	}
+	if bulk && len(new.PodSetAssignments) == 1 && len(old.PodSetAssignments) == 1 {
+		newCount, oldCount := ptr.Deref(new.PodSetAssignments[0].Count, 0), ptr.Deref(old.PodSetAssignments[0].Count, 0)
+		if newCount < oldCount {
+			return field.ErrorList{field.Invalid(path.Child("podSetAssignments").Index(0).Child("count"), newCount, fmt.Sprintf("should be greater or equal to %d", oldCount))}
+		}
+		// Allow to admit more tasks
+		old = old.DeepCopy()
+		old.PodSetAssignments[0].Count = new.PodSetAssignments[0].Count
+		old.PodSetAssignments[0].ResourceUsage = new.PodSetAssignments[0].ResourceUsage
+	}
 	if features.Enabled(features.TopologyAwareScheduling) {
 		if len(new.PodSetAssignments) != len(old.PodSetAssignments) {
 			return apivalidation.ValidateImmutableField(new, old, path)
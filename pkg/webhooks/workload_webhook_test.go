@@ -225,6 +225,59 @@ func TestValidateWorkload(t *testing.T) {
 				field.Invalid(podSetsPath, nil, ""),
 			},
 		},
+		"valid dependencies": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+				Dependency(kueue.WorkloadDependency{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prepare"}},
+				}).
+				Obj(),
+		},
+		"should not depend on itself": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To(testWorkloadName)}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("dependencies").Index(0).Child("name"), nil, ""),
+			},
+		},
+		"should have a valid dependency selector": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{
+					Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "stage",
+						Operator: metav1.LabelSelectorOpIn,
+					}}},
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Required(specPath.Child("dependencies").Index(0).Child("selector", "matchExpressions").Index(0).Child("values"), ""),
+			},
+		},
+		"valid tasks": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Obj()).
+				Tasks(1000).
+				Obj(),
+		},
+		"tasks cannot be set together with a topology request": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).RequiredTopologyRequest("cloud.com/block").Obj()).
+				Tasks(1000).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(specPath.Child("tasks"), ""),
+			},
+		},
+		"tasks cannot be set together with minCount": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).SetMinimumCount(1).Obj()).
+				Tasks(1000).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(specPath.Child("tasks"), ""),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -241,6 +294,7 @@ func TestValidateWorkloadUpdate(t *testing.T) {
 	testCases := map[string]struct {
 		enableTopologyAwareScheduling bool
 		enableElasticJobsFeature      bool
+		enableBulkWorkloads           bool
 
 		before, after *kueue.Workload
 		wantErr       field.ErrorList
@@ -555,11 +609,105 @@ func TestValidateWorkloadUpdate(t *testing.T) {
 				Annotation(workloadslicing.WorkloadSliceReplacementFor, string(workload.NewReference(testWorkloadNamespace, testWorkloadName))).
 				Obj(),
 		},
+		"dependencies cannot be changed": {
+			before: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+				Obj(),
+			after: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("other")}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "dependencies"), nil, ""),
+			},
+		},
+		"tasks cannot be changed": {
+			enableBulkWorkloads: true,
+			before:              utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).Tasks(10).Obj(),
+			after:               utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).Tasks(20).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "tasks"), nil, ""),
+			},
+		},
+		"admitted tasks of a bulk workload can increase": {
+			enableBulkWorkloads: true,
+			before: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+				Tasks(1000).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "2").
+						Count(2).
+						Obj()).
+					Obj(), now).
+				Obj(),
+			after: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+				Tasks(1000).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "5").
+						Count(5).
+						Obj()).
+					Obj(), now).
+				Obj(),
+		},
+		"admitted tasks of a bulk workload cannot decrease": {
+			enableBulkWorkloads: true,
+			before: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+				Tasks(1000).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "5").
+						Count(5).
+						Obj()).
+					Obj(), now).
+				Obj(),
+			after: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+				Tasks(1000).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "2").
+						Count(2).
+						Obj()).
+					Obj(), now).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("status", "admission", "podSetAssignments").Index(0).Child("count"), nil, ""),
+			},
+		},
+		"admitted tasks cannot change when the feature gate is disabled": {
+			before: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+				Tasks(1000).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "2").
+						Count(2).
+						Obj()).
+					Obj(), now).
+				Obj(),
+			after: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+				Tasks(1000).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "5").
+						Count(5).
+						Obj()).
+					Obj(), now).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("status", "admission"), nil, ""),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.enableElasticJobsFeature)
 			features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, tc.enableTopologyAwareScheduling)
+			features.SetFeatureGateDuringTest(t, features.BulkWorkloads, tc.enableBulkWorkloads)
 			errList := ValidateWorkloadUpdate(tc.after, tc.before)
 			if diff := cmp.Diff(tc.wantErr, errList, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
 				t.Errorf("ValidateWorkloadUpdate() mismatch (-want +got):\n%s", diff)
@@ -567,3 +715,98 @@ func TestValidateWorkloadUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCreateDependencyCycle(t *testing.T) {
+	dependenciesPath := field.NewPath("spec", "dependencies")
+	testCases := map[string]struct {
+		enableWorkloadDependencies bool
+		workloads                  []kueue.Workload
+		workload                   *kueue.Workload
+		wantErr                    error
+	}{
+		"no cycle": {
+			enableWorkloadDependencies: true,
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("prepare", testWorkloadNamespace).Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+				Obj(),
+		},
+		"cycle": {
+			enableWorkloadDependencies: true,
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("prepare", testWorkloadNamespace).
+					Dependency(kueue.WorkloadDependency{Name: ptr.To(testWorkloadName)}).
+					Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(dependenciesPath, ""),
+			}.ToAggregate(),
+		},
+		"cycle when the feature is disabled": {
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("prepare", testWorkloadNamespace).
+					Dependency(kueue.WorkloadDependency{Name: ptr.To(testWorkloadName)}).
+					Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Dependency(kueue.WorkloadDependency{Name: ptr.To("prepare")}).
+				Obj(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, tc.enableWorkloadDependencies)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder()
+			for i := range tc.workloads {
+				builder = builder.WithObjects(&tc.workloads[i])
+			}
+			w := &WorkloadWebhook{client: builder.Build()}
+			_, gotErr := w.ValidateCreate(ctx, tc.workload)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
+				t.Errorf("ValidateCreate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateClusterSelectors(t *testing.T) {
+	annotationsPath := field.NewPath("metadata", "annotations")
+	testCases := map[string]struct {
+		workload *kueue.Workload
+		wantErr  field.ErrorList
+	}{
+		"no cluster selectors": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).Obj(),
+		},
+		"valid cluster selectors": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Annotation(kueue.MultiKueueRequiredClusterSelectorAnnotation, "region in (eu, us)").
+				Annotation(kueue.MultiKueuePreferredClusterSelectorAnnotation, "zone=a").
+				Obj(),
+		},
+		"invalid cluster selectors": {
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Annotation(kueue.MultiKueueRequiredClusterSelectorAnnotation, "region in (").
+				Annotation(kueue.MultiKueuePreferredClusterSelectorAnnotation, "zone==a=b").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(annotationsPath.Key(kueue.MultiKueueRequiredClusterSelectorAnnotation), nil, ""),
+				field.Invalid(annotationsPath.Key(kueue.MultiKueuePreferredClusterSelectorAnnotation), nil, ""),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateClusterSelectors(tc.workload)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
+				t.Errorf("validateClusterSelectors() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+// IsBulk returns true if the workload tracks a number of independent tasks,
+// each running one pod of its only podSet.
+func IsBulk(wl *kueue.Workload) bool {
+	return features.Enabled(features.BulkWorkloads) && wl.Spec.Tasks != nil && len(wl.Spec.PodSets) == 1
+}
+
+// MaxAdmittedTasks returns the number of tasks the bulk workload can run at
+// once: the count of its podSet, limited to the tasks which are not finished.
+func MaxAdmittedTasks(wl *kueue.Workload) int32 {
+	remaining := *wl.Spec.Tasks
+	if wl.Status.Tasks != nil {
+		remaining -= wl.Status.Tasks.Succeeded + wl.Status.Tasks.Failed
+	}
+	return max(0, min(wl.Spec.PodSets[0].Count, remaining))
+}
+
+// AdmittedTasks returns the number of tasks the bulk workload can run at once
+// within its quota reservation.
+func AdmittedTasks(wl *kueue.Workload) int32 {
+	if wl.Status.Admission == nil || len(wl.Status.Admission.PodSetAssignments) == 0 {
+		return 0
+	}
+	return ptr.Deref(wl.Status.Admission.PodSetAssignments[0].Count, wl.Spec.PodSets[0].Count)
+}
+
+// NeedsBulkGrowth returns true if the admitted bulk workload can run more tasks
+// at once than its quota reservation allows. Such a workload is considered
+// again by the scheduler, which admits the additional tasks when the quota
+// allows.
+func NeedsBulkGrowth(wl *kueue.Workload) bool {
+	if !IsBulk(wl) || !IsAdmitted(wl) || IsFinished(wl) || IsEvicted(wl) {
+		return false
+	}
+	if len(wl.Status.AdmissionChecks) > 0 || wl.Status.Admission.PodSetAssignments[0].TopologyAssignment != nil {
+		return false
+	}
+	return AdmittedTasks(wl) < MaxAdmittedTasks(wl)
+}
+
+// PodSetsForPartialAdmission returns the podSets of the workload with the
+// minimal counts used when searching for a partial admission. The minimal
+// count of a bulk workload is a single task.
+func PodSetsForPartialAdmission(wl *kueue.Workload) []kueue.PodSet {
+	if !IsBulk(wl) || wl.Spec.PodSets[0].MinCount != nil {
+		return wl.Spec.PodSets
+	}
+	podSets := []kueue.PodSet{*wl.Spec.PodSets[0].DeepCopy()}
+	podSets[0].MinCount = ptr.To[int32](1)
+	return podSets
+}
+
+// TasksStatusAreEqual checks if two aggregated statuses of the tasks are equal.
+func TasksStatusAreEqual(a, b *kueue.WorkloadTasksStatus) bool {
+	return equality.Semantic.DeepEqual(a, b)
+}
+
+// UpdateTasksStatus updates the aggregated status of the tasks of the bulk
+// workload.
+func UpdateTasksStatus(ctx context.Context, c client.Client, wl *kueue.Workload, tasks *kueue.WorkloadTasksStatus) error {
+	return PatchStatus(ctx, c, wl, constants.TasksStatusMgr, func(wl *kueue.Workload) (bool, error) {
+		wl.Status.Tasks = tasks
+		return true, nil
+	})
+}
@@ -0,0 +1,163 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestNeedsBulkGrowth(t *testing.T) {
+	now := time.Now()
+	bulkWorkload := func(admittedTasks int32) *utiltestingapi.WorkloadWrapper {
+		return utiltestingapi.MakeWorkload("wl", "ns").
+			PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).Request(corev1.ResourceCPU, "1").Obj()).
+			Tasks(10).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").PodSets(
+				utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "1").
+					Count(admittedTasks).
+					Obj(),
+			).Obj(), now).
+			AdmittedAt(true, now)
+	}
+	cases := map[string]struct {
+		workload     *kueue.Workload
+		disableGate  bool
+		wantMax      int32
+		wantAdmitted int32
+		want         bool
+	}{
+		"not bulk": {
+			workload:     utiltestingapi.MakeWorkload("wl", "ns").SimpleReserveQuota("cq", "default", now).AdmittedAt(true, now).Obj(),
+			wantAdmitted: 1,
+		},
+		"feature gate disabled": {
+			workload:     bulkWorkload(1).Obj(),
+			disableGate:  true,
+			wantMax:      4,
+			wantAdmitted: 1,
+		},
+		"not admitted": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").Tasks(10).Obj(),
+			wantMax:  1,
+		},
+		"single task admitted": {
+			workload:     bulkWorkload(1).Obj(),
+			wantMax:      4,
+			wantAdmitted: 1,
+			want:         true,
+		},
+		"all the tasks which can run at once admitted": {
+			workload:     bulkWorkload(4).Obj(),
+			wantMax:      4,
+			wantAdmitted: 4,
+		},
+		"remaining tasks admitted": {
+			workload:     bulkWorkload(2).TasksStatus(kueue.WorkloadTasksStatus{Succeeded: 7, Failed: 1}).Obj(),
+			wantMax:      2,
+			wantAdmitted: 2,
+		},
+		"some remaining tasks not admitted": {
+			workload:     bulkWorkload(2).TasksStatus(kueue.WorkloadTasksStatus{Succeeded: 7}).Obj(),
+			wantMax:      3,
+			wantAdmitted: 2,
+			want:         true,
+		},
+		"finished": {
+			workload:     bulkWorkload(1).Finished().Obj(),
+			wantMax:      4,
+			wantAdmitted: 1,
+		},
+		"evicted": {
+			workload:     bulkWorkload(1).EvictedAt(now).Obj(),
+			wantMax:      4,
+			wantAdmitted: 1,
+		},
+		"with admission checks": {
+			workload:     bulkWorkload(1).AdmissionCheck(kueue.AdmissionCheckState{Name: "check", State: kueue.CheckStateReady}).Obj(),
+			wantMax:      4,
+			wantAdmitted: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.BulkWorkloads, !tc.disableGate)
+			if tc.workload.Spec.Tasks != nil {
+				if got := MaxAdmittedTasks(tc.workload); got != tc.wantMax {
+					t.Errorf("Unexpected MaxAdmittedTasks, want=%d, got=%d", tc.wantMax, got)
+				}
+			}
+			if got := AdmittedTasks(tc.workload); got != tc.wantAdmitted {
+				t.Errorf("Unexpected AdmittedTasks, want=%d, got=%d", tc.wantAdmitted, got)
+			}
+			if got := NeedsBulkGrowth(tc.workload); got != tc.want {
+				t.Errorf("Unexpected NeedsBulkGrowth, want=%v, got=%v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPodSetsForPartialAdmission(t *testing.T) {
+	cases := map[string]struct {
+		workload    *kueue.Workload
+		disableGate bool
+		want        []kueue.PodSet
+	}{
+		"not bulk": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).Obj()).
+				Obj(),
+			want: []kueue.PodSet{*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).Obj()},
+		},
+		"bulk": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).Obj()).
+				Tasks(10).
+				Obj(),
+			want: []kueue.PodSet{*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).SetMinimumCount(1).Obj()},
+		},
+		"bulk; feature gate disabled": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).Obj()).
+				Tasks(10).
+				Obj(),
+			disableGate: true,
+			want:        []kueue.PodSet{*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).Obj()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.BulkWorkloads, !tc.disableGate)
+			got := PodSetsForPartialAdmission(tc.workload)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected podSets (-want,+got):\n%s", diff)
+			}
+			if tc.workload.Spec.PodSets[0].MinCount != nil {
+				t.Errorf("Unexpected change of the workload minCount: %d", ptr.Deref(tc.workload.Spec.PodSets[0].MinCount, 0))
+			}
+		})
+	}
+}
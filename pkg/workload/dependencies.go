@@ -0,0 +1,243 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+// DependenciesState is the state of the dependencies of a Workload.
+type DependenciesState int
+
+const (
+	// DependenciesStatePending means that some dependencies have not finished yet.
+	DependenciesStatePending DependenciesState = iota
+	// DependenciesStateSatisfied means that all the dependencies met their condition.
+	DependenciesStateSatisfied
+	// DependenciesStateFailed means that a dependency finished without meeting its condition.
+	DependenciesStateFailed
+)
+
+// HasPendingDependencies returns true if the workload declares dependencies
+// which are not satisfied yet, so it cannot be admitted.
+func HasPendingDependencies(wl *kueue.Workload) bool {
+	return features.Enabled(features.WorkloadDependencies) &&
+		len(wl.Spec.Dependencies) > 0 &&
+		!apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadDependenciesSatisfied)
+}
+
+// DependsOn returns true if any of the dependencies of the workload references
+// the target Workload.
+func DependsOn(wl, target *kueue.Workload) bool {
+	if wl.Namespace != target.Namespace || wl.Name == target.Name {
+		return false
+	}
+	for i := range wl.Spec.Dependencies {
+		if dependencyMatches(&wl.Spec.Dependencies[i], target) {
+			return true
+		}
+	}
+	return false
+}
+
+func dependencyMatches(dep *kueue.WorkloadDependency, target *kueue.Workload) bool {
+	if dep.Name != nil {
+		return *dep.Name == target.Name
+	}
+	selector, err := metav1.LabelSelectorAsSelector(dep.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(target.Labels))
+}
+
+// EvaluateDependencies returns the state of the dependencies of the workload
+// along with a message describing it.
+func EvaluateDependencies(ctx context.Context, c client.Reader, wl *kueue.Workload) (DependenciesState, string, error) {
+	var pending []string
+	for i := range wl.Spec.Dependencies {
+		dep := &wl.Spec.Dependencies[i]
+		targets, err := dependencyTargets(ctx, c, wl, dep)
+		if err != nil {
+			return DependenciesStatePending, "", err
+		}
+		if len(targets) == 0 {
+			pending = append(pending, dependencyString(dep))
+			continue
+		}
+		for _, target := range targets {
+			if !IsFinished(target) {
+				pending = append(pending, target.Name)
+				continue
+			}
+			if !dependencyConditionMet(dep, target) {
+				return DependenciesStateFailed, fmt.Sprintf("The dependency %q finished without success", target.Name), nil
+			}
+		}
+	}
+	if len(pending) > 0 {
+		return DependenciesStatePending, fmt.Sprintf("Waiting for the dependencies to finish: %s", strings.Join(pending, ", ")), nil
+	}
+	return DependenciesStateSatisfied, "All the dependencies are satisfied", nil
+}
+
+// dependencyTargets returns the Workloads referenced by the dependency.
+func dependencyTargets(ctx context.Context, c client.Reader, wl *kueue.Workload, dep *kueue.WorkloadDependency) ([]*kueue.Workload, error) {
+	if dep.Name != nil {
+		target := &kueue.Workload{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: *dep.Name}, target); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return []*kueue.Workload{target}, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(dep.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the dependency selector: %w", err)
+	}
+	var list kueue.WorkloadList
+	if err := c.List(ctx, &list, client.InNamespace(wl.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	targets := make([]*kueue.Workload, 0, len(list.Items))
+	for i := range list.Items {
+		if list.Items[i].Name != wl.Name {
+			targets = append(targets, &list.Items[i])
+		}
+	}
+	return targets, nil
+}
+
+func dependencyConditionMet(dep *kueue.WorkloadDependency, target *kueue.Workload) bool {
+	if dep.Condition == kueue.WorkloadDependencyFinished {
+		return true
+	}
+	cond := apimeta.FindStatusCondition(target.Status.Conditions, kueue.WorkloadFinished)
+	return cond != nil && cond.Reason == kueue.WorkloadFinishedReasonSucceeded
+}
+
+func dependencyString(dep *kueue.WorkloadDependency) string {
+	if dep.Name != nil {
+		return *dep.Name
+	}
+	return metav1.FormatLabelSelector(dep.Selector)
+}
+
+// FindDependencyCycle returns the names of the Workloads which form a cycle of
+// dependencies through the workload, or nil if there is no such cycle.
+// The workload doesn't need to exist yet, so that the cycle can be detected
+// before it is created or updated.
+func FindDependencyCycle(ctx context.Context, c client.Reader, wl *kueue.Workload) ([]string, error) {
+	if len(wl.Spec.Dependencies) == 0 {
+		return nil, nil
+	}
+	var list kueue.WorkloadList
+	if err := c.List(ctx, &list, client.InNamespace(wl.Namespace)); err != nil {
+		return nil, err
+	}
+	graph := newDependencyGraph(wl, list.Items)
+
+	visited := sets.New[string]()
+	var path []string
+	var visit func(current *kueue.Workload) bool
+	visit = func(current *kueue.Workload) bool {
+		path = append(path, current.Name)
+		visited.Insert(current.Name)
+		for i := range current.Spec.Dependencies {
+			for _, next := range graph.targets(&current.Spec.Dependencies[i]) {
+				if next.Name == current.Name {
+					continue
+				}
+				if next.Name == wl.Name {
+					path = append(path, next.Name)
+					return true
+				}
+				if !visited.Has(next.Name) && visit(next) {
+					return true
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if visit(wl) {
+		return path, nil
+	}
+	return nil, nil
+}
+
+// dependencyGraph resolves the dependencies between the Workloads of a namespace.
+// The Workloads matching a selector are computed once per distinct selector.
+type dependencyGraph struct {
+	workloads  []*kueue.Workload
+	byName     map[string]*kueue.Workload
+	bySelector map[string][]*kueue.Workload
+}
+
+// newDependencyGraph returns the graph of the workload and the other Workloads
+// of its namespace. The workload replaces the stored version of itself, if any.
+func newDependencyGraph(wl *kueue.Workload, others []kueue.Workload) *dependencyGraph {
+	g := &dependencyGraph{
+		workloads:  make([]*kueue.Workload, 0, len(others)+1),
+		byName:     make(map[string]*kueue.Workload, len(others)+1),
+		bySelector: make(map[string][]*kueue.Workload),
+	}
+	g.workloads = append(g.workloads, wl)
+	g.byName[wl.Name] = wl
+	for i := range others {
+		if others[i].Name != wl.Name {
+			g.workloads = append(g.workloads, &others[i])
+			g.byName[others[i].Name] = &others[i]
+		}
+	}
+	return g
+}
+
+// targets returns the Workloads referenced by the dependency.
+func (g *dependencyGraph) targets(dep *kueue.WorkloadDependency) []*kueue.Workload {
+	if dep.Name != nil {
+		if target, found := g.byName[*dep.Name]; found {
+			return []*kueue.Workload{target}
+		}
+		return nil
+	}
+	key := metav1.FormatLabelSelector(dep.Selector)
+	if matching, found := g.bySelector[key]; found {
+		return matching
+	}
+	var matching []*kueue.Workload
+	if selector, err := metav1.LabelSelectorAsSelector(dep.Selector); err == nil {
+		for _, candidate := range g.workloads {
+			if selector.Matches(labels.Set(candidate.Labels)) {
+				matching = append(matching, candidate)
+			}
+		}
+	}
+	g.bySelector[key] = matching
+	return matching
+}
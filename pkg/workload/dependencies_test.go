@@ -0,0 +1,242 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func finishedCondition(reason string) metav1.Condition {
+	return metav1.Condition{
+		Type:   kueue.WorkloadFinished,
+		Status: metav1.ConditionTrue,
+		Reason: reason,
+	}
+}
+
+func TestEvaluateDependencies(t *testing.T) {
+	byName := func(name string, condition kueue.WorkloadDependencyCondition) kueue.WorkloadDependency {
+		return kueue.WorkloadDependency{Name: ptr.To(name), Condition: condition}
+	}
+	bySelector := kueue.WorkloadDependency{
+		Selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prepare"}},
+		Condition: kueue.WorkloadDependencySucceeded,
+	}
+	cases := map[string]struct {
+		workload    *kueue.Workload
+		workloads   []kueue.Workload
+		wantState   DependenciesState
+		wantMessage string
+	}{
+		"missing dependency": {
+			workload:    utiltestingapi.MakeWorkload("b", "ns").Dependency(byName("a", kueue.WorkloadDependencySucceeded)).Obj(),
+			wantState:   DependenciesStatePending,
+			wantMessage: "Waiting for the dependencies to finish: a",
+		},
+		"running dependency": {
+			workload:    utiltestingapi.MakeWorkload("b", "ns").Dependency(byName("a", kueue.WorkloadDependencySucceeded)).Obj(),
+			workloads:   []kueue.Workload{*utiltestingapi.MakeWorkload("a", "ns").Obj()},
+			wantState:   DependenciesStatePending,
+			wantMessage: "Waiting for the dependencies to finish: a",
+		},
+		"succeeded dependency": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Dependency(byName("a", kueue.WorkloadDependencySucceeded)).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "ns").Condition(finishedCondition(kueue.WorkloadFinishedReasonSucceeded)).Obj(),
+			},
+			wantState:   DependenciesStateSatisfied,
+			wantMessage: "All the dependencies are satisfied",
+		},
+		"failed dependency": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Dependency(byName("a", kueue.WorkloadDependencySucceeded)).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "ns").Condition(finishedCondition(kueue.WorkloadFinishedReasonFailed)).Obj(),
+			},
+			wantState:   DependenciesStateFailed,
+			wantMessage: `The dependency "a" finished without success`,
+		},
+		"failed dependency with the finished condition": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Dependency(byName("a", kueue.WorkloadDependencyFinished)).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "ns").Condition(finishedCondition(kueue.WorkloadFinishedReasonFailed)).Obj(),
+			},
+			wantState:   DependenciesStateSatisfied,
+			wantMessage: "All the dependencies are satisfied",
+		},
+		"dependency in another namespace": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Dependency(byName("a", kueue.WorkloadDependencySucceeded)).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "other").Condition(finishedCondition(kueue.WorkloadFinishedReasonSucceeded)).Obj(),
+			},
+			wantState:   DependenciesStatePending,
+			wantMessage: "Waiting for the dependencies to finish: a",
+		},
+		"selector without matching workloads": {
+			workload:    utiltestingapi.MakeWorkload("b", "ns").Dependency(bySelector).Obj(),
+			wantState:   DependenciesStatePending,
+			wantMessage: "Waiting for the dependencies to finish: stage=prepare",
+		},
+		"selector with some workloads still running": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Dependency(bySelector).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a1", "ns").Label("stage", "prepare").
+					Condition(finishedCondition(kueue.WorkloadFinishedReasonSucceeded)).Obj(),
+				*utiltestingapi.MakeWorkload("a2", "ns").Label("stage", "prepare").Obj(),
+			},
+			wantState:   DependenciesStatePending,
+			wantMessage: "Waiting for the dependencies to finish: a2",
+		},
+		"selector with all workloads succeeded, ignoring itself": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Label("stage", "prepare").Dependency(bySelector).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a1", "ns").Label("stage", "prepare").
+					Condition(finishedCondition(kueue.WorkloadFinishedReasonSucceeded)).Obj(),
+				*utiltestingapi.MakeWorkload("a2", "ns").Label("stage", "prepare").
+					Condition(finishedCondition(kueue.WorkloadFinishedReasonSucceeded)).Obj(),
+			},
+			wantState:   DependenciesStateSatisfied,
+			wantMessage: "All the dependencies are satisfied",
+		},
+		"failure propagated from a dependency": {
+			workload: utiltestingapi.MakeWorkload("c", "ns").Dependency(byName("b", kueue.WorkloadDependencySucceeded)).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("b", "ns").Condition(finishedCondition(kueue.WorkloadFinishedReasonDependencyFailed)).Obj(),
+			},
+			wantState:   DependenciesStateFailed,
+			wantMessage: `The dependency "b" finished without success`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			objs := make([]client.Object, 0, len(tc.workloads))
+			for i := range tc.workloads {
+				objs = append(objs, &tc.workloads[i])
+			}
+			cl := utiltesting.NewClientBuilder().WithObjects(objs...).Build()
+			gotState, gotMessage, err := EvaluateDependencies(ctx, cl, tc.workload)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if gotState != tc.wantState {
+				t.Errorf("Unexpected state, want: %v, got: %v", tc.wantState, gotState)
+			}
+			if gotMessage != tc.wantMessage {
+				t.Errorf("Unexpected message, want: %q, got: %q", tc.wantMessage, gotMessage)
+			}
+		})
+	}
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	dependsOn := func(name string) kueue.WorkloadDependency {
+		return kueue.WorkloadDependency{Name: ptr.To(name)}
+	}
+	cases := map[string]struct {
+		workload  *kueue.Workload
+		workloads []kueue.Workload
+		want      []string
+	}{
+		"no dependencies": {
+			workload: utiltestingapi.MakeWorkload("a", "ns").Obj(),
+		},
+		"chain": {
+			workload: utiltestingapi.MakeWorkload("c", "ns").Dependency(dependsOn("b")).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "ns").Obj(),
+				*utiltestingapi.MakeWorkload("b", "ns").Dependency(dependsOn("a")).Obj(),
+			},
+		},
+		"diamond": {
+			workload: utiltestingapi.MakeWorkload("d", "ns").Dependency(dependsOn("b")).Dependency(dependsOn("c")).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "ns").Obj(),
+				*utiltestingapi.MakeWorkload("b", "ns").Dependency(dependsOn("a")).Obj(),
+				*utiltestingapi.MakeWorkload("c", "ns").Dependency(dependsOn("a")).Obj(),
+			},
+		},
+		"cycle by names": {
+			workload: utiltestingapi.MakeWorkload("a", "ns").Dependency(dependsOn("c")).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("b", "ns").Dependency(dependsOn("a")).Obj(),
+				*utiltestingapi.MakeWorkload("c", "ns").Dependency(dependsOn("b")).Obj(),
+			},
+			want: []string{"a", "c", "b", "a"},
+		},
+		"cycle by the labels of the new workload": {
+			workload: utiltestingapi.MakeWorkload("b", "ns").Label("stage", "train").Dependency(dependsOn("a")).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("a", "ns").Dependency(kueue.WorkloadDependency{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "train"}},
+				}).Obj(),
+			},
+			want: []string{"b", "a", "b"},
+		},
+		"selector matching the workload itself": {
+			workload: utiltestingapi.MakeWorkload("a", "ns").Label("stage", "train").Dependency(kueue.WorkloadDependency{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "train"}},
+			}).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("b", "ns").Label("stage", "train").Obj(),
+			},
+		},
+		"cycle through a selector shared by several workloads": {
+			workload: utiltestingapi.MakeWorkload("a", "ns").Label("stage", "prepare").Dependency(dependsOn("c")).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("b", "ns").Dependency(kueue.WorkloadDependency{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prepare"}},
+				}).Obj(),
+				*utiltestingapi.MakeWorkload("c", "ns").Dependency(kueue.WorkloadDependency{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prepare"}},
+				}).Obj(),
+			},
+			want: []string{"a", "c", "a"},
+		},
+		"same names in another namespace": {
+			workload: utiltestingapi.MakeWorkload("a", "ns").Dependency(dependsOn("b")).Obj(),
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("b", "other").Dependency(dependsOn("a")).Obj(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			objs := make([]client.Object, 0, len(tc.workloads))
+			for i := range tc.workloads {
+				objs = append(objs, &tc.workloads[i])
+			}
+			cl := utiltesting.NewClientBuilder().WithObjects(objs...).Build()
+			got, err := FindDependencyCycle(ctx, cl, tc.workload)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected cycle (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
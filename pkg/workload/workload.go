@@ -376,7 +376,7 @@ func IsExplicitlyRequestingTAS(podSets ...kueue.PodSet) bool {
 	return slices.ContainsFunc(podSets,
 		func(ps kueue.PodSet) bool {
 			tr := ps.TopologyRequest
-			return tr != nil && (tr.Unconstrained != nil || tr.Required != nil || tr.Preferred != nil || tr.PodSetSliceRequiredTopology != nil || tr.PodSetSliceSize != nil)
+			return tr != nil && (tr.Unconstrained != nil || tr.Required != nil || tr.Preferred != nil || tr.PodSetSliceRequiredTopology != nil || tr.PodSetSliceSize != nil || tr.SpreadTopology != nil)
 		})
 }
 
@@ -449,7 +449,7 @@ func applyResourceTransformations(input corev1.ResourceList, transforms map[core
 }
 
 func CanBePartiallyAdmitted(wl *kueue.Workload) bool {
-	ps := wl.Spec.PodSets
+	ps := PodSetsForPartialAdmission(wl)
 	for psi := range ps {
 		if ps[psi].Count > ptr.Deref(ps[psi].MinCount, ps[psi].Count) {
 			return true
@@ -811,7 +811,7 @@ func NeedsSecondPass(w *kueue.Workload) bool {
 	if IsFinished(w) || IsEvicted(w) || !HasQuotaReservation(w) {
 		return false
 	}
-	return needsSecondPassForDelayedAssignment(w) || needsSecondPassAfterNodeFailure(w)
+	return needsSecondPassForDelayedAssignment(w) || needsSecondPassAfterNodeFailure(w) || NeedsBulkGrowth(w)
 }
 
 func needsSecondPassForDelayedAssignment(w *kueue.Workload) bool {
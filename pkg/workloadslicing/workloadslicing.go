@@ -110,14 +110,19 @@ func FindNotFinishedWorkloads(ctx context.Context, clnt client.Client, jobObject
 	if err := clnt.List(ctx, list, client.InNamespace(jobObject.GetNamespace()), indexer.OwnerReferenceIndexFieldMatcher(jobObjectGVK, jobObject.GetName())); err != nil {
 		return nil, err
 	}
+	return NotFinishedWorkloads(list.Items), nil
+}
 
+// NotFinishedWorkloads returns the provided workload slices sorted from the oldest,
+// without the ones with "Finished" condition with status = "True".
+func NotFinishedWorkloads(workloads []kueue.Workload) []kueue.Workload {
 	// Sort workloads by creation timestamp, oldest first.
 	// In the rare case that two workload slices have identical creationTimestamp values
 	// (due to RFC3339 second-level precision), use WorkloadSliceReplacementFor
 	// as a tiebreaker. This edge case is uncommon in production but can occur in
 	// integration or e2e tests where the original and scaled-up workloads are created
 	// in rapid succession.
-	slices.SortFunc(list.Items, func(a, b kueue.Workload) int {
+	slices.SortFunc(workloads, func(a, b kueue.Workload) int {
 		return cmputil.LazyOr(
 			func() int {
 				return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
@@ -132,9 +137,9 @@ func FindNotFinishedWorkloads(ctx context.Context, clnt client.Client, jobObject
 	})
 
 	// Filter out workloads with activated "Finished" condition.
-	return slices.DeleteFunc(list.Items, func(w kueue.Workload) bool {
+	return slices.DeleteFunc(workloads, func(w kueue.Workload) bool {
 		return workload.IsFinished(&w)
-	}), nil
+	})
 }
 
 // FindLatestActiveWorkload returns the newest non-finished workload from the list of workloads
@@ -181,12 +186,25 @@ func EnsureWorkloadSlices(
 	jobObjectGVK schema.GroupVersionKind,
 	tracker *roletracker.RoleTracker,
 ) (*kueue.Workload, bool, error) {
-	jobPodSetsCounts := workload.ExtractPodSetCounts(jobPodSets)
-
 	workloads, err := FindNotFinishedWorkloads(ctx, clnt, jobObject, jobObjectGVK)
 	if err != nil {
 		return nil, true, fmt.Errorf("failed to find active workload slices: %w", err)
 	}
+	return EnsureWorkloadSlicesOf(ctx, clnt, clk, jobPodSets, workloads, tracker)
+}
+
+// EnsureWorkloadSlicesOf works as EnsureWorkloadSlices, but for the not finished
+// workload slices of the job, sorted from the oldest, which were already looked up.
+// It's meant for jobs which workload slices can't be found by the owner reference.
+func EnsureWorkloadSlicesOf(
+	ctx context.Context,
+	clnt client.Client,
+	clk clock.Clock,
+	jobPodSets []kueue.PodSet,
+	workloads []kueue.Workload,
+	tracker *roletracker.RoleTracker,
+) (*kueue.Workload, bool, error) {
+	jobPodSetsCounts := workload.ExtractPodSetCounts(jobPodSets)
 
 	switch len(workloads) {
 	case 0:
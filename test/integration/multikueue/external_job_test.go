@@ -137,7 +137,7 @@ var _ = ginkgo.Describe("MultiKueue", ginkgo.Label("area:multikueue", "feature:m
 				)
 				gomega.Expect(err).NotTo(gomega.HaveOccurred())
 
-				_, err = dispatcher.SetupControllers(mgr, configuration, nil)
+				_, err = dispatcher.SetupControllers(mgr, configuration, nil, nil)
 				gomega.Expect(err).NotTo(gomega.HaveOccurred())
 			})
 		})
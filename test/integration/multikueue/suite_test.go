@@ -376,7 +376,7 @@ func managerAndMultiKueueSetup(
 		},
 	}
 	mgr.GetScheme().Default(configuration)
-	_, err = dispatcher.SetupControllers(mgr, configuration, nil)
+	_, err = dispatcher.SetupControllers(mgr, configuration, nil, nil)
 	gomega.Expect(err).NotTo(gomega.HaveOccurred())
 }
 
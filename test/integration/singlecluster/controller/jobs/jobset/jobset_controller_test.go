@@ -38,12 +38,14 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	workloadjobset "sigs.k8s.io/kueue/pkg/controller/jobs/jobset"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/tas"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	testingjobset "sigs.k8s.io/kueue/pkg/util/testingjobs/jobset"
 	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
 	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 	"sigs.k8s.io/kueue/test/integration/framework"
 	"sigs.k8s.io/kueue/test/util"
 )
@@ -1133,6 +1135,79 @@ var _ = ginkgo.Describe("JobSet controller interacting with scheduler", ginkgo.L
 			util.ExpectReservingActiveWorkloadsMetric(clusterQueue, 2)
 		})
 	})
+
+	ginkgo.It("Should readmit a running JobSet which is scaled, rather than admitting a workload slice", func() {
+		features.SetFeatureGateDuringTest(ginkgo.GinkgoTB(), features.ElasticJobsViaWorkloadSlices, true)
+
+		ginkgo.By("creating localQueue", func() {
+			localQueue = utiltestingapi.MakeLocalQueue("local-queue", ns.Name).ClusterQueue(clusterQueue.Name).Obj()
+			util.MustCreate(ctx, k8sClient, localQueue)
+		})
+
+		ginkgo.By("checking a JobSet opted in for workload slicing is rejected", func() {
+			elasticJobSet := testingjobset.MakeJobSet("elastic-jobset", ns.Name).ReplicatedJobs(
+				testingjobset.ReplicatedJobRequirements{
+					Name:        "replicated-job-1",
+					Replicas:    1,
+					Parallelism: 1,
+					Completions: 1,
+				},
+			).Queue(localQueue.Name).
+				Annotations(map[string]string{workloadslicing.EnabledAnnotationKey: workloadslicing.EnabledAnnotationValue}).
+				Request("replicated-job-1", corev1.ResourceCPU, "1").
+				Obj()
+			gomega.Expect(k8sClient.Create(ctx, elasticJobSet)).Should(utiltesting.BeForbiddenError())
+		})
+
+		jobSet := testingjobset.MakeJobSet("dev-jobset", ns.Name).ReplicatedJobs(
+			testingjobset.ReplicatedJobRequirements{
+				Name:        "replicated-job-1",
+				Replicas:    1,
+				Parallelism: 1,
+				Completions: 1,
+			},
+		).Queue(localQueue.Name).
+			Request("replicated-job-1", corev1.ResourceCPU, "1").
+			Obj()
+		lookupKey := types.NamespacedName{Name: jobSet.Name, Namespace: jobSet.Namespace}
+		createdJobSet := &jobsetapi.JobSet{}
+
+		ginkgo.By("checking the jobset starts", func() {
+			util.MustCreate(ctx, k8sClient, jobSet)
+			gomega.Eventually(func(g gomega.Gomega) {
+				g.Expect(k8sClient.Get(ctx, lookupKey, createdJobSet)).Should(gomega.Succeed())
+				g.Expect(ptr.Deref(createdJobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+			}, util.Timeout, util.Interval).Should(gomega.Succeed())
+		})
+
+		ginkgo.By("scaling the running jobset up", func() {
+			gomega.Eventually(func(g gomega.Gomega) {
+				g.Expect(k8sClient.Get(ctx, lookupKey, createdJobSet)).Should(gomega.Succeed())
+				createdJobSet.Spec.ReplicatedJobs[0].Replicas = 3
+				g.Expect(k8sClient.Update(ctx, createdJobSet)).Should(gomega.Succeed())
+			}, util.Timeout, util.Interval).Should(gomega.Succeed())
+		})
+
+		wlKey := types.NamespacedName{Name: workloadjobset.GetWorkloadNameForJobSet(jobSet.Name, createdJobSet.UID), Namespace: jobSet.Namespace}
+
+		ginkgo.By("checking the jobset is readmitted with the new count", func() {
+			gomega.Eventually(func(g gomega.Gomega) {
+				wl := &kueue.Workload{}
+				g.Expect(k8sClient.Get(ctx, wlKey, wl)).Should(gomega.Succeed())
+				g.Expect(wl.Spec.PodSets[0].Count).Should(gomega.Equal(int32(3)))
+				g.Expect(workload.HasQuotaReservation(wl)).Should(gomega.BeTrue())
+				g.Expect(k8sClient.Get(ctx, lookupKey, createdJobSet)).Should(gomega.Succeed())
+				g.Expect(ptr.Deref(createdJobSet.Spec.Suspend, false)).Should(gomega.BeFalse())
+			}, util.Timeout, util.Interval).Should(gomega.Succeed())
+		})
+
+		ginkgo.By("checking no workload slice is created", func() {
+			workloads := &kueue.WorkloadList{}
+			gomega.Expect(k8sClient.List(ctx, workloads, client.InNamespace(ns.Name))).Should(gomega.Succeed())
+			gomega.Expect(workloads.Items).Should(gomega.HaveLen(1))
+			gomega.Expect(workloads.Items[0].Name).Should(gomega.Equal(wlKey.Name))
+		})
+	})
 })
 
 var _ = ginkgo.Describe("JobSet controller with TopologyAwareScheduling", ginkgo.Label("job:jobset", "area:jobs", "feature:tas"), ginkgo.Ordered, ginkgo.ContinueOnFailure, func() {